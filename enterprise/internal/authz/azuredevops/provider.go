@@ -211,7 +211,7 @@ func (p *Provider) FetchUserPerms(ctx context.Context, account *extsvc.Account,
 
 		logger.Debug("listing repos", log.String("org", org.Name))
 
-		foundRepos, err := client.ListRepositoriesByProjectOrOrg(ctx, azuredevops.ListRepositoriesByProjectOrOrgArgs{
+		page, err := client.ListRepositoriesPage(ctx, azuredevops.ListRepositoriesByProjectOrOrgArgs{
 			ProjectOrOrgName: org.Name,
 		})
 		if err != nil {
@@ -237,8 +237,8 @@ func (p *Provider) FetchUserPerms(ctx context.Context, account *extsvc.Account,
 			return nil, errors.Newf("failed to list repositories for org: %q with error: %q", org, err.Error())
 		}
 
-		logger.Debug("adding repos", log.Int("count", len(foundRepos)))
-		repos = append(repos, foundRepos...)
+		logger.Debug("adding repos", log.Int("count", len(page.Repositories)))
+		repos = append(repos, page.Repositories...)
 	}
 
 	extIDs := make([]extsvc.RepoID, 0, len(repos))
@@ -90,7 +90,7 @@ func (s AzureDevOpsSource) LoadChangeset(ctx context.Context, cs *Changeset) err
 		return err
 	}
 
-	pr, err := s.client.GetPullRequest(ctx, args)
+	pr, err := s.client.GetPullRequest(ctx, args, azuredevops.GetPullRequestOptions{})
 	if err != nil {
 		if errcode.IsNotFound(err) {
 			return ChangesetNotFoundError{Changeset: cs}
@@ -194,7 +194,7 @@ func (s AzureDevOpsSource) UpdateChangeset(ctx context.Context, cs *Changeset) e
 
 	// ADO does not support updating the target branch alongside other fields, so we have
 	// to check it separately, and make 2 calls if there is a change.
-	pr, err := s.client.GetPullRequest(ctx, args)
+	pr, err := s.client.GetPullRequest(ctx, args, azuredevops.GetPullRequestOptions{})
 	if err != nil {
 		if errcode.IsNotFound(err) {
 			return ChangesetNotFoundError{Changeset: cs}
@@ -1,6 +1,10 @@
 package azuredevops
 
-import "github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
+import (
+	"encoding/json"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/azuredevops"
+)
 
 // AnnotatedPullRequest adds metadata we need that lives outside the main
 // PullRequest type returned by the Azure DevOps API alongside the pull request.
@@ -10,3 +14,24 @@ type AnnotatedPullRequest struct {
 	*azuredevops.PullRequest
 	Statuses []*azuredevops.PullRequestBuildStatus
 }
+
+// UnmarshalJSON decodes the embedded PullRequest and the statuses separately,
+// since the UnmarshalJSON method of PullRequest would otherwise be promoted and
+// decode only the pull request.
+func (pr *AnnotatedPullRequest) UnmarshalJSON(data []byte) error {
+	var p azuredevops.PullRequest
+	if err := json.Unmarshal(data, &p); err != nil {
+		return err
+	}
+
+	var annotations struct {
+		Statuses []*azuredevops.PullRequestBuildStatus
+	}
+	if err := json.Unmarshal(data, &annotations); err != nil {
+		return err
+	}
+
+	pr.PullRequest = &p
+	pr.Statuses = annotations.Statuses
+	return nil
+}
@@ -107,7 +107,7 @@ func TestAzureDevOpsSource_LoadChangeset(t *testing.T) {
 		cs, _ := mockAzureDevOpsChangeset()
 		s, client := mockAzureDevOpsSource()
 		want := errors.New("error")
-		client.GetPullRequestFunc.SetDefaultHook(func(ctx context.Context, r azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+		client.GetPullRequestFunc.SetDefaultHook(func(ctx context.Context, r azuredevops.PullRequestCommonArgs, _ azuredevops.GetPullRequestOptions) (azuredevops.PullRequest, error) {
 			assert.Equal(t, testCommonPullRequestArgs, r)
 			return azuredevops.PullRequest{}, want
 		})
@@ -120,7 +120,7 @@ func TestAzureDevOpsSource_LoadChangeset(t *testing.T) {
 	t.Run("pull request not found", func(t *testing.T) {
 		cs, _ := mockAzureDevOpsChangeset()
 		s, client := mockAzureDevOpsSource()
-		client.GetPullRequestFunc.SetDefaultHook(func(ctx context.Context, r azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+		client.GetPullRequestFunc.SetDefaultHook(func(ctx context.Context, r azuredevops.PullRequestCommonArgs, _ azuredevops.GetPullRequestOptions) (azuredevops.PullRequest, error) {
 			assert.Equal(t, testCommonPullRequestArgs, r)
 			return azuredevops.PullRequest{}, &notFoundError{}
 		})
@@ -138,7 +138,7 @@ func TestAzureDevOpsSource_LoadChangeset(t *testing.T) {
 		want := mockAzureDevOpsAnnotatePullRequestError(client)
 
 		pr := mockAzureDevOpsPullRequest(&testRepository)
-		client.GetPullRequestFunc.SetDefaultHook(func(ctx context.Context, r azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+		client.GetPullRequestFunc.SetDefaultHook(func(ctx context.Context, r azuredevops.PullRequestCommonArgs, _ azuredevops.GetPullRequestOptions) (azuredevops.PullRequest, error) {
 			assert.Equal(t, testCommonPullRequestArgs, r)
 			return *pr, nil
 		})
@@ -154,7 +154,7 @@ func TestAzureDevOpsSource_LoadChangeset(t *testing.T) {
 		mockAzureDevOpsAnnotatePullRequestSuccess(client)
 
 		pr := mockAzureDevOpsPullRequest(&testRepository)
-		client.GetPullRequestFunc.SetDefaultHook(func(ctx context.Context, r azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+		client.GetPullRequestFunc.SetDefaultHook(func(ctx context.Context, r azuredevops.PullRequestCommonArgs, _ azuredevops.GetPullRequestOptions) (azuredevops.PullRequest, error) {
 			assert.Equal(t, testCommonPullRequestArgs, r)
 			return *pr, nil
 		})
@@ -399,7 +399,7 @@ func TestAzureDevOpsSource_UpdateChangeset(t *testing.T) {
 		cs, _ := mockAzureDevOpsChangeset()
 		s, client := mockAzureDevOpsSource()
 		want := errors.New("error")
-		client.GetPullRequestFunc.SetDefaultHook(func(ctx context.Context, r azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+		client.GetPullRequestFunc.SetDefaultHook(func(ctx context.Context, r azuredevops.PullRequestCommonArgs, _ azuredevops.GetPullRequestOptions) (azuredevops.PullRequest, error) {
 			assert.Equal(t, testCommonPullRequestArgs, r)
 			return azuredevops.PullRequest{}, want
 		})
@@ -414,7 +414,7 @@ func TestAzureDevOpsSource_UpdateChangeset(t *testing.T) {
 		s, client := mockAzureDevOpsSource()
 		want := errors.New("error")
 		pr := mockAzureDevOpsPullRequest(&testRepository)
-		client.GetPullRequestFunc.SetDefaultHook(func(ctx context.Context, r azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+		client.GetPullRequestFunc.SetDefaultHook(func(ctx context.Context, r azuredevops.PullRequestCommonArgs, _ azuredevops.GetPullRequestOptions) (azuredevops.PullRequest, error) {
 			assert.Equal(t, testCommonPullRequestArgs, r)
 			return *pr, nil
 		})
@@ -436,7 +436,7 @@ func TestAzureDevOpsSource_UpdateChangeset(t *testing.T) {
 		want := mockAzureDevOpsAnnotatePullRequestError(client)
 
 		pr := mockAzureDevOpsPullRequest(&testRepository)
-		client.GetPullRequestFunc.SetDefaultHook(func(ctx context.Context, r azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+		client.GetPullRequestFunc.SetDefaultHook(func(ctx context.Context, r azuredevops.PullRequestCommonArgs, _ azuredevops.GetPullRequestOptions) (azuredevops.PullRequest, error) {
 			assert.Equal(t, testCommonPullRequestArgs, r)
 			return *pr, nil
 		})
@@ -458,7 +458,7 @@ func TestAzureDevOpsSource_UpdateChangeset(t *testing.T) {
 		mockAzureDevOpsAnnotatePullRequestSuccess(client)
 
 		pr := mockAzureDevOpsPullRequest(&testRepository)
-		client.GetPullRequestFunc.SetDefaultHook(func(ctx context.Context, r azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+		client.GetPullRequestFunc.SetDefaultHook(func(ctx context.Context, r azuredevops.PullRequestCommonArgs, _ azuredevops.GetPullRequestOptions) (azuredevops.PullRequest, error) {
 			assert.Equal(t, testCommonPullRequestArgs, r)
 			return *pr, nil
 		})
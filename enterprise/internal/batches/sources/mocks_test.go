@@ -6078,9 +6078,16 @@ type MockAzureDevOpsClient struct {
 	// AuthenticatorFunc is an instance of a mock function object
 	// controlling the behavior of the method Authenticator.
 	AuthenticatorFunc *AzureDevOpsClientAuthenticatorFunc
+	// CloseFunc is an instance of a mock function object controlling the
+	// behavior of the method Close.
+	CloseFunc *AzureDevOpsClientCloseFunc
 	// CompletePullRequestFunc is an instance of a mock function object
 	// controlling the behavior of the method CompletePullRequest.
 	CompletePullRequestFunc *AzureDevOpsClientCompletePullRequestFunc
+	// CreateOrUpdateRequiredReviewersPolicyFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// CreateOrUpdateRequiredReviewersPolicy.
+	CreateOrUpdateRequiredReviewersPolicyFunc *AzureDevOpsClientCreateOrUpdateRequiredReviewersPolicyFunc
 	// CreatePullRequestFunc is an instance of a mock function object
 	// controlling the behavior of the method CreatePullRequest.
 	CreatePullRequestFunc *AzureDevOpsClientCreatePullRequestFunc
@@ -6088,47 +6095,262 @@ type MockAzureDevOpsClient struct {
 	// object controlling the behavior of the method
 	// CreatePullRequestCommentThread.
 	CreatePullRequestCommentThreadFunc *AzureDevOpsClientCreatePullRequestCommentThreadFunc
+	// DeleteBranchFunc is an instance of a mock function object controlling
+	// the behavior of the method DeleteBranch.
+	DeleteBranchFunc *AzureDevOpsClientDeleteBranchFunc
+	// DiffRepositoriesFunc is an instance of a mock function object
+	// controlling the behavior of the method DiffRepositories.
+	DiffRepositoriesFunc *AzureDevOpsClientDiffRepositoriesFunc
+	// DoRawFunc is an instance of a mock function object controlling the
+	// behavior of the method DoRaw.
+	DoRawFunc *AzureDevOpsClientDoRawFunc
+	// DownloadBuildArtifactFunc is an instance of a mock function object
+	// controlling the behavior of the method DownloadBuildArtifact.
+	DownloadBuildArtifactFunc *AzureDevOpsClientDownloadBuildArtifactFunc
+	// DownloadRepositoryZipFunc is an instance of a mock function object
+	// controlling the behavior of the method DownloadRepositoryZip.
+	DownloadRepositoryZipFunc *AzureDevOpsClientDownloadRepositoryZipFunc
+	// EnsureSubscriptionFunc is an instance of a mock function object
+	// controlling the behavior of the method EnsureSubscription.
+	EnsureSubscriptionFunc *AzureDevOpsClientEnsureSubscriptionFunc
+	// FindRepositoryByNameFunc is an instance of a mock function object
+	// controlling the behavior of the method FindRepositoryByName.
+	FindRepositoryByNameFunc *AzureDevOpsClientFindRepositoryByNameFunc
 	// ForkRepositoryFunc is an instance of a mock function object
 	// controlling the behavior of the method ForkRepository.
 	ForkRepositoryFunc *AzureDevOpsClientForkRepositoryFunc
 	// GetAuthorizedProfileFunc is an instance of a mock function object
 	// controlling the behavior of the method GetAuthorizedProfile.
 	GetAuthorizedProfileFunc *AzureDevOpsClientGetAuthorizedProfileFunc
+	// GetCommitFunc is an instance of a mock function object controlling
+	// the behavior of the method GetCommit.
+	GetCommitFunc *AzureDevOpsClientGetCommitFunc
+	// GetCommitsBatchFunc is an instance of a mock function object
+	// controlling the behavior of the method GetCommitsBatch.
+	GetCommitsBatchFunc *AzureDevOpsClientGetCommitsBatchFunc
+	// GetItemFunc is an instance of a mock function object controlling the
+	// behavior of the method GetItem.
+	GetItemFunc *AzureDevOpsClientGetItemFunc
+	// GetMergeBaseFunc is an instance of a mock function object controlling
+	// the behavior of the method GetMergeBase.
+	GetMergeBaseFunc *AzureDevOpsClientGetMergeBaseFunc
+	// GetMergeBasesFunc is an instance of a mock function object
+	// controlling the behavior of the method GetMergeBases.
+	GetMergeBasesFunc *AzureDevOpsClientGetMergeBasesFunc
+	// GetPipelineRunFunc is an instance of a mock function object
+	// controlling the behavior of the method GetPipelineRun.
+	GetPipelineRunFunc *AzureDevOpsClientGetPipelineRunFunc
 	// GetProjectFunc is an instance of a mock function object controlling
 	// the behavior of the method GetProject.
 	GetProjectFunc *AzureDevOpsClientGetProjectFunc
+	// GetProjectIDFunc is an instance of a mock function object controlling
+	// the behavior of the method GetProjectID.
+	GetProjectIDFunc *AzureDevOpsClientGetProjectIDFunc
 	// GetPullRequestFunc is an instance of a mock function object
 	// controlling the behavior of the method GetPullRequest.
 	GetPullRequestFunc *AzureDevOpsClientGetPullRequestFunc
+	// GetPullRequestByRefsFunc is an instance of a mock function object
+	// controlling the behavior of the method GetPullRequestByRefs.
+	GetPullRequestByRefsFunc *AzureDevOpsClientGetPullRequestByRefsFunc
+	// GetPullRequestPropertiesFunc is an instance of a mock function object
+	// controlling the behavior of the method GetPullRequestProperties.
+	GetPullRequestPropertiesFunc *AzureDevOpsClientGetPullRequestPropertiesFunc
 	// GetPullRequestStatusesFunc is an instance of a mock function object
 	// controlling the behavior of the method GetPullRequestStatuses.
 	GetPullRequestStatusesFunc *AzureDevOpsClientGetPullRequestStatusesFunc
+	// GetPullRequestsBatchFunc is an instance of a mock function object
+	// controlling the behavior of the method GetPullRequestsBatch.
+	GetPullRequestsBatchFunc *AzureDevOpsClientGetPullRequestsBatchFunc
+	// GetPushChangesFunc is an instance of a mock function object
+	// controlling the behavior of the method GetPushChanges.
+	GetPushChangesFunc *AzureDevOpsClientGetPushChangesFunc
+	// GetReadmeFunc is an instance of a mock function object controlling
+	// the behavior of the method GetReadme.
+	GetReadmeFunc *AzureDevOpsClientGetReadmeFunc
 	// GetRepoFunc is an instance of a mock function object controlling the
 	// behavior of the method GetRepo.
 	GetRepoFunc *AzureDevOpsClientGetRepoFunc
 	// GetRepositoryBranchFunc is an instance of a mock function object
 	// controlling the behavior of the method GetRepositoryBranch.
 	GetRepositoryBranchFunc *AzureDevOpsClientGetRepositoryBranchFunc
+	// GetRepositorySizeFunc is an instance of a mock function object
+	// controlling the behavior of the method GetRepositorySize.
+	GetRepositorySizeFunc *AzureDevOpsClientGetRepositorySizeFunc
+	// GetResourceAreasFunc is an instance of a mock function object
+	// controlling the behavior of the method GetResourceAreas.
+	GetResourceAreasFunc *AzureDevOpsClientGetResourceAreasFunc
+	// GetSecurityNamespaceIDFunc is an instance of a mock function object
+	// controlling the behavior of the method GetSecurityNamespaceID.
+	GetSecurityNamespaceIDFunc *AzureDevOpsClientGetSecurityNamespaceIDFunc
+	// GetTokenInfoFunc is an instance of a mock function object controlling
+	// the behavior of the method GetTokenInfo.
+	GetTokenInfoFunc *AzureDevOpsClientGetTokenInfoFunc
 	// GetURLFunc is an instance of a mock function object controlling the
 	// behavior of the method GetURL.
 	GetURLFunc *AzureDevOpsClientGetURLFunc
+	// InspectCredentialsFunc is an instance of a mock function object
+	// controlling the behavior of the method InspectCredentials.
+	InspectCredentialsFunc *AzureDevOpsClientInspectCredentialsFunc
 	// IsAzureDevOpsServicesFunc is an instance of a mock function object
 	// controlling the behavior of the method IsAzureDevOpsServices.
 	IsAzureDevOpsServicesFunc *AzureDevOpsClientIsAzureDevOpsServicesFunc
+	// LikeCommentFunc is an instance of a mock function object controlling
+	// the behavior of the method LikeComment.
+	LikeCommentFunc *AzureDevOpsClientLikeCommentFunc
+	// ListAccessibleOrgsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListAccessibleOrgs.
+	ListAccessibleOrgsFunc *AzureDevOpsClientListAccessibleOrgsFunc
+	// ListAccessibleRepositoriesFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// ListAccessibleRepositories.
+	ListAccessibleRepositoriesFunc *AzureDevOpsClientListAccessibleRepositoriesFunc
 	// ListAuthorizedUserOrganizationsFunc is an instance of a mock function
 	// object controlling the behavior of the method
 	// ListAuthorizedUserOrganizations.
 	ListAuthorizedUserOrganizationsFunc *AzureDevOpsClientListAuthorizedUserOrganizationsFunc
+	// ListBranchPoliciesFunc is an instance of a mock function object
+	// controlling the behavior of the method ListBranchPolicies.
+	ListBranchPoliciesFunc *AzureDevOpsClientListBranchPoliciesFunc
+	// ListBranchesWithStatsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListBranchesWithStats.
+	ListBranchesWithStatsFunc *AzureDevOpsClientListBranchesWithStatsFunc
+	// ListBuildArtifactsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListBuildArtifacts.
+	ListBuildArtifactsFunc *AzureDevOpsClientListBuildArtifactsFunc
+	// ListBuildDefinitionsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListBuildDefinitions.
+	ListBuildDefinitionsFunc *AzureDevOpsClientListBuildDefinitionsFunc
+	// ListCommentLikesFunc is an instance of a mock function object
+	// controlling the behavior of the method ListCommentLikes.
+	ListCommentLikesFunc *AzureDevOpsClientListCommentLikesFunc
+	// ListCommitsFunc is an instance of a mock function object controlling
+	// the behavior of the method ListCommits.
+	ListCommitsFunc *AzureDevOpsClientListCommitsFunc
+	// ListCommitsByProjectFunc is an instance of a mock function object
+	// controlling the behavior of the method ListCommitsByProject.
+	ListCommitsByProjectFunc *AzureDevOpsClientListCommitsByProjectFunc
+	// ListDefaultReviewersFunc is an instance of a mock function object
+	// controlling the behavior of the method ListDefaultReviewers.
+	ListDefaultReviewersFunc *AzureDevOpsClientListDefaultReviewersFunc
+	// ListItemsFunc is an instance of a mock function object controlling
+	// the behavior of the method ListItems.
+	ListItemsFunc *AzureDevOpsClientListItemsFunc
+	// ListPipelinesFunc is an instance of a mock function object
+	// controlling the behavior of the method ListPipelines.
+	ListPipelinesFunc *AzureDevOpsClientListPipelinesFunc
+	// ListPolicyEvaluationsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListPolicyEvaluations.
+	ListPolicyEvaluationsFunc *AzureDevOpsClientListPolicyEvaluationsFunc
+	// ListPullRequestCommentsUpdatedSinceFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// ListPullRequestCommentsUpdatedSince.
+	ListPullRequestCommentsUpdatedSinceFunc *AzureDevOpsClientListPullRequestCommentsUpdatedSinceFunc
+	// ListPullRequestInlineCommentsFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// ListPullRequestInlineComments.
+	ListPullRequestInlineCommentsFunc *AzureDevOpsClientListPullRequestInlineCommentsFunc
+	// ListPullRequestReviewersFunc is an instance of a mock function object
+	// controlling the behavior of the method ListPullRequestReviewers.
+	ListPullRequestReviewersFunc *AzureDevOpsClientListPullRequestReviewersFunc
+	// ListPullRequestThreadsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListPullRequestThreads.
+	ListPullRequestThreadsFunc *AzureDevOpsClientListPullRequestThreadsFunc
+	// ListPullRequestThreadsUpdatedSinceFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// ListPullRequestThreadsUpdatedSince.
+	ListPullRequestThreadsUpdatedSinceFunc *AzureDevOpsClientListPullRequestThreadsUpdatedSinceFunc
+	// ListPullRequestsFunc is an instance of a mock function object
+	// controlling the behavior of the method ListPullRequests.
+	ListPullRequestsFunc *AzureDevOpsClientListPullRequestsFunc
+	// ListRefsFunc is an instance of a mock function object controlling the
+	// behavior of the method ListRefs.
+	ListRefsFunc *AzureDevOpsClientListRefsFunc
 	// ListRepositoriesByProjectOrOrgFunc is an instance of a mock function
 	// object controlling the behavior of the method
 	// ListRepositoriesByProjectOrOrg.
 	ListRepositoriesByProjectOrOrgFunc *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc
+	// ListRepositoriesByProjectsOrOrgsFunc is an instance of a mock
+	// function object controlling the behavior of the method
+	// ListRepositoriesByProjectsOrOrgs.
+	ListRepositoriesByProjectsOrOrgsFunc *AzureDevOpsClientListRepositoriesByProjectsOrOrgsFunc
+	// ListRepositoriesPageFunc is an instance of a mock function object
+	// controlling the behavior of the method ListRepositoriesPage.
+	ListRepositoriesPageFunc *AzureDevOpsClientListRepositoriesPageFunc
+	// PullRequestApprovalStateFunc is an instance of a mock function object
+	// controlling the behavior of the method PullRequestApprovalState.
+	PullRequestApprovalStateFunc *AzureDevOpsClientPullRequestApprovalStateFunc
+	// QueryAuditLogFunc is an instance of a mock function object
+	// controlling the behavior of the method QueryAuditLog.
+	QueryAuditLogFunc *AzureDevOpsClientQueryAuditLogFunc
+	// QueryCommitsBatchFunc is an instance of a mock function object
+	// controlling the behavior of the method QueryCommitsBatch.
+	QueryCommitsBatchFunc *AzureDevOpsClientQueryCommitsBatchFunc
+	// ReactivatePullRequestFunc is an instance of a mock function object
+	// controlling the behavior of the method ReactivatePullRequest.
+	ReactivatePullRequestFunc *AzureDevOpsClientReactivatePullRequestFunc
+	// RemovePullRequestReviewerFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// RemovePullRequestReviewer.
+	RemovePullRequestReviewerFunc *AzureDevOpsClientRemovePullRequestReviewerFunc
+	// ResolveCommitAuthorsFunc is an instance of a mock function object
+	// controlling the behavior of the method ResolveCommitAuthors.
+	ResolveCommitAuthorsFunc *AzureDevOpsClientResolveCommitAuthorsFunc
+	// RunPipelineFunc is an instance of a mock function object controlling
+	// the behavior of the method RunPipeline.
+	RunPipelineFunc *AzureDevOpsClientRunPipelineFunc
+	// SetAPIVersionFunc is an instance of a mock function object
+	// controlling the behavior of the method SetAPIVersion.
+	SetAPIVersionFunc *AzureDevOpsClientSetAPIVersionFunc
+	// SetCaptureRawJSONFunc is an instance of a mock function object
+	// controlling the behavior of the method SetCaptureRawJSON.
+	SetCaptureRawJSONFunc *AzureDevOpsClientSetCaptureRawJSONFunc
+	// SetFollowRedirectsFunc is an instance of a mock function object
+	// controlling the behavior of the method SetFollowRedirects.
+	SetFollowRedirectsFunc *AzureDevOpsClientSetFollowRedirectsFunc
+	// SetJobIDMetricLabelFunc is an instance of a mock function object
+	// controlling the behavior of the method SetJobIDMetricLabel.
+	SetJobIDMetricLabelFunc *AzureDevOpsClientSetJobIDMetricLabelFunc
+	// SetProbeNotFoundFunc is an instance of a mock function object
+	// controlling the behavior of the method SetProbeNotFound.
+	SetProbeNotFoundFunc *AzureDevOpsClientSetProbeNotFoundFunc
+	// SetProjectIDCacheTTLFunc is an instance of a mock function object
+	// controlling the behavior of the method SetProjectIDCacheTTL.
+	SetProjectIDCacheTTLFunc *AzureDevOpsClientSetProjectIDCacheTTLFunc
+	// SetPullRequestAutoCompleteFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// SetPullRequestAutoComplete.
+	SetPullRequestAutoCompleteFunc *AzureDevOpsClientSetPullRequestAutoCompleteFunc
+	// SetPullRequestDraftFunc is an instance of a mock function object
+	// controlling the behavior of the method SetPullRequestDraft.
+	SetPullRequestDraftFunc *AzureDevOpsClientSetPullRequestDraftFunc
+	// SetPullRequestPropertiesFunc is an instance of a mock function object
+	// controlling the behavior of the method SetPullRequestProperties.
+	SetPullRequestPropertiesFunc *AzureDevOpsClientSetPullRequestPropertiesFunc
+	// SetStrictDecodeFunc is an instance of a mock function object
+	// controlling the behavior of the method SetStrictDecode.
+	SetStrictDecodeFunc *AzureDevOpsClientSetStrictDecodeFunc
 	// SetWaitForRateLimitFunc is an instance of a mock function object
 	// controlling the behavior of the method SetWaitForRateLimit.
 	SetWaitForRateLimitFunc *AzureDevOpsClientSetWaitForRateLimitFunc
+	// StatItemFunc is an instance of a mock function object controlling the
+	// behavior of the method StatItem.
+	StatItemFunc *AzureDevOpsClientStatItemFunc
+	// UnlikeCommentFunc is an instance of a mock function object
+	// controlling the behavior of the method UnlikeComment.
+	UnlikeCommentFunc *AzureDevOpsClientUnlikeCommentFunc
 	// UpdatePullRequestFunc is an instance of a mock function object
 	// controlling the behavior of the method UpdatePullRequest.
 	UpdatePullRequestFunc *AzureDevOpsClientUpdatePullRequestFunc
+	// UpdateRefsFunc is an instance of a mock function object controlling
+	// the behavior of the method UpdateRefs.
+	UpdateRefsFunc *AzureDevOpsClientUpdateRefsFunc
+	// UpdateRepositoryFunc is an instance of a mock function object
+	// controlling the behavior of the method UpdateRepository.
+	UpdateRepositoryFunc *AzureDevOpsClientUpdateRepositoryFunc
+	// UploadPullRequestAttachmentFunc is an instance of a mock function
+	// object controlling the behavior of the method
+	// UploadPullRequestAttachment.
+	UploadPullRequestAttachmentFunc *AzureDevOpsClientUploadPullRequestAttachmentFunc
 	// WithAuthenticatorFunc is an instance of a mock function object
 	// controlling the behavior of the method WithAuthenticator.
 	WithAuthenticatorFunc *AzureDevOpsClientWithAuthenticatorFunc
@@ -6148,11 +6370,21 @@ func NewMockAzureDevOpsClient() *MockAzureDevOpsClient {
 				return
 			},
 		},
+		CloseFunc: &AzureDevOpsClientCloseFunc{
+			defaultHook: func() (r0 error) {
+				return
+			},
+		},
 		CompletePullRequestFunc: &AzureDevOpsClientCompletePullRequestFunc{
 			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (r0 azuredevops.PullRequest, r1 error) {
 				return
 			},
 		},
+		CreateOrUpdateRequiredReviewersPolicyFunc: &AzureDevOpsClientCreateOrUpdateRequiredReviewersPolicyFunc{
+			defaultHook: func(context.Context, string, string, azuredevops.RequiredReviewersPolicyInput) (r0 azuredevops.PolicyConfiguration, r1 error) {
+				return
+			},
+		},
 		CreatePullRequestFunc: &AzureDevOpsClientCreatePullRequestFunc{
 			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (r0 azuredevops.PullRequest, r1 error) {
 				return
@@ -6163,6 +6395,41 @@ func NewMockAzureDevOpsClient() *MockAzureDevOpsClient {
 				return
 			},
 		},
+		DeleteBranchFunc: &AzureDevOpsClientDeleteBranchFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, string) (r0 error) {
+				return
+			},
+		},
+		DiffRepositoriesFunc: &AzureDevOpsClientDiffRepositoriesFunc{
+			defaultHook: func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs, []string) (r0 []string, r1 []string, r2 error) {
+				return
+			},
+		},
+		DoRawFunc: &AzureDevOpsClientDoRawFunc{
+			defaultHook: func(context.Context, *http.Request, interface{}) (r0 *http.Response, r1 error) {
+				return
+			},
+		},
+		DownloadBuildArtifactFunc: &AzureDevOpsClientDownloadBuildArtifactFunc{
+			defaultHook: func(context.Context, azuredevops.BuildArtifact) (r0 io.ReadCloser, r1 error) {
+				return
+			},
+		},
+		DownloadRepositoryZipFunc: &AzureDevOpsClientDownloadRepositoryZipFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, *azuredevops.GitVersionDescriptor) (r0 io.ReadCloser, r1 error) {
+				return
+			},
+		},
+		EnsureSubscriptionFunc: &AzureDevOpsClientEnsureSubscriptionFunc{
+			defaultHook: func(context.Context, azuredevops.EnsureSubscriptionInput) (r0 *azuredevops.Subscription, r1 error) {
+				return
+			},
+		},
+		FindRepositoryByNameFunc: &AzureDevOpsClientFindRepositoryByNameFunc{
+			defaultHook: func(context.Context, string, string) (r0 azuredevops.Repository, r1 error) {
+				return
+			},
+		},
 		ForkRepositoryFunc: &AzureDevOpsClientForkRepositoryFunc{
 			defaultHook: func(context.Context, string, azuredevops.ForkRepositoryInput) (r0 azuredevops.Repository, r1 error) {
 				return
@@ -6173,13 +6440,58 @@ func NewMockAzureDevOpsClient() *MockAzureDevOpsClient {
 				return
 			},
 		},
+		GetCommitFunc: &AzureDevOpsClientGetCommitFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, string) (r0 azuredevops.Commit, r1 error) {
+				return
+			},
+		},
+		GetCommitsBatchFunc: &AzureDevOpsClientGetCommitsBatchFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, []string) (r0 []azuredevops.Commit, r1 error) {
+				return
+			},
+		},
+		GetItemFunc: &AzureDevOpsClientGetItemFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, string, *azuredevops.GitVersionDescriptor) (r0 azuredevops.Item, r1 error) {
+				return
+			},
+		},
+		GetMergeBaseFunc: &AzureDevOpsClientGetMergeBaseFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) (r0 string, r1 error) {
+				return
+			},
+		},
+		GetMergeBasesFunc: &AzureDevOpsClientGetMergeBasesFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) (r0 []string, r1 error) {
+				return
+			},
+		},
+		GetPipelineRunFunc: &AzureDevOpsClientGetPipelineRunFunc{
+			defaultHook: func(context.Context, string, string, int, int) (r0 azuredevops.PipelineRun, r1 error) {
+				return
+			},
+		},
 		GetProjectFunc: &AzureDevOpsClientGetProjectFunc{
 			defaultHook: func(context.Context, string, string) (r0 azuredevops.Project, r1 error) {
 				return
 			},
 		},
+		GetProjectIDFunc: &AzureDevOpsClientGetProjectIDFunc{
+			defaultHook: func(context.Context, string, string) (r0 string, r1 error) {
+				return
+			},
+		},
 		GetPullRequestFunc: &AzureDevOpsClientGetPullRequestFunc{
-			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs) (r0 azuredevops.PullRequest, r1 error) {
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.GetPullRequestOptions) (r0 azuredevops.PullRequest, r1 error) {
+				return
+			},
+		},
+		GetPullRequestByRefsFunc: &AzureDevOpsClientGetPullRequestByRefsFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) (r0 azuredevops.PullRequest, r1 error) {
+				return
+			},
+		},
+		GetPullRequestPropertiesFunc: &AzureDevOpsClientGetPullRequestPropertiesFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs) (r0 map[string]azuredevops.PropertyValue, r1 error) {
 				return
 			},
 		},
@@ -6188,6 +6500,21 @@ func NewMockAzureDevOpsClient() *MockAzureDevOpsClient {
 				return
 			},
 		},
+		GetPullRequestsBatchFunc: &AzureDevOpsClientGetPullRequestsBatchFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, []string, azuredevops.GetPullRequestsBatchOptions) (r0 azuredevops.GetPullRequestsBatchResult, r1 error) {
+				return
+			},
+		},
+		GetPushChangesFunc: &AzureDevOpsClientGetPushChangesFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, int) (r0 []azuredevops.Change, r1 error) {
+				return
+			},
+		},
+		GetReadmeFunc: &AzureDevOpsClientGetReadmeFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs) (r0 []byte, r1 string, r2 error) {
+				return
+			},
+		},
 		GetRepoFunc: &AzureDevOpsClientGetRepoFunc{
 			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs) (r0 azuredevops.Repository, r1 error) {
 				return
@@ -6198,36 +6525,286 @@ func NewMockAzureDevOpsClient() *MockAzureDevOpsClient {
 				return
 			},
 		},
+		GetRepositorySizeFunc: &AzureDevOpsClientGetRepositorySizeFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs) (r0 int64, r1 bool, r2 error) {
+				return
+			},
+		},
+		GetResourceAreasFunc: &AzureDevOpsClientGetResourceAreasFunc{
+			defaultHook: func(context.Context) (r0 map[string]string, r1 error) {
+				return
+			},
+		},
+		GetSecurityNamespaceIDFunc: &AzureDevOpsClientGetSecurityNamespaceIDFunc{
+			defaultHook: func(context.Context, string, string) (r0 string, r1 error) {
+				return
+			},
+		},
+		GetTokenInfoFunc: &AzureDevOpsClientGetTokenInfoFunc{
+			defaultHook: func(context.Context) (r0 *azuredevops.TokenInfo, r1 error) {
+				return
+			},
+		},
 		GetURLFunc: &AzureDevOpsClientGetURLFunc{
 			defaultHook: func() (r0 *url.URL) {
 				return
 			},
 		},
+		InspectCredentialsFunc: &AzureDevOpsClientInspectCredentialsFunc{
+			defaultHook: func(context.Context, string) (r0 azuredevops.CredentialsInfo, r1 error) {
+				return
+			},
+		},
 		IsAzureDevOpsServicesFunc: &AzureDevOpsClientIsAzureDevOpsServicesFunc{
 			defaultHook: func() (r0 bool) {
 				return
 			},
 		},
+		LikeCommentFunc: &AzureDevOpsClientLikeCommentFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommentArgs) (r0 error) {
+				return
+			},
+		},
+		ListAccessibleOrgsFunc: &AzureDevOpsClientListAccessibleOrgsFunc{
+			defaultHook: func(context.Context) (r0 []azuredevops.Org, r1 error) {
+				return
+			},
+		},
+		ListAccessibleRepositoriesFunc: &AzureDevOpsClientListAccessibleRepositoriesFunc{
+			defaultHook: func(context.Context, string, string, azuredevops.ListAccessibleRepositoriesOptions) (r0 []azuredevops.Repository, r1 error) {
+				return
+			},
+		},
 		ListAuthorizedUserOrganizationsFunc: &AzureDevOpsClientListAuthorizedUserOrganizationsFunc{
 			defaultHook: func(context.Context, azuredevops.Profile) (r0 []azuredevops.Org, r1 error) {
 				return
 			},
 		},
+		ListBranchPoliciesFunc: &AzureDevOpsClientListBranchPoliciesFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, string) (r0 []azuredevops.PolicyConfiguration, r1 error) {
+				return
+			},
+		},
+		ListBranchesWithStatsFunc: &AzureDevOpsClientListBranchesWithStatsFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListBranchesWithStatsOptions) (r0 []azuredevops.BranchStat, r1 error) {
+				return
+			},
+		},
+		ListBuildArtifactsFunc: &AzureDevOpsClientListBuildArtifactsFunc{
+			defaultHook: func(context.Context, string, string, int) (r0 []azuredevops.BuildArtifact, r1 error) {
+				return
+			},
+		},
+		ListBuildDefinitionsFunc: &AzureDevOpsClientListBuildDefinitionsFunc{
+			defaultHook: func(context.Context, string, string, azuredevops.ListBuildDefinitionsOptions) (r0 []azuredevops.BuildDefinition, r1 error) {
+				return
+			},
+		},
+		ListCommentLikesFunc: &AzureDevOpsClientListCommentLikesFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommentArgs) (r0 []azuredevops.CreatorInfo, r1 error) {
+				return
+			},
+		},
+		ListCommitsFunc: &AzureDevOpsClientListCommitsFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListCommitsCriteria) (r0 []azuredevops.Commit, r1 error) {
+				return
+			},
+		},
+		ListCommitsByProjectFunc: &AzureDevOpsClientListCommitsByProjectFunc{
+			defaultHook: func(context.Context, string, string, azuredevops.ListCommitsByProjectOptions) (r0 []azuredevops.ProjectCommit, r1 error) {
+				return
+			},
+		},
+		ListDefaultReviewersFunc: &AzureDevOpsClientListDefaultReviewersFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs) (r0 []azuredevops.RequiredReviewer, r1 error) {
+				return
+			},
+		},
+		ListItemsFunc: &AzureDevOpsClientListItemsFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListItemsOptions) (r0 []azuredevops.Item, r1 error) {
+				return
+			},
+		},
+		ListPipelinesFunc: &AzureDevOpsClientListPipelinesFunc{
+			defaultHook: func(context.Context, string, string, azuredevops.ListPipelinesOptions) (r0 []azuredevops.Pipeline, r1 error) {
+				return
+			},
+		},
+		ListPolicyEvaluationsFunc: &AzureDevOpsClientListPolicyEvaluationsFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs) (r0 []azuredevops.PolicyEvaluation, r1 error) {
+				return
+			},
+		},
+		ListPullRequestCommentsUpdatedSinceFunc: &AzureDevOpsClientListPullRequestCommentsUpdatedSinceFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, time.Time) (r0 []azuredevops.InlineComment, r1 time.Time, r2 error) {
+				return
+			},
+		},
+		ListPullRequestInlineCommentsFunc: &AzureDevOpsClientListPullRequestInlineCommentsFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs) (r0 []azuredevops.InlineComment, r1 error) {
+				return
+			},
+		},
+		ListPullRequestReviewersFunc: &AzureDevOpsClientListPullRequestReviewersFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.ListPullRequestReviewersOptions) (r0 []azuredevops.Reviewer, r1 error) {
+				return
+			},
+		},
+		ListPullRequestThreadsFunc: &AzureDevOpsClientListPullRequestThreadsFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.ListPullRequestThreadsOptions) (r0 []azuredevops.PullRequestCommentResponse, r1 error) {
+				return
+			},
+		},
+		ListPullRequestThreadsUpdatedSinceFunc: &AzureDevOpsClientListPullRequestThreadsUpdatedSinceFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, time.Time, azuredevops.ListPullRequestThreadsOptions) (r0 []azuredevops.PullRequestCommentResponse, r1 time.Time, r2 error) {
+				return
+			},
+		},
+		ListPullRequestsFunc: &AzureDevOpsClientListPullRequestsFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.PullRequestSearchCriteria) (r0 []azuredevops.PullRequest, r1 error) {
+				return
+			},
+		},
+		ListRefsFunc: &AzureDevOpsClientListRefsFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListRefsOptions) (r0 []azuredevops.Ref, r1 error) {
+				return
+			},
+		},
 		ListRepositoriesByProjectOrOrgFunc: &AzureDevOpsClientListRepositoriesByProjectOrOrgFunc{
 			defaultHook: func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) (r0 []azuredevops.Repository, r1 error) {
 				return
 			},
 		},
+		ListRepositoriesByProjectsOrOrgsFunc: &AzureDevOpsClientListRepositoriesByProjectsOrOrgsFunc{
+			defaultHook: func(context.Context, []string) (r0 azuredevops.ListRepositoriesResult, r1 error) {
+				return
+			},
+		},
+		ListRepositoriesPageFunc: &AzureDevOpsClientListRepositoriesPageFunc{
+			defaultHook: func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) (r0 azuredevops.RepositoriesPage, r1 error) {
+				return
+			},
+		},
+		PullRequestApprovalStateFunc: &AzureDevOpsClientPullRequestApprovalStateFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs) (r0 azuredevops.ApprovalState, r1 error) {
+				return
+			},
+		},
+		QueryAuditLogFunc: &AzureDevOpsClientQueryAuditLogFunc{
+			defaultHook: func(context.Context, azuredevops.QueryAuditLogInput) (r0 []azuredevops.AuditLogEntry, r1 error) {
+				return
+			},
+		},
+		QueryCommitsBatchFunc: &AzureDevOpsClientQueryCommitsBatchFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.QueryCommitsCriteria) (r0 []azuredevops.Commit, r1 error) {
+				return
+			},
+		},
+		ReactivatePullRequestFunc: &AzureDevOpsClientReactivatePullRequestFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs) (r0 azuredevops.PullRequest, r1 error) {
+				return
+			},
+		},
+		RemovePullRequestReviewerFunc: &AzureDevOpsClientRemovePullRequestReviewerFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, string) (r0 error) {
+				return
+			},
+		},
+		ResolveCommitAuthorsFunc: &AzureDevOpsClientResolveCommitAuthorsFunc{
+			defaultHook: func(context.Context, string, []string) (r0 map[string]azuredevops.User, r1 error) {
+				return
+			},
+		},
+		RunPipelineFunc: &AzureDevOpsClientRunPipelineFunc{
+			defaultHook: func(context.Context, string, string, azuredevops.RunPipelineInput) (r0 azuredevops.PipelineRun, r1 error) {
+				return
+			},
+		},
+		SetAPIVersionFunc: &AzureDevOpsClientSetAPIVersionFunc{
+			defaultHook: func(string) {
+				return
+			},
+		},
+		SetCaptureRawJSONFunc: &AzureDevOpsClientSetCaptureRawJSONFunc{
+			defaultHook: func(bool) {
+				return
+			},
+		},
+		SetFollowRedirectsFunc: &AzureDevOpsClientSetFollowRedirectsFunc{
+			defaultHook: func(bool) {
+				return
+			},
+		},
+		SetJobIDMetricLabelFunc: &AzureDevOpsClientSetJobIDMetricLabelFunc{
+			defaultHook: func(bool) {
+				return
+			},
+		},
+		SetProbeNotFoundFunc: &AzureDevOpsClientSetProbeNotFoundFunc{
+			defaultHook: func(bool) {
+				return
+			},
+		},
+		SetProjectIDCacheTTLFunc: &AzureDevOpsClientSetProjectIDCacheTTLFunc{
+			defaultHook: func(time.Duration) {
+				return
+			},
+		},
+		SetPullRequestAutoCompleteFunc: &AzureDevOpsClientSetPullRequestAutoCompleteFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestAutoCompleteInput) (r0 azuredevops.PullRequest, r1 error) {
+				return
+			},
+		},
+		SetPullRequestDraftFunc: &AzureDevOpsClientSetPullRequestDraftFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, bool) (r0 azuredevops.PullRequest, r1 error) {
+				return
+			},
+		},
+		SetPullRequestPropertiesFunc: &AzureDevOpsClientSetPullRequestPropertiesFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, []azuredevops.JSONPatchOperation) (r0 map[string]azuredevops.PropertyValue, r1 error) {
+				return
+			},
+		},
+		SetStrictDecodeFunc: &AzureDevOpsClientSetStrictDecodeFunc{
+			defaultHook: func(bool) {
+				return
+			},
+		},
 		SetWaitForRateLimitFunc: &AzureDevOpsClientSetWaitForRateLimitFunc{
 			defaultHook: func(bool) {
 				return
 			},
 		},
+		StatItemFunc: &AzureDevOpsClientStatItemFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, string, *azuredevops.GitVersionDescriptor) (r0 azuredevops.Item, r1 error) {
+				return
+			},
+		},
+		UnlikeCommentFunc: &AzureDevOpsClientUnlikeCommentFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommentArgs) (r0 error) {
+				return
+			},
+		},
 		UpdatePullRequestFunc: &AzureDevOpsClientUpdatePullRequestFunc{
 			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (r0 azuredevops.PullRequest, r1 error) {
 				return
 			},
 		},
+		UpdateRefsFunc: &AzureDevOpsClientUpdateRefsFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, []azuredevops.RefUpdate) (r0 []azuredevops.RefUpdateResult, r1 error) {
+				return
+			},
+		},
+		UpdateRepositoryFunc: &AzureDevOpsClientUpdateRepositoryFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.UpdateRepositoryInput) (r0 azuredevops.Repository, r1 error) {
+				return
+			},
+		},
+		UploadPullRequestAttachmentFunc: &AzureDevOpsClientUploadPullRequestAttachmentFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, string, io.Reader) (r0 azuredevops.Attachment, r1 error) {
+				return
+			},
+		},
 		WithAuthenticatorFunc: &AzureDevOpsClientWithAuthenticatorFunc{
 			defaultHook: func(auth.Authenticator) (r0 azuredevops.Client, r1 error) {
 				return
@@ -6250,11 +6827,21 @@ func NewStrictMockAzureDevOpsClient() *MockAzureDevOpsClient {
 				panic("unexpected invocation of MockAzureDevOpsClient.Authenticator")
 			},
 		},
+		CloseFunc: &AzureDevOpsClientCloseFunc{
+			defaultHook: func() error {
+				panic("unexpected invocation of MockAzureDevOpsClient.Close")
+			},
+		},
 		CompletePullRequestFunc: &AzureDevOpsClientCompletePullRequestFunc{
 			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error) {
 				panic("unexpected invocation of MockAzureDevOpsClient.CompletePullRequest")
 			},
 		},
+		CreateOrUpdateRequiredReviewersPolicyFunc: &AzureDevOpsClientCreateOrUpdateRequiredReviewersPolicyFunc{
+			defaultHook: func(context.Context, string, string, azuredevops.RequiredReviewersPolicyInput) (azuredevops.PolicyConfiguration, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.CreateOrUpdateRequiredReviewersPolicy")
+			},
+		},
 		CreatePullRequestFunc: &AzureDevOpsClientCreatePullRequestFunc{
 			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error) {
 				panic("unexpected invocation of MockAzureDevOpsClient.CreatePullRequest")
@@ -6265,6 +6852,41 @@ func NewStrictMockAzureDevOpsClient() *MockAzureDevOpsClient {
 				panic("unexpected invocation of MockAzureDevOpsClient.CreatePullRequestCommentThread")
 			},
 		},
+		DeleteBranchFunc: &AzureDevOpsClientDeleteBranchFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, string) error {
+				panic("unexpected invocation of MockAzureDevOpsClient.DeleteBranch")
+			},
+		},
+		DiffRepositoriesFunc: &AzureDevOpsClientDiffRepositoriesFunc{
+			defaultHook: func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs, []string) ([]string, []string, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.DiffRepositories")
+			},
+		},
+		DoRawFunc: &AzureDevOpsClientDoRawFunc{
+			defaultHook: func(context.Context, *http.Request, interface{}) (*http.Response, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.DoRaw")
+			},
+		},
+		DownloadBuildArtifactFunc: &AzureDevOpsClientDownloadBuildArtifactFunc{
+			defaultHook: func(context.Context, azuredevops.BuildArtifact) (io.ReadCloser, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.DownloadBuildArtifact")
+			},
+		},
+		DownloadRepositoryZipFunc: &AzureDevOpsClientDownloadRepositoryZipFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, *azuredevops.GitVersionDescriptor) (io.ReadCloser, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.DownloadRepositoryZip")
+			},
+		},
+		EnsureSubscriptionFunc: &AzureDevOpsClientEnsureSubscriptionFunc{
+			defaultHook: func(context.Context, azuredevops.EnsureSubscriptionInput) (*azuredevops.Subscription, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.EnsureSubscription")
+			},
+		},
+		FindRepositoryByNameFunc: &AzureDevOpsClientFindRepositoryByNameFunc{
+			defaultHook: func(context.Context, string, string) (azuredevops.Repository, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.FindRepositoryByName")
+			},
+		},
 		ForkRepositoryFunc: &AzureDevOpsClientForkRepositoryFunc{
 			defaultHook: func(context.Context, string, azuredevops.ForkRepositoryInput) (azuredevops.Repository, error) {
 				panic("unexpected invocation of MockAzureDevOpsClient.ForkRepository")
@@ -6275,19 +6897,79 @@ func NewStrictMockAzureDevOpsClient() *MockAzureDevOpsClient {
 				panic("unexpected invocation of MockAzureDevOpsClient.GetAuthorizedProfile")
 			},
 		},
-		GetProjectFunc: &AzureDevOpsClientGetProjectFunc{
-			defaultHook: func(context.Context, string, string) (azuredevops.Project, error) {
-				panic("unexpected invocation of MockAzureDevOpsClient.GetProject")
+		GetCommitFunc: &AzureDevOpsClientGetCommitFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, string) (azuredevops.Commit, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.GetCommit")
 			},
 		},
-		GetPullRequestFunc: &AzureDevOpsClientGetPullRequestFunc{
-			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
-				panic("unexpected invocation of MockAzureDevOpsClient.GetPullRequest")
+		GetCommitsBatchFunc: &AzureDevOpsClientGetCommitsBatchFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, []string) ([]azuredevops.Commit, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.GetCommitsBatch")
 			},
 		},
-		GetPullRequestStatusesFunc: &AzureDevOpsClientGetPullRequestStatusesFunc{
-			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error) {
-				panic("unexpected invocation of MockAzureDevOpsClient.GetPullRequestStatuses")
+		GetItemFunc: &AzureDevOpsClientGetItemFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, string, *azuredevops.GitVersionDescriptor) (azuredevops.Item, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.GetItem")
+			},
+		},
+		GetMergeBaseFunc: &AzureDevOpsClientGetMergeBaseFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) (string, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.GetMergeBase")
+			},
+		},
+		GetMergeBasesFunc: &AzureDevOpsClientGetMergeBasesFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) ([]string, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.GetMergeBases")
+			},
+		},
+		GetPipelineRunFunc: &AzureDevOpsClientGetPipelineRunFunc{
+			defaultHook: func(context.Context, string, string, int, int) (azuredevops.PipelineRun, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.GetPipelineRun")
+			},
+		},
+		GetProjectFunc: &AzureDevOpsClientGetProjectFunc{
+			defaultHook: func(context.Context, string, string) (azuredevops.Project, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.GetProject")
+			},
+		},
+		GetProjectIDFunc: &AzureDevOpsClientGetProjectIDFunc{
+			defaultHook: func(context.Context, string, string) (string, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.GetProjectID")
+			},
+		},
+		GetPullRequestFunc: &AzureDevOpsClientGetPullRequestFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.GetPullRequestOptions) (azuredevops.PullRequest, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.GetPullRequest")
+			},
+		},
+		GetPullRequestByRefsFunc: &AzureDevOpsClientGetPullRequestByRefsFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) (azuredevops.PullRequest, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.GetPullRequestByRefs")
+			},
+		},
+		GetPullRequestPropertiesFunc: &AzureDevOpsClientGetPullRequestPropertiesFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs) (map[string]azuredevops.PropertyValue, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.GetPullRequestProperties")
+			},
+		},
+		GetPullRequestStatusesFunc: &AzureDevOpsClientGetPullRequestStatusesFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.GetPullRequestStatuses")
+			},
+		},
+		GetPullRequestsBatchFunc: &AzureDevOpsClientGetPullRequestsBatchFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, []string, azuredevops.GetPullRequestsBatchOptions) (azuredevops.GetPullRequestsBatchResult, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.GetPullRequestsBatch")
+			},
+		},
+		GetPushChangesFunc: &AzureDevOpsClientGetPushChangesFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, int) ([]azuredevops.Change, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.GetPushChanges")
+			},
+		},
+		GetReadmeFunc: &AzureDevOpsClientGetReadmeFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs) ([]byte, string, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.GetReadme")
 			},
 		},
 		GetRepoFunc: &AzureDevOpsClientGetRepoFunc{
@@ -6300,36 +6982,286 @@ func NewStrictMockAzureDevOpsClient() *MockAzureDevOpsClient {
 				panic("unexpected invocation of MockAzureDevOpsClient.GetRepositoryBranch")
 			},
 		},
+		GetRepositorySizeFunc: &AzureDevOpsClientGetRepositorySizeFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs) (int64, bool, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.GetRepositorySize")
+			},
+		},
+		GetResourceAreasFunc: &AzureDevOpsClientGetResourceAreasFunc{
+			defaultHook: func(context.Context) (map[string]string, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.GetResourceAreas")
+			},
+		},
+		GetSecurityNamespaceIDFunc: &AzureDevOpsClientGetSecurityNamespaceIDFunc{
+			defaultHook: func(context.Context, string, string) (string, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.GetSecurityNamespaceID")
+			},
+		},
+		GetTokenInfoFunc: &AzureDevOpsClientGetTokenInfoFunc{
+			defaultHook: func(context.Context) (*azuredevops.TokenInfo, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.GetTokenInfo")
+			},
+		},
 		GetURLFunc: &AzureDevOpsClientGetURLFunc{
 			defaultHook: func() *url.URL {
 				panic("unexpected invocation of MockAzureDevOpsClient.GetURL")
 			},
 		},
+		InspectCredentialsFunc: &AzureDevOpsClientInspectCredentialsFunc{
+			defaultHook: func(context.Context, string) (azuredevops.CredentialsInfo, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.InspectCredentials")
+			},
+		},
 		IsAzureDevOpsServicesFunc: &AzureDevOpsClientIsAzureDevOpsServicesFunc{
 			defaultHook: func() bool {
 				panic("unexpected invocation of MockAzureDevOpsClient.IsAzureDevOpsServices")
 			},
 		},
+		LikeCommentFunc: &AzureDevOpsClientLikeCommentFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommentArgs) error {
+				panic("unexpected invocation of MockAzureDevOpsClient.LikeComment")
+			},
+		},
+		ListAccessibleOrgsFunc: &AzureDevOpsClientListAccessibleOrgsFunc{
+			defaultHook: func(context.Context) ([]azuredevops.Org, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListAccessibleOrgs")
+			},
+		},
+		ListAccessibleRepositoriesFunc: &AzureDevOpsClientListAccessibleRepositoriesFunc{
+			defaultHook: func(context.Context, string, string, azuredevops.ListAccessibleRepositoriesOptions) ([]azuredevops.Repository, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListAccessibleRepositories")
+			},
+		},
 		ListAuthorizedUserOrganizationsFunc: &AzureDevOpsClientListAuthorizedUserOrganizationsFunc{
 			defaultHook: func(context.Context, azuredevops.Profile) ([]azuredevops.Org, error) {
 				panic("unexpected invocation of MockAzureDevOpsClient.ListAuthorizedUserOrganizations")
 			},
 		},
+		ListBranchPoliciesFunc: &AzureDevOpsClientListBranchPoliciesFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, string) ([]azuredevops.PolicyConfiguration, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListBranchPolicies")
+			},
+		},
+		ListBranchesWithStatsFunc: &AzureDevOpsClientListBranchesWithStatsFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListBranchesWithStatsOptions) ([]azuredevops.BranchStat, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListBranchesWithStats")
+			},
+		},
+		ListBuildArtifactsFunc: &AzureDevOpsClientListBuildArtifactsFunc{
+			defaultHook: func(context.Context, string, string, int) ([]azuredevops.BuildArtifact, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListBuildArtifacts")
+			},
+		},
+		ListBuildDefinitionsFunc: &AzureDevOpsClientListBuildDefinitionsFunc{
+			defaultHook: func(context.Context, string, string, azuredevops.ListBuildDefinitionsOptions) ([]azuredevops.BuildDefinition, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListBuildDefinitions")
+			},
+		},
+		ListCommentLikesFunc: &AzureDevOpsClientListCommentLikesFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommentArgs) ([]azuredevops.CreatorInfo, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListCommentLikes")
+			},
+		},
+		ListCommitsFunc: &AzureDevOpsClientListCommitsFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListCommitsCriteria) ([]azuredevops.Commit, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListCommits")
+			},
+		},
+		ListCommitsByProjectFunc: &AzureDevOpsClientListCommitsByProjectFunc{
+			defaultHook: func(context.Context, string, string, azuredevops.ListCommitsByProjectOptions) ([]azuredevops.ProjectCommit, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListCommitsByProject")
+			},
+		},
+		ListDefaultReviewersFunc: &AzureDevOpsClientListDefaultReviewersFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs) ([]azuredevops.RequiredReviewer, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListDefaultReviewers")
+			},
+		},
+		ListItemsFunc: &AzureDevOpsClientListItemsFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListItemsOptions) ([]azuredevops.Item, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListItems")
+			},
+		},
+		ListPipelinesFunc: &AzureDevOpsClientListPipelinesFunc{
+			defaultHook: func(context.Context, string, string, azuredevops.ListPipelinesOptions) ([]azuredevops.Pipeline, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListPipelines")
+			},
+		},
+		ListPolicyEvaluationsFunc: &AzureDevOpsClientListPolicyEvaluationsFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PolicyEvaluation, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListPolicyEvaluations")
+			},
+		},
+		ListPullRequestCommentsUpdatedSinceFunc: &AzureDevOpsClientListPullRequestCommentsUpdatedSinceFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, time.Time) ([]azuredevops.InlineComment, time.Time, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListPullRequestCommentsUpdatedSince")
+			},
+		},
+		ListPullRequestInlineCommentsFunc: &AzureDevOpsClientListPullRequestInlineCommentsFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.InlineComment, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListPullRequestInlineComments")
+			},
+		},
+		ListPullRequestReviewersFunc: &AzureDevOpsClientListPullRequestReviewersFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.ListPullRequestReviewersOptions) ([]azuredevops.Reviewer, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListPullRequestReviewers")
+			},
+		},
+		ListPullRequestThreadsFunc: &AzureDevOpsClientListPullRequestThreadsFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.ListPullRequestThreadsOptions) ([]azuredevops.PullRequestCommentResponse, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListPullRequestThreads")
+			},
+		},
+		ListPullRequestThreadsUpdatedSinceFunc: &AzureDevOpsClientListPullRequestThreadsUpdatedSinceFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, time.Time, azuredevops.ListPullRequestThreadsOptions) ([]azuredevops.PullRequestCommentResponse, time.Time, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListPullRequestThreadsUpdatedSince")
+			},
+		},
+		ListPullRequestsFunc: &AzureDevOpsClientListPullRequestsFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.PullRequestSearchCriteria) ([]azuredevops.PullRequest, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListPullRequests")
+			},
+		},
+		ListRefsFunc: &AzureDevOpsClientListRefsFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListRefsOptions) ([]azuredevops.Ref, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListRefs")
+			},
+		},
 		ListRepositoriesByProjectOrOrgFunc: &AzureDevOpsClientListRepositoriesByProjectOrOrgFunc{
 			defaultHook: func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error) {
 				panic("unexpected invocation of MockAzureDevOpsClient.ListRepositoriesByProjectOrOrg")
 			},
 		},
+		ListRepositoriesByProjectsOrOrgsFunc: &AzureDevOpsClientListRepositoriesByProjectsOrOrgsFunc{
+			defaultHook: func(context.Context, []string) (azuredevops.ListRepositoriesResult, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListRepositoriesByProjectsOrOrgs")
+			},
+		},
+		ListRepositoriesPageFunc: &AzureDevOpsClientListRepositoriesPageFunc{
+			defaultHook: func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) (azuredevops.RepositoriesPage, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ListRepositoriesPage")
+			},
+		},
+		PullRequestApprovalStateFunc: &AzureDevOpsClientPullRequestApprovalStateFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.ApprovalState, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.PullRequestApprovalState")
+			},
+		},
+		QueryAuditLogFunc: &AzureDevOpsClientQueryAuditLogFunc{
+			defaultHook: func(context.Context, azuredevops.QueryAuditLogInput) ([]azuredevops.AuditLogEntry, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.QueryAuditLog")
+			},
+		},
+		QueryCommitsBatchFunc: &AzureDevOpsClientQueryCommitsBatchFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.QueryCommitsCriteria) ([]azuredevops.Commit, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.QueryCommitsBatch")
+			},
+		},
+		ReactivatePullRequestFunc: &AzureDevOpsClientReactivatePullRequestFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ReactivatePullRequest")
+			},
+		},
+		RemovePullRequestReviewerFunc: &AzureDevOpsClientRemovePullRequestReviewerFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, string) error {
+				panic("unexpected invocation of MockAzureDevOpsClient.RemovePullRequestReviewer")
+			},
+		},
+		ResolveCommitAuthorsFunc: &AzureDevOpsClientResolveCommitAuthorsFunc{
+			defaultHook: func(context.Context, string, []string) (map[string]azuredevops.User, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.ResolveCommitAuthors")
+			},
+		},
+		RunPipelineFunc: &AzureDevOpsClientRunPipelineFunc{
+			defaultHook: func(context.Context, string, string, azuredevops.RunPipelineInput) (azuredevops.PipelineRun, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.RunPipeline")
+			},
+		},
+		SetAPIVersionFunc: &AzureDevOpsClientSetAPIVersionFunc{
+			defaultHook: func(string) {
+				panic("unexpected invocation of MockAzureDevOpsClient.SetAPIVersion")
+			},
+		},
+		SetCaptureRawJSONFunc: &AzureDevOpsClientSetCaptureRawJSONFunc{
+			defaultHook: func(bool) {
+				panic("unexpected invocation of MockAzureDevOpsClient.SetCaptureRawJSON")
+			},
+		},
+		SetFollowRedirectsFunc: &AzureDevOpsClientSetFollowRedirectsFunc{
+			defaultHook: func(bool) {
+				panic("unexpected invocation of MockAzureDevOpsClient.SetFollowRedirects")
+			},
+		},
+		SetJobIDMetricLabelFunc: &AzureDevOpsClientSetJobIDMetricLabelFunc{
+			defaultHook: func(bool) {
+				panic("unexpected invocation of MockAzureDevOpsClient.SetJobIDMetricLabel")
+			},
+		},
+		SetProbeNotFoundFunc: &AzureDevOpsClientSetProbeNotFoundFunc{
+			defaultHook: func(bool) {
+				panic("unexpected invocation of MockAzureDevOpsClient.SetProbeNotFound")
+			},
+		},
+		SetProjectIDCacheTTLFunc: &AzureDevOpsClientSetProjectIDCacheTTLFunc{
+			defaultHook: func(time.Duration) {
+				panic("unexpected invocation of MockAzureDevOpsClient.SetProjectIDCacheTTL")
+			},
+		},
+		SetPullRequestAutoCompleteFunc: &AzureDevOpsClientSetPullRequestAutoCompleteFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestAutoCompleteInput) (azuredevops.PullRequest, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.SetPullRequestAutoComplete")
+			},
+		},
+		SetPullRequestDraftFunc: &AzureDevOpsClientSetPullRequestDraftFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, bool) (azuredevops.PullRequest, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.SetPullRequestDraft")
+			},
+		},
+		SetPullRequestPropertiesFunc: &AzureDevOpsClientSetPullRequestPropertiesFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, []azuredevops.JSONPatchOperation) (map[string]azuredevops.PropertyValue, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.SetPullRequestProperties")
+			},
+		},
+		SetStrictDecodeFunc: &AzureDevOpsClientSetStrictDecodeFunc{
+			defaultHook: func(bool) {
+				panic("unexpected invocation of MockAzureDevOpsClient.SetStrictDecode")
+			},
+		},
 		SetWaitForRateLimitFunc: &AzureDevOpsClientSetWaitForRateLimitFunc{
 			defaultHook: func(bool) {
 				panic("unexpected invocation of MockAzureDevOpsClient.SetWaitForRateLimit")
 			},
 		},
+		StatItemFunc: &AzureDevOpsClientStatItemFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, string, *azuredevops.GitVersionDescriptor) (azuredevops.Item, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.StatItem")
+			},
+		},
+		UnlikeCommentFunc: &AzureDevOpsClientUnlikeCommentFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommentArgs) error {
+				panic("unexpected invocation of MockAzureDevOpsClient.UnlikeComment")
+			},
+		},
 		UpdatePullRequestFunc: &AzureDevOpsClientUpdatePullRequestFunc{
 			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error) {
 				panic("unexpected invocation of MockAzureDevOpsClient.UpdatePullRequest")
 			},
 		},
+		UpdateRefsFunc: &AzureDevOpsClientUpdateRefsFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, []azuredevops.RefUpdate) ([]azuredevops.RefUpdateResult, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.UpdateRefs")
+			},
+		},
+		UpdateRepositoryFunc: &AzureDevOpsClientUpdateRepositoryFunc{
+			defaultHook: func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.UpdateRepositoryInput) (azuredevops.Repository, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.UpdateRepository")
+			},
+		},
+		UploadPullRequestAttachmentFunc: &AzureDevOpsClientUploadPullRequestAttachmentFunc{
+			defaultHook: func(context.Context, azuredevops.PullRequestCommonArgs, string, io.Reader) (azuredevops.Attachment, error) {
+				panic("unexpected invocation of MockAzureDevOpsClient.UploadPullRequestAttachment")
+			},
+		},
 		WithAuthenticatorFunc: &AzureDevOpsClientWithAuthenticatorFunc{
 			defaultHook: func(auth.Authenticator) (azuredevops.Client, error) {
 				panic("unexpected invocation of MockAzureDevOpsClient.WithAuthenticator")
@@ -6349,91 +7281,8306 @@ func NewMockAzureDevOpsClientFrom(i azuredevops.Client) *MockAzureDevOpsClient {
 		AuthenticatorFunc: &AzureDevOpsClientAuthenticatorFunc{
 			defaultHook: i.Authenticator,
 		},
+		CloseFunc: &AzureDevOpsClientCloseFunc{
+			defaultHook: i.Close,
+		},
 		CompletePullRequestFunc: &AzureDevOpsClientCompletePullRequestFunc{
 			defaultHook: i.CompletePullRequest,
 		},
+		CreateOrUpdateRequiredReviewersPolicyFunc: &AzureDevOpsClientCreateOrUpdateRequiredReviewersPolicyFunc{
+			defaultHook: i.CreateOrUpdateRequiredReviewersPolicy,
+		},
 		CreatePullRequestFunc: &AzureDevOpsClientCreatePullRequestFunc{
 			defaultHook: i.CreatePullRequest,
 		},
 		CreatePullRequestCommentThreadFunc: &AzureDevOpsClientCreatePullRequestCommentThreadFunc{
 			defaultHook: i.CreatePullRequestCommentThread,
 		},
+		DeleteBranchFunc: &AzureDevOpsClientDeleteBranchFunc{
+			defaultHook: i.DeleteBranch,
+		},
+		DiffRepositoriesFunc: &AzureDevOpsClientDiffRepositoriesFunc{
+			defaultHook: i.DiffRepositories,
+		},
+		DoRawFunc: &AzureDevOpsClientDoRawFunc{
+			defaultHook: i.DoRaw,
+		},
+		DownloadBuildArtifactFunc: &AzureDevOpsClientDownloadBuildArtifactFunc{
+			defaultHook: i.DownloadBuildArtifact,
+		},
+		DownloadRepositoryZipFunc: &AzureDevOpsClientDownloadRepositoryZipFunc{
+			defaultHook: i.DownloadRepositoryZip,
+		},
+		EnsureSubscriptionFunc: &AzureDevOpsClientEnsureSubscriptionFunc{
+			defaultHook: i.EnsureSubscription,
+		},
+		FindRepositoryByNameFunc: &AzureDevOpsClientFindRepositoryByNameFunc{
+			defaultHook: i.FindRepositoryByName,
+		},
 		ForkRepositoryFunc: &AzureDevOpsClientForkRepositoryFunc{
 			defaultHook: i.ForkRepository,
 		},
 		GetAuthorizedProfileFunc: &AzureDevOpsClientGetAuthorizedProfileFunc{
 			defaultHook: i.GetAuthorizedProfile,
 		},
+		GetCommitFunc: &AzureDevOpsClientGetCommitFunc{
+			defaultHook: i.GetCommit,
+		},
+		GetCommitsBatchFunc: &AzureDevOpsClientGetCommitsBatchFunc{
+			defaultHook: i.GetCommitsBatch,
+		},
+		GetItemFunc: &AzureDevOpsClientGetItemFunc{
+			defaultHook: i.GetItem,
+		},
+		GetMergeBaseFunc: &AzureDevOpsClientGetMergeBaseFunc{
+			defaultHook: i.GetMergeBase,
+		},
+		GetMergeBasesFunc: &AzureDevOpsClientGetMergeBasesFunc{
+			defaultHook: i.GetMergeBases,
+		},
+		GetPipelineRunFunc: &AzureDevOpsClientGetPipelineRunFunc{
+			defaultHook: i.GetPipelineRun,
+		},
 		GetProjectFunc: &AzureDevOpsClientGetProjectFunc{
 			defaultHook: i.GetProject,
 		},
+		GetProjectIDFunc: &AzureDevOpsClientGetProjectIDFunc{
+			defaultHook: i.GetProjectID,
+		},
 		GetPullRequestFunc: &AzureDevOpsClientGetPullRequestFunc{
 			defaultHook: i.GetPullRequest,
 		},
+		GetPullRequestByRefsFunc: &AzureDevOpsClientGetPullRequestByRefsFunc{
+			defaultHook: i.GetPullRequestByRefs,
+		},
+		GetPullRequestPropertiesFunc: &AzureDevOpsClientGetPullRequestPropertiesFunc{
+			defaultHook: i.GetPullRequestProperties,
+		},
 		GetPullRequestStatusesFunc: &AzureDevOpsClientGetPullRequestStatusesFunc{
 			defaultHook: i.GetPullRequestStatuses,
 		},
+		GetPullRequestsBatchFunc: &AzureDevOpsClientGetPullRequestsBatchFunc{
+			defaultHook: i.GetPullRequestsBatch,
+		},
+		GetPushChangesFunc: &AzureDevOpsClientGetPushChangesFunc{
+			defaultHook: i.GetPushChanges,
+		},
+		GetReadmeFunc: &AzureDevOpsClientGetReadmeFunc{
+			defaultHook: i.GetReadme,
+		},
 		GetRepoFunc: &AzureDevOpsClientGetRepoFunc{
 			defaultHook: i.GetRepo,
 		},
 		GetRepositoryBranchFunc: &AzureDevOpsClientGetRepositoryBranchFunc{
 			defaultHook: i.GetRepositoryBranch,
 		},
+		GetRepositorySizeFunc: &AzureDevOpsClientGetRepositorySizeFunc{
+			defaultHook: i.GetRepositorySize,
+		},
+		GetResourceAreasFunc: &AzureDevOpsClientGetResourceAreasFunc{
+			defaultHook: i.GetResourceAreas,
+		},
+		GetSecurityNamespaceIDFunc: &AzureDevOpsClientGetSecurityNamespaceIDFunc{
+			defaultHook: i.GetSecurityNamespaceID,
+		},
+		GetTokenInfoFunc: &AzureDevOpsClientGetTokenInfoFunc{
+			defaultHook: i.GetTokenInfo,
+		},
 		GetURLFunc: &AzureDevOpsClientGetURLFunc{
 			defaultHook: i.GetURL,
 		},
+		InspectCredentialsFunc: &AzureDevOpsClientInspectCredentialsFunc{
+			defaultHook: i.InspectCredentials,
+		},
 		IsAzureDevOpsServicesFunc: &AzureDevOpsClientIsAzureDevOpsServicesFunc{
 			defaultHook: i.IsAzureDevOpsServices,
 		},
+		LikeCommentFunc: &AzureDevOpsClientLikeCommentFunc{
+			defaultHook: i.LikeComment,
+		},
+		ListAccessibleOrgsFunc: &AzureDevOpsClientListAccessibleOrgsFunc{
+			defaultHook: i.ListAccessibleOrgs,
+		},
+		ListAccessibleRepositoriesFunc: &AzureDevOpsClientListAccessibleRepositoriesFunc{
+			defaultHook: i.ListAccessibleRepositories,
+		},
 		ListAuthorizedUserOrganizationsFunc: &AzureDevOpsClientListAuthorizedUserOrganizationsFunc{
 			defaultHook: i.ListAuthorizedUserOrganizations,
 		},
+		ListBranchPoliciesFunc: &AzureDevOpsClientListBranchPoliciesFunc{
+			defaultHook: i.ListBranchPolicies,
+		},
+		ListBranchesWithStatsFunc: &AzureDevOpsClientListBranchesWithStatsFunc{
+			defaultHook: i.ListBranchesWithStats,
+		},
+		ListBuildArtifactsFunc: &AzureDevOpsClientListBuildArtifactsFunc{
+			defaultHook: i.ListBuildArtifacts,
+		},
+		ListBuildDefinitionsFunc: &AzureDevOpsClientListBuildDefinitionsFunc{
+			defaultHook: i.ListBuildDefinitions,
+		},
+		ListCommentLikesFunc: &AzureDevOpsClientListCommentLikesFunc{
+			defaultHook: i.ListCommentLikes,
+		},
+		ListCommitsFunc: &AzureDevOpsClientListCommitsFunc{
+			defaultHook: i.ListCommits,
+		},
+		ListCommitsByProjectFunc: &AzureDevOpsClientListCommitsByProjectFunc{
+			defaultHook: i.ListCommitsByProject,
+		},
+		ListDefaultReviewersFunc: &AzureDevOpsClientListDefaultReviewersFunc{
+			defaultHook: i.ListDefaultReviewers,
+		},
+		ListItemsFunc: &AzureDevOpsClientListItemsFunc{
+			defaultHook: i.ListItems,
+		},
+		ListPipelinesFunc: &AzureDevOpsClientListPipelinesFunc{
+			defaultHook: i.ListPipelines,
+		},
+		ListPolicyEvaluationsFunc: &AzureDevOpsClientListPolicyEvaluationsFunc{
+			defaultHook: i.ListPolicyEvaluations,
+		},
+		ListPullRequestCommentsUpdatedSinceFunc: &AzureDevOpsClientListPullRequestCommentsUpdatedSinceFunc{
+			defaultHook: i.ListPullRequestCommentsUpdatedSince,
+		},
+		ListPullRequestInlineCommentsFunc: &AzureDevOpsClientListPullRequestInlineCommentsFunc{
+			defaultHook: i.ListPullRequestInlineComments,
+		},
+		ListPullRequestReviewersFunc: &AzureDevOpsClientListPullRequestReviewersFunc{
+			defaultHook: i.ListPullRequestReviewers,
+		},
+		ListPullRequestThreadsFunc: &AzureDevOpsClientListPullRequestThreadsFunc{
+			defaultHook: i.ListPullRequestThreads,
+		},
+		ListPullRequestThreadsUpdatedSinceFunc: &AzureDevOpsClientListPullRequestThreadsUpdatedSinceFunc{
+			defaultHook: i.ListPullRequestThreadsUpdatedSince,
+		},
+		ListPullRequestsFunc: &AzureDevOpsClientListPullRequestsFunc{
+			defaultHook: i.ListPullRequests,
+		},
+		ListRefsFunc: &AzureDevOpsClientListRefsFunc{
+			defaultHook: i.ListRefs,
+		},
 		ListRepositoriesByProjectOrOrgFunc: &AzureDevOpsClientListRepositoriesByProjectOrOrgFunc{
 			defaultHook: i.ListRepositoriesByProjectOrOrg,
 		},
+		ListRepositoriesByProjectsOrOrgsFunc: &AzureDevOpsClientListRepositoriesByProjectsOrOrgsFunc{
+			defaultHook: i.ListRepositoriesByProjectsOrOrgs,
+		},
+		ListRepositoriesPageFunc: &AzureDevOpsClientListRepositoriesPageFunc{
+			defaultHook: i.ListRepositoriesPage,
+		},
+		PullRequestApprovalStateFunc: &AzureDevOpsClientPullRequestApprovalStateFunc{
+			defaultHook: i.PullRequestApprovalState,
+		},
+		QueryAuditLogFunc: &AzureDevOpsClientQueryAuditLogFunc{
+			defaultHook: i.QueryAuditLog,
+		},
+		QueryCommitsBatchFunc: &AzureDevOpsClientQueryCommitsBatchFunc{
+			defaultHook: i.QueryCommitsBatch,
+		},
+		ReactivatePullRequestFunc: &AzureDevOpsClientReactivatePullRequestFunc{
+			defaultHook: i.ReactivatePullRequest,
+		},
+		RemovePullRequestReviewerFunc: &AzureDevOpsClientRemovePullRequestReviewerFunc{
+			defaultHook: i.RemovePullRequestReviewer,
+		},
+		ResolveCommitAuthorsFunc: &AzureDevOpsClientResolveCommitAuthorsFunc{
+			defaultHook: i.ResolveCommitAuthors,
+		},
+		RunPipelineFunc: &AzureDevOpsClientRunPipelineFunc{
+			defaultHook: i.RunPipeline,
+		},
+		SetAPIVersionFunc: &AzureDevOpsClientSetAPIVersionFunc{
+			defaultHook: i.SetAPIVersion,
+		},
+		SetCaptureRawJSONFunc: &AzureDevOpsClientSetCaptureRawJSONFunc{
+			defaultHook: i.SetCaptureRawJSON,
+		},
+		SetFollowRedirectsFunc: &AzureDevOpsClientSetFollowRedirectsFunc{
+			defaultHook: i.SetFollowRedirects,
+		},
+		SetJobIDMetricLabelFunc: &AzureDevOpsClientSetJobIDMetricLabelFunc{
+			defaultHook: i.SetJobIDMetricLabel,
+		},
+		SetProbeNotFoundFunc: &AzureDevOpsClientSetProbeNotFoundFunc{
+			defaultHook: i.SetProbeNotFound,
+		},
+		SetProjectIDCacheTTLFunc: &AzureDevOpsClientSetProjectIDCacheTTLFunc{
+			defaultHook: i.SetProjectIDCacheTTL,
+		},
+		SetPullRequestAutoCompleteFunc: &AzureDevOpsClientSetPullRequestAutoCompleteFunc{
+			defaultHook: i.SetPullRequestAutoComplete,
+		},
+		SetPullRequestDraftFunc: &AzureDevOpsClientSetPullRequestDraftFunc{
+			defaultHook: i.SetPullRequestDraft,
+		},
+		SetPullRequestPropertiesFunc: &AzureDevOpsClientSetPullRequestPropertiesFunc{
+			defaultHook: i.SetPullRequestProperties,
+		},
+		SetStrictDecodeFunc: &AzureDevOpsClientSetStrictDecodeFunc{
+			defaultHook: i.SetStrictDecode,
+		},
 		SetWaitForRateLimitFunc: &AzureDevOpsClientSetWaitForRateLimitFunc{
 			defaultHook: i.SetWaitForRateLimit,
 		},
+		StatItemFunc: &AzureDevOpsClientStatItemFunc{
+			defaultHook: i.StatItem,
+		},
+		UnlikeCommentFunc: &AzureDevOpsClientUnlikeCommentFunc{
+			defaultHook: i.UnlikeComment,
+		},
 		UpdatePullRequestFunc: &AzureDevOpsClientUpdatePullRequestFunc{
 			defaultHook: i.UpdatePullRequest,
 		},
+		UpdateRefsFunc: &AzureDevOpsClientUpdateRefsFunc{
+			defaultHook: i.UpdateRefs,
+		},
+		UpdateRepositoryFunc: &AzureDevOpsClientUpdateRepositoryFunc{
+			defaultHook: i.UpdateRepository,
+		},
+		UploadPullRequestAttachmentFunc: &AzureDevOpsClientUploadPullRequestAttachmentFunc{
+			defaultHook: i.UploadPullRequestAttachment,
+		},
 		WithAuthenticatorFunc: &AzureDevOpsClientWithAuthenticatorFunc{
 			defaultHook: i.WithAuthenticator,
 		},
 	}
 }
 
-// AzureDevOpsClientAbandonPullRequestFunc describes the behavior when the
-// AbandonPullRequest method of the parent MockAzureDevOpsClient instance is
+// AzureDevOpsClientAbandonPullRequestFunc describes the behavior when the
+// AbandonPullRequest method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientAbandonPullRequestFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)
+	history     []AzureDevOpsClientAbandonPullRequestFuncCall
+	mutex       sync.Mutex
+}
+
+// AbandonPullRequest delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) AbandonPullRequest(v0 context.Context, v1 azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+	r0, r1 := m.AbandonPullRequestFunc.nextHook()(v0, v1)
+	m.AbandonPullRequestFunc.appendCall(AzureDevOpsClientAbandonPullRequestFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the AbandonPullRequest
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientAbandonPullRequestFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// AbandonPullRequest method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientAbandonPullRequestFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientAbandonPullRequestFunc) SetDefaultReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientAbandonPullRequestFunc) PushReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientAbandonPullRequestFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientAbandonPullRequestFunc) appendCall(r0 AzureDevOpsClientAbandonPullRequestFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientAbandonPullRequestFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientAbandonPullRequestFunc) History() []AzureDevOpsClientAbandonPullRequestFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientAbandonPullRequestFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientAbandonPullRequestFuncCall is an object that describes
+// an invocation of method AbandonPullRequest on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientAbandonPullRequestFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.PullRequest
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientAbandonPullRequestFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientAbandonPullRequestFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientAuthenticatorFunc describes the behavior when the
+// Authenticator method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientAuthenticatorFunc struct {
+	defaultHook func() auth.Authenticator
+	hooks       []func() auth.Authenticator
+	history     []AzureDevOpsClientAuthenticatorFuncCall
+	mutex       sync.Mutex
+}
+
+// Authenticator delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) Authenticator() auth.Authenticator {
+	r0 := m.AuthenticatorFunc.nextHook()()
+	m.AuthenticatorFunc.appendCall(AzureDevOpsClientAuthenticatorFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Authenticator method
+// of the parent MockAzureDevOpsClient instance is invoked and the hook
+// queue is empty.
+func (f *AzureDevOpsClientAuthenticatorFunc) SetDefaultHook(hook func() auth.Authenticator) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Authenticator method of the parent MockAzureDevOpsClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientAuthenticatorFunc) PushHook(hook func() auth.Authenticator) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientAuthenticatorFunc) SetDefaultReturn(r0 auth.Authenticator) {
+	f.SetDefaultHook(func() auth.Authenticator {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientAuthenticatorFunc) PushReturn(r0 auth.Authenticator) {
+	f.PushHook(func() auth.Authenticator {
+		return r0
+	})
+}
+
+func (f *AzureDevOpsClientAuthenticatorFunc) nextHook() func() auth.Authenticator {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientAuthenticatorFunc) appendCall(r0 AzureDevOpsClientAuthenticatorFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientAuthenticatorFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientAuthenticatorFunc) History() []AzureDevOpsClientAuthenticatorFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientAuthenticatorFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientAuthenticatorFuncCall is an object that describes an
+// invocation of method Authenticator on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientAuthenticatorFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 auth.Authenticator
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientAuthenticatorFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientAuthenticatorFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// AzureDevOpsClientCloseFunc describes the behavior when the Close method
+// of the parent MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientCloseFunc struct {
+	defaultHook func() error
+	hooks       []func() error
+	history     []AzureDevOpsClientCloseFuncCall
+	mutex       sync.Mutex
+}
+
+// Close delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) Close() error {
+	r0 := m.CloseFunc.nextHook()()
+	m.CloseFunc.appendCall(AzureDevOpsClientCloseFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the Close method of the
+// parent MockAzureDevOpsClient instance is invoked and the hook queue is
+// empty.
+func (f *AzureDevOpsClientCloseFunc) SetDefaultHook(hook func() error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// Close method of the parent MockAzureDevOpsClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientCloseFunc) PushHook(hook func() error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientCloseFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func() error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientCloseFunc) PushReturn(r0 error) {
+	f.PushHook(func() error {
+		return r0
+	})
+}
+
+func (f *AzureDevOpsClientCloseFunc) nextHook() func() error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientCloseFunc) appendCall(r0 AzureDevOpsClientCloseFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientCloseFuncCall objects
+// describing the invocations of this function.
+func (f *AzureDevOpsClientCloseFunc) History() []AzureDevOpsClientCloseFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientCloseFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientCloseFuncCall is an object that describes an invocation
+// of method Close on an instance of MockAzureDevOpsClient.
+type AzureDevOpsClientCloseFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientCloseFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientCloseFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// AzureDevOpsClientCompletePullRequestFunc describes the behavior when the
+// CompletePullRequest method of the parent MockAzureDevOpsClient instance
+// is invoked.
+type AzureDevOpsClientCompletePullRequestFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error)
+	history     []AzureDevOpsClientCompletePullRequestFuncCall
+	mutex       sync.Mutex
+}
+
+// CompletePullRequest delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) CompletePullRequest(v0 context.Context, v1 azuredevops.PullRequestCommonArgs, v2 azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error) {
+	r0, r1 := m.CompletePullRequestFunc.nextHook()(v0, v1, v2)
+	m.CompletePullRequestFunc.appendCall(AzureDevOpsClientCompletePullRequestFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CompletePullRequest
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientCompletePullRequestFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CompletePullRequest method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientCompletePullRequestFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientCompletePullRequestFunc) SetDefaultReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientCompletePullRequestFunc) PushReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientCompletePullRequestFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientCompletePullRequestFunc) appendCall(r0 AzureDevOpsClientCompletePullRequestFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientCompletePullRequestFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientCompletePullRequestFunc) History() []AzureDevOpsClientCompletePullRequestFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientCompletePullRequestFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientCompletePullRequestFuncCall is an object that describes
+// an invocation of method CompletePullRequest on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientCompletePullRequestFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 azuredevops.PullRequestCompleteInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.PullRequest
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientCompletePullRequestFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientCompletePullRequestFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientCreateOrUpdateRequiredReviewersPolicyFunc describes the
+// behavior when the CreateOrUpdateRequiredReviewersPolicy method of the
+// parent MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientCreateOrUpdateRequiredReviewersPolicyFunc struct {
+	defaultHook func(context.Context, string, string, azuredevops.RequiredReviewersPolicyInput) (azuredevops.PolicyConfiguration, error)
+	hooks       []func(context.Context, string, string, azuredevops.RequiredReviewersPolicyInput) (azuredevops.PolicyConfiguration, error)
+	history     []AzureDevOpsClientCreateOrUpdateRequiredReviewersPolicyFuncCall
+	mutex       sync.Mutex
+}
+
+// CreateOrUpdateRequiredReviewersPolicy delegates to the next hook function
+// in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockAzureDevOpsClient) CreateOrUpdateRequiredReviewersPolicy(v0 context.Context, v1 string, v2 string, v3 azuredevops.RequiredReviewersPolicyInput) (azuredevops.PolicyConfiguration, error) {
+	r0, r1 := m.CreateOrUpdateRequiredReviewersPolicyFunc.nextHook()(v0, v1, v2, v3)
+	m.CreateOrUpdateRequiredReviewersPolicyFunc.appendCall(AzureDevOpsClientCreateOrUpdateRequiredReviewersPolicyFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// CreateOrUpdateRequiredReviewersPolicy method of the parent
+// MockAzureDevOpsClient instance is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientCreateOrUpdateRequiredReviewersPolicyFunc) SetDefaultHook(hook func(context.Context, string, string, azuredevops.RequiredReviewersPolicyInput) (azuredevops.PolicyConfiguration, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreateOrUpdateRequiredReviewersPolicy method of the parent
+// MockAzureDevOpsClient instance invokes the hook at the front of the queue
+// and discards it. After the queue is empty, the default hook function is
+// invoked for any future action.
+func (f *AzureDevOpsClientCreateOrUpdateRequiredReviewersPolicyFunc) PushHook(hook func(context.Context, string, string, azuredevops.RequiredReviewersPolicyInput) (azuredevops.PolicyConfiguration, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientCreateOrUpdateRequiredReviewersPolicyFunc) SetDefaultReturn(r0 azuredevops.PolicyConfiguration, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, azuredevops.RequiredReviewersPolicyInput) (azuredevops.PolicyConfiguration, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientCreateOrUpdateRequiredReviewersPolicyFunc) PushReturn(r0 azuredevops.PolicyConfiguration, r1 error) {
+	f.PushHook(func(context.Context, string, string, azuredevops.RequiredReviewersPolicyInput) (azuredevops.PolicyConfiguration, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientCreateOrUpdateRequiredReviewersPolicyFunc) nextHook() func(context.Context, string, string, azuredevops.RequiredReviewersPolicyInput) (azuredevops.PolicyConfiguration, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientCreateOrUpdateRequiredReviewersPolicyFunc) appendCall(r0 AzureDevOpsClientCreateOrUpdateRequiredReviewersPolicyFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientCreateOrUpdateRequiredReviewersPolicyFuncCall objects
+// describing the invocations of this function.
+func (f *AzureDevOpsClientCreateOrUpdateRequiredReviewersPolicyFunc) History() []AzureDevOpsClientCreateOrUpdateRequiredReviewersPolicyFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientCreateOrUpdateRequiredReviewersPolicyFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientCreateOrUpdateRequiredReviewersPolicyFuncCall is an
+// object that describes an invocation of method
+// CreateOrUpdateRequiredReviewersPolicy on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientCreateOrUpdateRequiredReviewersPolicyFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 azuredevops.RequiredReviewersPolicyInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.PolicyConfiguration
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientCreateOrUpdateRequiredReviewersPolicyFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientCreateOrUpdateRequiredReviewersPolicyFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientCreatePullRequestFunc describes the behavior when the
+// CreatePullRequest method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientCreatePullRequestFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error)
+	history     []AzureDevOpsClientCreatePullRequestFuncCall
+	mutex       sync.Mutex
+}
+
+// CreatePullRequest delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) CreatePullRequest(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error) {
+	r0, r1 := m.CreatePullRequestFunc.nextHook()(v0, v1, v2)
+	m.CreatePullRequestFunc.appendCall(AzureDevOpsClientCreatePullRequestFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the CreatePullRequest
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientCreatePullRequestFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreatePullRequest method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientCreatePullRequestFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientCreatePullRequestFunc) SetDefaultReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientCreatePullRequestFunc) PushReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientCreatePullRequestFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientCreatePullRequestFunc) appendCall(r0 AzureDevOpsClientCreatePullRequestFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientCreatePullRequestFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientCreatePullRequestFunc) History() []AzureDevOpsClientCreatePullRequestFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientCreatePullRequestFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientCreatePullRequestFuncCall is an object that describes an
+// invocation of method CreatePullRequest on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientCreatePullRequestFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 azuredevops.CreatePullRequestInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.PullRequest
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientCreatePullRequestFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientCreatePullRequestFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientCreatePullRequestCommentThreadFunc describes the
+// behavior when the CreatePullRequestCommentThread method of the parent
+// MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientCreatePullRequestCommentThreadFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error)
+	history     []AzureDevOpsClientCreatePullRequestCommentThreadFuncCall
+	mutex       sync.Mutex
+}
+
+// CreatePullRequestCommentThread delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) CreatePullRequestCommentThread(v0 context.Context, v1 azuredevops.PullRequestCommonArgs, v2 azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error) {
+	r0, r1 := m.CreatePullRequestCommentThreadFunc.nextHook()(v0, v1, v2)
+	m.CreatePullRequestCommentThreadFunc.appendCall(AzureDevOpsClientCreatePullRequestCommentThreadFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// CreatePullRequestCommentThread method of the parent MockAzureDevOpsClient
+// instance is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientCreatePullRequestCommentThreadFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// CreatePullRequestCommentThread method of the parent MockAzureDevOpsClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *AzureDevOpsClientCreatePullRequestCommentThreadFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientCreatePullRequestCommentThreadFunc) SetDefaultReturn(r0 azuredevops.PullRequestCommentResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientCreatePullRequestCommentThreadFunc) PushReturn(r0 azuredevops.PullRequestCommentResponse, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientCreatePullRequestCommentThreadFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientCreatePullRequestCommentThreadFunc) appendCall(r0 AzureDevOpsClientCreatePullRequestCommentThreadFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientCreatePullRequestCommentThreadFuncCall objects
+// describing the invocations of this function.
+func (f *AzureDevOpsClientCreatePullRequestCommentThreadFunc) History() []AzureDevOpsClientCreatePullRequestCommentThreadFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientCreatePullRequestCommentThreadFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientCreatePullRequestCommentThreadFuncCall is an object that
+// describes an invocation of method CreatePullRequestCommentThread on an
+// instance of MockAzureDevOpsClient.
+type AzureDevOpsClientCreatePullRequestCommentThreadFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 azuredevops.PullRequestCommentInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.PullRequestCommentResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientCreatePullRequestCommentThreadFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientCreatePullRequestCommentThreadFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientDeleteBranchFunc describes the behavior when the
+// DeleteBranch method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientDeleteBranchFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, string) error
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, string) error
+	history     []AzureDevOpsClientDeleteBranchFuncCall
+	mutex       sync.Mutex
+}
+
+// DeleteBranch delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) DeleteBranch(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 string) error {
+	r0 := m.DeleteBranchFunc.nextHook()(v0, v1, v2)
+	m.DeleteBranchFunc.appendCall(AzureDevOpsClientDeleteBranchFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the DeleteBranch method
+// of the parent MockAzureDevOpsClient instance is invoked and the hook
+// queue is empty.
+func (f *AzureDevOpsClientDeleteBranchFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, string) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DeleteBranch method of the parent MockAzureDevOpsClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientDeleteBranchFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, string) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientDeleteBranchFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, string) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientDeleteBranchFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, string) error {
+		return r0
+	})
+}
+
+func (f *AzureDevOpsClientDeleteBranchFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientDeleteBranchFunc) appendCall(r0 AzureDevOpsClientDeleteBranchFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientDeleteBranchFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientDeleteBranchFunc) History() []AzureDevOpsClientDeleteBranchFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientDeleteBranchFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientDeleteBranchFuncCall is an object that describes an
+// invocation of method DeleteBranch on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientDeleteBranchFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientDeleteBranchFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientDeleteBranchFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// AzureDevOpsClientDiffRepositoriesFunc describes the behavior when the
+// DiffRepositories method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientDiffRepositoriesFunc struct {
+	defaultHook func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs, []string) ([]string, []string, error)
+	hooks       []func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs, []string) ([]string, []string, error)
+	history     []AzureDevOpsClientDiffRepositoriesFuncCall
+	mutex       sync.Mutex
+}
+
+// DiffRepositories delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) DiffRepositories(v0 context.Context, v1 azuredevops.ListRepositoriesByProjectOrOrgArgs, v2 []string) ([]string, []string, error) {
+	r0, r1, r2 := m.DiffRepositoriesFunc.nextHook()(v0, v1, v2)
+	m.DiffRepositoriesFunc.appendCall(AzureDevOpsClientDiffRepositoriesFuncCall{v0, v1, v2, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the DiffRepositories
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientDiffRepositoriesFunc) SetDefaultHook(hook func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs, []string) ([]string, []string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DiffRepositories method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientDiffRepositoriesFunc) PushHook(hook func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs, []string) ([]string, []string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientDiffRepositoriesFunc) SetDefaultReturn(r0 []string, r1 []string, r2 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs, []string) ([]string, []string, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientDiffRepositoriesFunc) PushReturn(r0 []string, r1 []string, r2 error) {
+	f.PushHook(func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs, []string) ([]string, []string, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *AzureDevOpsClientDiffRepositoriesFunc) nextHook() func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs, []string) ([]string, []string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientDiffRepositoriesFunc) appendCall(r0 AzureDevOpsClientDiffRepositoriesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientDiffRepositoriesFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientDiffRepositoriesFunc) History() []AzureDevOpsClientDiffRepositoriesFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientDiffRepositoriesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientDiffRepositoriesFuncCall is an object that describes an
+// invocation of method DiffRepositories on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientDiffRepositoriesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.ListRepositoriesByProjectOrOrgArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 []string
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientDiffRepositoriesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientDiffRepositoriesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// AzureDevOpsClientDoRawFunc describes the behavior when the DoRaw method
+// of the parent MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientDoRawFunc struct {
+	defaultHook func(context.Context, *http.Request, interface{}) (*http.Response, error)
+	hooks       []func(context.Context, *http.Request, interface{}) (*http.Response, error)
+	history     []AzureDevOpsClientDoRawFuncCall
+	mutex       sync.Mutex
+}
+
+// DoRaw delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) DoRaw(v0 context.Context, v1 *http.Request, v2 interface{}) (*http.Response, error) {
+	r0, r1 := m.DoRawFunc.nextHook()(v0, v1, v2)
+	m.DoRawFunc.appendCall(AzureDevOpsClientDoRawFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the DoRaw method of the
+// parent MockAzureDevOpsClient instance is invoked and the hook queue is
+// empty.
+func (f *AzureDevOpsClientDoRawFunc) SetDefaultHook(hook func(context.Context, *http.Request, interface{}) (*http.Response, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DoRaw method of the parent MockAzureDevOpsClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientDoRawFunc) PushHook(hook func(context.Context, *http.Request, interface{}) (*http.Response, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientDoRawFunc) SetDefaultReturn(r0 *http.Response, r1 error) {
+	f.SetDefaultHook(func(context.Context, *http.Request, interface{}) (*http.Response, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientDoRawFunc) PushReturn(r0 *http.Response, r1 error) {
+	f.PushHook(func(context.Context, *http.Request, interface{}) (*http.Response, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientDoRawFunc) nextHook() func(context.Context, *http.Request, interface{}) (*http.Response, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientDoRawFunc) appendCall(r0 AzureDevOpsClientDoRawFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientDoRawFuncCall objects
+// describing the invocations of this function.
+func (f *AzureDevOpsClientDoRawFunc) History() []AzureDevOpsClientDoRawFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientDoRawFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientDoRawFuncCall is an object that describes an invocation
+// of method DoRaw on an instance of MockAzureDevOpsClient.
+type AzureDevOpsClientDoRawFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 *http.Request
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 interface{}
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *http.Response
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientDoRawFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientDoRawFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientDownloadBuildArtifactFunc describes the behavior when
+// the DownloadBuildArtifact method of the parent MockAzureDevOpsClient
+// instance is invoked.
+type AzureDevOpsClientDownloadBuildArtifactFunc struct {
+	defaultHook func(context.Context, azuredevops.BuildArtifact) (io.ReadCloser, error)
+	hooks       []func(context.Context, azuredevops.BuildArtifact) (io.ReadCloser, error)
+	history     []AzureDevOpsClientDownloadBuildArtifactFuncCall
+	mutex       sync.Mutex
+}
+
+// DownloadBuildArtifact delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) DownloadBuildArtifact(v0 context.Context, v1 azuredevops.BuildArtifact) (io.ReadCloser, error) {
+	r0, r1 := m.DownloadBuildArtifactFunc.nextHook()(v0, v1)
+	m.DownloadBuildArtifactFunc.appendCall(AzureDevOpsClientDownloadBuildArtifactFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// DownloadBuildArtifact method of the parent MockAzureDevOpsClient instance
+// is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientDownloadBuildArtifactFunc) SetDefaultHook(hook func(context.Context, azuredevops.BuildArtifact) (io.ReadCloser, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DownloadBuildArtifact method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientDownloadBuildArtifactFunc) PushHook(hook func(context.Context, azuredevops.BuildArtifact) (io.ReadCloser, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientDownloadBuildArtifactFunc) SetDefaultReturn(r0 io.ReadCloser, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.BuildArtifact) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientDownloadBuildArtifactFunc) PushReturn(r0 io.ReadCloser, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.BuildArtifact) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientDownloadBuildArtifactFunc) nextHook() func(context.Context, azuredevops.BuildArtifact) (io.ReadCloser, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientDownloadBuildArtifactFunc) appendCall(r0 AzureDevOpsClientDownloadBuildArtifactFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientDownloadBuildArtifactFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientDownloadBuildArtifactFunc) History() []AzureDevOpsClientDownloadBuildArtifactFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientDownloadBuildArtifactFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientDownloadBuildArtifactFuncCall is an object that
+// describes an invocation of method DownloadBuildArtifact on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientDownloadBuildArtifactFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.BuildArtifact
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 io.ReadCloser
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientDownloadBuildArtifactFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientDownloadBuildArtifactFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientDownloadRepositoryZipFunc describes the behavior when
+// the DownloadRepositoryZip method of the parent MockAzureDevOpsClient
+// instance is invoked.
+type AzureDevOpsClientDownloadRepositoryZipFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, *azuredevops.GitVersionDescriptor) (io.ReadCloser, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, *azuredevops.GitVersionDescriptor) (io.ReadCloser, error)
+	history     []AzureDevOpsClientDownloadRepositoryZipFuncCall
+	mutex       sync.Mutex
+}
+
+// DownloadRepositoryZip delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) DownloadRepositoryZip(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 *azuredevops.GitVersionDescriptor) (io.ReadCloser, error) {
+	r0, r1 := m.DownloadRepositoryZipFunc.nextHook()(v0, v1, v2)
+	m.DownloadRepositoryZipFunc.appendCall(AzureDevOpsClientDownloadRepositoryZipFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// DownloadRepositoryZip method of the parent MockAzureDevOpsClient instance
+// is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientDownloadRepositoryZipFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, *azuredevops.GitVersionDescriptor) (io.ReadCloser, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// DownloadRepositoryZip method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientDownloadRepositoryZipFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, *azuredevops.GitVersionDescriptor) (io.ReadCloser, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientDownloadRepositoryZipFunc) SetDefaultReturn(r0 io.ReadCloser, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, *azuredevops.GitVersionDescriptor) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientDownloadRepositoryZipFunc) PushReturn(r0 io.ReadCloser, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, *azuredevops.GitVersionDescriptor) (io.ReadCloser, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientDownloadRepositoryZipFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, *azuredevops.GitVersionDescriptor) (io.ReadCloser, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientDownloadRepositoryZipFunc) appendCall(r0 AzureDevOpsClientDownloadRepositoryZipFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientDownloadRepositoryZipFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientDownloadRepositoryZipFunc) History() []AzureDevOpsClientDownloadRepositoryZipFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientDownloadRepositoryZipFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientDownloadRepositoryZipFuncCall is an object that
+// describes an invocation of method DownloadRepositoryZip on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientDownloadRepositoryZipFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 *azuredevops.GitVersionDescriptor
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 io.ReadCloser
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientDownloadRepositoryZipFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientDownloadRepositoryZipFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientEnsureSubscriptionFunc describes the behavior when the
+// EnsureSubscription method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientEnsureSubscriptionFunc struct {
+	defaultHook func(context.Context, azuredevops.EnsureSubscriptionInput) (*azuredevops.Subscription, error)
+	hooks       []func(context.Context, azuredevops.EnsureSubscriptionInput) (*azuredevops.Subscription, error)
+	history     []AzureDevOpsClientEnsureSubscriptionFuncCall
+	mutex       sync.Mutex
+}
+
+// EnsureSubscription delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) EnsureSubscription(v0 context.Context, v1 azuredevops.EnsureSubscriptionInput) (*azuredevops.Subscription, error) {
+	r0, r1 := m.EnsureSubscriptionFunc.nextHook()(v0, v1)
+	m.EnsureSubscriptionFunc.appendCall(AzureDevOpsClientEnsureSubscriptionFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the EnsureSubscription
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientEnsureSubscriptionFunc) SetDefaultHook(hook func(context.Context, azuredevops.EnsureSubscriptionInput) (*azuredevops.Subscription, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// EnsureSubscription method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientEnsureSubscriptionFunc) PushHook(hook func(context.Context, azuredevops.EnsureSubscriptionInput) (*azuredevops.Subscription, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientEnsureSubscriptionFunc) SetDefaultReturn(r0 *azuredevops.Subscription, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.EnsureSubscriptionInput) (*azuredevops.Subscription, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientEnsureSubscriptionFunc) PushReturn(r0 *azuredevops.Subscription, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.EnsureSubscriptionInput) (*azuredevops.Subscription, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientEnsureSubscriptionFunc) nextHook() func(context.Context, azuredevops.EnsureSubscriptionInput) (*azuredevops.Subscription, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientEnsureSubscriptionFunc) appendCall(r0 AzureDevOpsClientEnsureSubscriptionFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientEnsureSubscriptionFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientEnsureSubscriptionFunc) History() []AzureDevOpsClientEnsureSubscriptionFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientEnsureSubscriptionFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientEnsureSubscriptionFuncCall is an object that describes
+// an invocation of method EnsureSubscription on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientEnsureSubscriptionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.EnsureSubscriptionInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *azuredevops.Subscription
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientEnsureSubscriptionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientEnsureSubscriptionFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientFindRepositoryByNameFunc describes the behavior when the
+// FindRepositoryByName method of the parent MockAzureDevOpsClient instance
+// is invoked.
+type AzureDevOpsClientFindRepositoryByNameFunc struct {
+	defaultHook func(context.Context, string, string) (azuredevops.Repository, error)
+	hooks       []func(context.Context, string, string) (azuredevops.Repository, error)
+	history     []AzureDevOpsClientFindRepositoryByNameFuncCall
+	mutex       sync.Mutex
+}
+
+// FindRepositoryByName delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) FindRepositoryByName(v0 context.Context, v1 string, v2 string) (azuredevops.Repository, error) {
+	r0, r1 := m.FindRepositoryByNameFunc.nextHook()(v0, v1, v2)
+	m.FindRepositoryByNameFunc.appendCall(AzureDevOpsClientFindRepositoryByNameFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the FindRepositoryByName
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientFindRepositoryByNameFunc) SetDefaultHook(hook func(context.Context, string, string) (azuredevops.Repository, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// FindRepositoryByName method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientFindRepositoryByNameFunc) PushHook(hook func(context.Context, string, string) (azuredevops.Repository, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientFindRepositoryByNameFunc) SetDefaultReturn(r0 azuredevops.Repository, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string) (azuredevops.Repository, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientFindRepositoryByNameFunc) PushReturn(r0 azuredevops.Repository, r1 error) {
+	f.PushHook(func(context.Context, string, string) (azuredevops.Repository, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientFindRepositoryByNameFunc) nextHook() func(context.Context, string, string) (azuredevops.Repository, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientFindRepositoryByNameFunc) appendCall(r0 AzureDevOpsClientFindRepositoryByNameFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientFindRepositoryByNameFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientFindRepositoryByNameFunc) History() []AzureDevOpsClientFindRepositoryByNameFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientFindRepositoryByNameFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientFindRepositoryByNameFuncCall is an object that describes
+// an invocation of method FindRepositoryByName on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientFindRepositoryByNameFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.Repository
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientFindRepositoryByNameFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientFindRepositoryByNameFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientForkRepositoryFunc describes the behavior when the
+// ForkRepository method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientForkRepositoryFunc struct {
+	defaultHook func(context.Context, string, azuredevops.ForkRepositoryInput) (azuredevops.Repository, error)
+	hooks       []func(context.Context, string, azuredevops.ForkRepositoryInput) (azuredevops.Repository, error)
+	history     []AzureDevOpsClientForkRepositoryFuncCall
+	mutex       sync.Mutex
+}
+
+// ForkRepository delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ForkRepository(v0 context.Context, v1 string, v2 azuredevops.ForkRepositoryInput) (azuredevops.Repository, error) {
+	r0, r1 := m.ForkRepositoryFunc.nextHook()(v0, v1, v2)
+	m.ForkRepositoryFunc.appendCall(AzureDevOpsClientForkRepositoryFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ForkRepository
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientForkRepositoryFunc) SetDefaultHook(hook func(context.Context, string, azuredevops.ForkRepositoryInput) (azuredevops.Repository, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ForkRepository method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientForkRepositoryFunc) PushHook(hook func(context.Context, string, azuredevops.ForkRepositoryInput) (azuredevops.Repository, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientForkRepositoryFunc) SetDefaultReturn(r0 azuredevops.Repository, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, azuredevops.ForkRepositoryInput) (azuredevops.Repository, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientForkRepositoryFunc) PushReturn(r0 azuredevops.Repository, r1 error) {
+	f.PushHook(func(context.Context, string, azuredevops.ForkRepositoryInput) (azuredevops.Repository, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientForkRepositoryFunc) nextHook() func(context.Context, string, azuredevops.ForkRepositoryInput) (azuredevops.Repository, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientForkRepositoryFunc) appendCall(r0 AzureDevOpsClientForkRepositoryFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientForkRepositoryFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientForkRepositoryFunc) History() []AzureDevOpsClientForkRepositoryFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientForkRepositoryFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientForkRepositoryFuncCall is an object that describes an
+// invocation of method ForkRepository on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientForkRepositoryFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 azuredevops.ForkRepositoryInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.Repository
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientForkRepositoryFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientForkRepositoryFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetAuthorizedProfileFunc describes the behavior when the
+// GetAuthorizedProfile method of the parent MockAzureDevOpsClient instance
+// is invoked.
+type AzureDevOpsClientGetAuthorizedProfileFunc struct {
+	defaultHook func(context.Context) (azuredevops.Profile, error)
+	hooks       []func(context.Context) (azuredevops.Profile, error)
+	history     []AzureDevOpsClientGetAuthorizedProfileFuncCall
+	mutex       sync.Mutex
+}
+
+// GetAuthorizedProfile delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetAuthorizedProfile(v0 context.Context) (azuredevops.Profile, error) {
+	r0, r1 := m.GetAuthorizedProfileFunc.nextHook()(v0)
+	m.GetAuthorizedProfileFunc.appendCall(AzureDevOpsClientGetAuthorizedProfileFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetAuthorizedProfile
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientGetAuthorizedProfileFunc) SetDefaultHook(hook func(context.Context) (azuredevops.Profile, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetAuthorizedProfile method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientGetAuthorizedProfileFunc) PushHook(hook func(context.Context) (azuredevops.Profile, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetAuthorizedProfileFunc) SetDefaultReturn(r0 azuredevops.Profile, r1 error) {
+	f.SetDefaultHook(func(context.Context) (azuredevops.Profile, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetAuthorizedProfileFunc) PushReturn(r0 azuredevops.Profile, r1 error) {
+	f.PushHook(func(context.Context) (azuredevops.Profile, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetAuthorizedProfileFunc) nextHook() func(context.Context) (azuredevops.Profile, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetAuthorizedProfileFunc) appendCall(r0 AzureDevOpsClientGetAuthorizedProfileFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientGetAuthorizedProfileFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientGetAuthorizedProfileFunc) History() []AzureDevOpsClientGetAuthorizedProfileFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetAuthorizedProfileFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetAuthorizedProfileFuncCall is an object that describes
+// an invocation of method GetAuthorizedProfile on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientGetAuthorizedProfileFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.Profile
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetAuthorizedProfileFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetAuthorizedProfileFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetCommitFunc describes the behavior when the GetCommit
+// method of the parent MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientGetCommitFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, string) (azuredevops.Commit, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, string) (azuredevops.Commit, error)
+	history     []AzureDevOpsClientGetCommitFuncCall
+	mutex       sync.Mutex
+}
+
+// GetCommit delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetCommit(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 string) (azuredevops.Commit, error) {
+	r0, r1 := m.GetCommitFunc.nextHook()(v0, v1, v2)
+	m.GetCommitFunc.appendCall(AzureDevOpsClientGetCommitFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetCommit method of
+// the parent MockAzureDevOpsClient instance is invoked and the hook queue
+// is empty.
+func (f *AzureDevOpsClientGetCommitFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, string) (azuredevops.Commit, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetCommit method of the parent MockAzureDevOpsClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientGetCommitFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, string) (azuredevops.Commit, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetCommitFunc) SetDefaultReturn(r0 azuredevops.Commit, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, string) (azuredevops.Commit, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetCommitFunc) PushReturn(r0 azuredevops.Commit, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, string) (azuredevops.Commit, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetCommitFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, string) (azuredevops.Commit, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetCommitFunc) appendCall(r0 AzureDevOpsClientGetCommitFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientGetCommitFuncCall objects
+// describing the invocations of this function.
+func (f *AzureDevOpsClientGetCommitFunc) History() []AzureDevOpsClientGetCommitFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetCommitFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetCommitFuncCall is an object that describes an
+// invocation of method GetCommit on an instance of MockAzureDevOpsClient.
+type AzureDevOpsClientGetCommitFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.Commit
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetCommitFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetCommitFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetCommitsBatchFunc describes the behavior when the
+// GetCommitsBatch method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientGetCommitsBatchFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, []string) ([]azuredevops.Commit, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, []string) ([]azuredevops.Commit, error)
+	history     []AzureDevOpsClientGetCommitsBatchFuncCall
+	mutex       sync.Mutex
+}
+
+// GetCommitsBatch delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetCommitsBatch(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 []string) ([]azuredevops.Commit, error) {
+	r0, r1 := m.GetCommitsBatchFunc.nextHook()(v0, v1, v2)
+	m.GetCommitsBatchFunc.appendCall(AzureDevOpsClientGetCommitsBatchFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetCommitsBatch
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientGetCommitsBatchFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, []string) ([]azuredevops.Commit, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetCommitsBatch method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientGetCommitsBatchFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, []string) ([]azuredevops.Commit, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetCommitsBatchFunc) SetDefaultReturn(r0 []azuredevops.Commit, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, []string) ([]azuredevops.Commit, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetCommitsBatchFunc) PushReturn(r0 []azuredevops.Commit, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, []string) ([]azuredevops.Commit, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetCommitsBatchFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, []string) ([]azuredevops.Commit, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetCommitsBatchFunc) appendCall(r0 AzureDevOpsClientGetCommitsBatchFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientGetCommitsBatchFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientGetCommitsBatchFunc) History() []AzureDevOpsClientGetCommitsBatchFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetCommitsBatchFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetCommitsBatchFuncCall is an object that describes an
+// invocation of method GetCommitsBatch on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientGetCommitsBatchFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.Commit
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetCommitsBatchFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetCommitsBatchFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetItemFunc describes the behavior when the GetItem
+// method of the parent MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientGetItemFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, string, *azuredevops.GitVersionDescriptor) (azuredevops.Item, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, string, *azuredevops.GitVersionDescriptor) (azuredevops.Item, error)
+	history     []AzureDevOpsClientGetItemFuncCall
+	mutex       sync.Mutex
+}
+
+// GetItem delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetItem(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 string, v3 *azuredevops.GitVersionDescriptor) (azuredevops.Item, error) {
+	r0, r1 := m.GetItemFunc.nextHook()(v0, v1, v2, v3)
+	m.GetItemFunc.appendCall(AzureDevOpsClientGetItemFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetItem method of
+// the parent MockAzureDevOpsClient instance is invoked and the hook queue
+// is empty.
+func (f *AzureDevOpsClientGetItemFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, string, *azuredevops.GitVersionDescriptor) (azuredevops.Item, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetItem method of the parent MockAzureDevOpsClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientGetItemFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, string, *azuredevops.GitVersionDescriptor) (azuredevops.Item, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetItemFunc) SetDefaultReturn(r0 azuredevops.Item, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, string, *azuredevops.GitVersionDescriptor) (azuredevops.Item, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetItemFunc) PushReturn(r0 azuredevops.Item, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, string, *azuredevops.GitVersionDescriptor) (azuredevops.Item, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetItemFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, string, *azuredevops.GitVersionDescriptor) (azuredevops.Item, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetItemFunc) appendCall(r0 AzureDevOpsClientGetItemFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientGetItemFuncCall objects
+// describing the invocations of this function.
+func (f *AzureDevOpsClientGetItemFunc) History() []AzureDevOpsClientGetItemFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetItemFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetItemFuncCall is an object that describes an
+// invocation of method GetItem on an instance of MockAzureDevOpsClient.
+type AzureDevOpsClientGetItemFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 *azuredevops.GitVersionDescriptor
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.Item
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetItemFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetItemFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetMergeBaseFunc describes the behavior when the
+// GetMergeBase method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientGetMergeBaseFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) (string, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) (string, error)
+	history     []AzureDevOpsClientGetMergeBaseFuncCall
+	mutex       sync.Mutex
+}
+
+// GetMergeBase delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetMergeBase(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 string, v3 string) (string, error) {
+	r0, r1 := m.GetMergeBaseFunc.nextHook()(v0, v1, v2, v3)
+	m.GetMergeBaseFunc.appendCall(AzureDevOpsClientGetMergeBaseFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetMergeBase method
+// of the parent MockAzureDevOpsClient instance is invoked and the hook
+// queue is empty.
+func (f *AzureDevOpsClientGetMergeBaseFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) (string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetMergeBase method of the parent MockAzureDevOpsClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientGetMergeBaseFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) (string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetMergeBaseFunc) SetDefaultReturn(r0 string, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) (string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetMergeBaseFunc) PushReturn(r0 string, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) (string, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetMergeBaseFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) (string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetMergeBaseFunc) appendCall(r0 AzureDevOpsClientGetMergeBaseFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientGetMergeBaseFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientGetMergeBaseFunc) History() []AzureDevOpsClientGetMergeBaseFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetMergeBaseFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetMergeBaseFuncCall is an object that describes an
+// invocation of method GetMergeBase on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientGetMergeBaseFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetMergeBaseFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetMergeBaseFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetMergeBasesFunc describes the behavior when the
+// GetMergeBases method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientGetMergeBasesFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) ([]string, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) ([]string, error)
+	history     []AzureDevOpsClientGetMergeBasesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetMergeBases delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetMergeBases(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 string, v3 string) ([]string, error) {
+	r0, r1 := m.GetMergeBasesFunc.nextHook()(v0, v1, v2, v3)
+	m.GetMergeBasesFunc.appendCall(AzureDevOpsClientGetMergeBasesFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetMergeBases method
+// of the parent MockAzureDevOpsClient instance is invoked and the hook
+// queue is empty.
+func (f *AzureDevOpsClientGetMergeBasesFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) ([]string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetMergeBases method of the parent MockAzureDevOpsClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientGetMergeBasesFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) ([]string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetMergeBasesFunc) SetDefaultReturn(r0 []string, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) ([]string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetMergeBasesFunc) PushReturn(r0 []string, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) ([]string, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetMergeBasesFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) ([]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetMergeBasesFunc) appendCall(r0 AzureDevOpsClientGetMergeBasesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientGetMergeBasesFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientGetMergeBasesFunc) History() []AzureDevOpsClientGetMergeBasesFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetMergeBasesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetMergeBasesFuncCall is an object that describes an
+// invocation of method GetMergeBases on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientGetMergeBasesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetMergeBasesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetMergeBasesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetPipelineRunFunc describes the behavior when the
+// GetPipelineRun method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientGetPipelineRunFunc struct {
+	defaultHook func(context.Context, string, string, int, int) (azuredevops.PipelineRun, error)
+	hooks       []func(context.Context, string, string, int, int) (azuredevops.PipelineRun, error)
+	history     []AzureDevOpsClientGetPipelineRunFuncCall
+	mutex       sync.Mutex
+}
+
+// GetPipelineRun delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetPipelineRun(v0 context.Context, v1 string, v2 string, v3 int, v4 int) (azuredevops.PipelineRun, error) {
+	r0, r1 := m.GetPipelineRunFunc.nextHook()(v0, v1, v2, v3, v4)
+	m.GetPipelineRunFunc.appendCall(AzureDevOpsClientGetPipelineRunFuncCall{v0, v1, v2, v3, v4, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetPipelineRun
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientGetPipelineRunFunc) SetDefaultHook(hook func(context.Context, string, string, int, int) (azuredevops.PipelineRun, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetPipelineRun method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientGetPipelineRunFunc) PushHook(hook func(context.Context, string, string, int, int) (azuredevops.PipelineRun, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetPipelineRunFunc) SetDefaultReturn(r0 azuredevops.PipelineRun, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, int, int) (azuredevops.PipelineRun, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetPipelineRunFunc) PushReturn(r0 azuredevops.PipelineRun, r1 error) {
+	f.PushHook(func(context.Context, string, string, int, int) (azuredevops.PipelineRun, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetPipelineRunFunc) nextHook() func(context.Context, string, string, int, int) (azuredevops.PipelineRun, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetPipelineRunFunc) appendCall(r0 AzureDevOpsClientGetPipelineRunFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientGetPipelineRunFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientGetPipelineRunFunc) History() []AzureDevOpsClientGetPipelineRunFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetPipelineRunFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetPipelineRunFuncCall is an object that describes an
+// invocation of method GetPipelineRun on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientGetPipelineRunFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Arg4 is the value of the 5th argument passed to this method
+	// invocation.
+	Arg4 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.PipelineRun
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetPipelineRunFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3, c.Arg4}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetPipelineRunFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetProjectFunc describes the behavior when the
+// GetProject method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientGetProjectFunc struct {
+	defaultHook func(context.Context, string, string) (azuredevops.Project, error)
+	hooks       []func(context.Context, string, string) (azuredevops.Project, error)
+	history     []AzureDevOpsClientGetProjectFuncCall
+	mutex       sync.Mutex
+}
+
+// GetProject delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetProject(v0 context.Context, v1 string, v2 string) (azuredevops.Project, error) {
+	r0, r1 := m.GetProjectFunc.nextHook()(v0, v1, v2)
+	m.GetProjectFunc.appendCall(AzureDevOpsClientGetProjectFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetProject method of
+// the parent MockAzureDevOpsClient instance is invoked and the hook queue
+// is empty.
+func (f *AzureDevOpsClientGetProjectFunc) SetDefaultHook(hook func(context.Context, string, string) (azuredevops.Project, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetProject method of the parent MockAzureDevOpsClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientGetProjectFunc) PushHook(hook func(context.Context, string, string) (azuredevops.Project, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetProjectFunc) SetDefaultReturn(r0 azuredevops.Project, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string) (azuredevops.Project, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetProjectFunc) PushReturn(r0 azuredevops.Project, r1 error) {
+	f.PushHook(func(context.Context, string, string) (azuredevops.Project, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetProjectFunc) nextHook() func(context.Context, string, string) (azuredevops.Project, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetProjectFunc) appendCall(r0 AzureDevOpsClientGetProjectFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientGetProjectFuncCall objects
+// describing the invocations of this function.
+func (f *AzureDevOpsClientGetProjectFunc) History() []AzureDevOpsClientGetProjectFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetProjectFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetProjectFuncCall is an object that describes an
+// invocation of method GetProject on an instance of MockAzureDevOpsClient.
+type AzureDevOpsClientGetProjectFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.Project
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetProjectFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetProjectFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetProjectIDFunc describes the behavior when the
+// GetProjectID method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientGetProjectIDFunc struct {
+	defaultHook func(context.Context, string, string) (string, error)
+	hooks       []func(context.Context, string, string) (string, error)
+	history     []AzureDevOpsClientGetProjectIDFuncCall
+	mutex       sync.Mutex
+}
+
+// GetProjectID delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetProjectID(v0 context.Context, v1 string, v2 string) (string, error) {
+	r0, r1 := m.GetProjectIDFunc.nextHook()(v0, v1, v2)
+	m.GetProjectIDFunc.appendCall(AzureDevOpsClientGetProjectIDFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetProjectID method
+// of the parent MockAzureDevOpsClient instance is invoked and the hook
+// queue is empty.
+func (f *AzureDevOpsClientGetProjectIDFunc) SetDefaultHook(hook func(context.Context, string, string) (string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetProjectID method of the parent MockAzureDevOpsClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientGetProjectIDFunc) PushHook(hook func(context.Context, string, string) (string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetProjectIDFunc) SetDefaultReturn(r0 string, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string) (string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetProjectIDFunc) PushReturn(r0 string, r1 error) {
+	f.PushHook(func(context.Context, string, string) (string, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetProjectIDFunc) nextHook() func(context.Context, string, string) (string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetProjectIDFunc) appendCall(r0 AzureDevOpsClientGetProjectIDFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientGetProjectIDFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientGetProjectIDFunc) History() []AzureDevOpsClientGetProjectIDFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetProjectIDFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetProjectIDFuncCall is an object that describes an
+// invocation of method GetProjectID on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientGetProjectIDFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetProjectIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetProjectIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetPullRequestFunc describes the behavior when the
+// GetPullRequest method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientGetPullRequestFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.GetPullRequestOptions) (azuredevops.PullRequest, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.GetPullRequestOptions) (azuredevops.PullRequest, error)
+	history     []AzureDevOpsClientGetPullRequestFuncCall
+	mutex       sync.Mutex
+}
+
+// GetPullRequest delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetPullRequest(v0 context.Context, v1 azuredevops.PullRequestCommonArgs, v2 azuredevops.GetPullRequestOptions) (azuredevops.PullRequest, error) {
+	r0, r1 := m.GetPullRequestFunc.nextHook()(v0, v1, v2)
+	m.GetPullRequestFunc.appendCall(AzureDevOpsClientGetPullRequestFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetPullRequest
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientGetPullRequestFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.GetPullRequestOptions) (azuredevops.PullRequest, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetPullRequest method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientGetPullRequestFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.GetPullRequestOptions) (azuredevops.PullRequest, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetPullRequestFunc) SetDefaultReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.GetPullRequestOptions) (azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetPullRequestFunc) PushReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.GetPullRequestOptions) (azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetPullRequestFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.GetPullRequestOptions) (azuredevops.PullRequest, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetPullRequestFunc) appendCall(r0 AzureDevOpsClientGetPullRequestFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientGetPullRequestFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientGetPullRequestFunc) History() []AzureDevOpsClientGetPullRequestFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetPullRequestFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetPullRequestFuncCall is an object that describes an
+// invocation of method GetPullRequest on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientGetPullRequestFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 azuredevops.GetPullRequestOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.PullRequest
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetPullRequestFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetPullRequestFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetPullRequestByRefsFunc describes the behavior when the
+// GetPullRequestByRefs method of the parent MockAzureDevOpsClient instance
+// is invoked.
+type AzureDevOpsClientGetPullRequestByRefsFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) (azuredevops.PullRequest, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) (azuredevops.PullRequest, error)
+	history     []AzureDevOpsClientGetPullRequestByRefsFuncCall
+	mutex       sync.Mutex
+}
+
+// GetPullRequestByRefs delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetPullRequestByRefs(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 string, v3 string) (azuredevops.PullRequest, error) {
+	r0, r1 := m.GetPullRequestByRefsFunc.nextHook()(v0, v1, v2, v3)
+	m.GetPullRequestByRefsFunc.appendCall(AzureDevOpsClientGetPullRequestByRefsFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetPullRequestByRefs
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientGetPullRequestByRefsFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) (azuredevops.PullRequest, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetPullRequestByRefs method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientGetPullRequestByRefsFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) (azuredevops.PullRequest, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetPullRequestByRefsFunc) SetDefaultReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) (azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetPullRequestByRefsFunc) PushReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) (azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetPullRequestByRefsFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, string, string) (azuredevops.PullRequest, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetPullRequestByRefsFunc) appendCall(r0 AzureDevOpsClientGetPullRequestByRefsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientGetPullRequestByRefsFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientGetPullRequestByRefsFunc) History() []AzureDevOpsClientGetPullRequestByRefsFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetPullRequestByRefsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetPullRequestByRefsFuncCall is an object that describes
+// an invocation of method GetPullRequestByRefs on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientGetPullRequestByRefsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.PullRequest
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetPullRequestByRefsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetPullRequestByRefsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetPullRequestPropertiesFunc describes the behavior when
+// the GetPullRequestProperties method of the parent MockAzureDevOpsClient
+// instance is invoked.
+type AzureDevOpsClientGetPullRequestPropertiesFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs) (map[string]azuredevops.PropertyValue, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs) (map[string]azuredevops.PropertyValue, error)
+	history     []AzureDevOpsClientGetPullRequestPropertiesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetPullRequestProperties delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetPullRequestProperties(v0 context.Context, v1 azuredevops.PullRequestCommonArgs) (map[string]azuredevops.PropertyValue, error) {
+	r0, r1 := m.GetPullRequestPropertiesFunc.nextHook()(v0, v1)
+	m.GetPullRequestPropertiesFunc.appendCall(AzureDevOpsClientGetPullRequestPropertiesFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetPullRequestProperties method of the parent MockAzureDevOpsClient
+// instance is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientGetPullRequestPropertiesFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) (map[string]azuredevops.PropertyValue, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetPullRequestProperties method of the parent MockAzureDevOpsClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *AzureDevOpsClientGetPullRequestPropertiesFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) (map[string]azuredevops.PropertyValue, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetPullRequestPropertiesFunc) SetDefaultReturn(r0 map[string]azuredevops.PropertyValue, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs) (map[string]azuredevops.PropertyValue, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetPullRequestPropertiesFunc) PushReturn(r0 map[string]azuredevops.PropertyValue, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs) (map[string]azuredevops.PropertyValue, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetPullRequestPropertiesFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs) (map[string]azuredevops.PropertyValue, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetPullRequestPropertiesFunc) appendCall(r0 AzureDevOpsClientGetPullRequestPropertiesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientGetPullRequestPropertiesFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientGetPullRequestPropertiesFunc) History() []AzureDevOpsClientGetPullRequestPropertiesFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetPullRequestPropertiesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetPullRequestPropertiesFuncCall is an object that
+// describes an invocation of method GetPullRequestProperties on an instance
+// of MockAzureDevOpsClient.
+type AzureDevOpsClientGetPullRequestPropertiesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 map[string]azuredevops.PropertyValue
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetPullRequestPropertiesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetPullRequestPropertiesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetPullRequestStatusesFunc describes the behavior when
+// the GetPullRequestStatuses method of the parent MockAzureDevOpsClient
+// instance is invoked.
+type AzureDevOpsClientGetPullRequestStatusesFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error)
+	history     []AzureDevOpsClientGetPullRequestStatusesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetPullRequestStatuses delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetPullRequestStatuses(v0 context.Context, v1 azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error) {
+	r0, r1 := m.GetPullRequestStatusesFunc.nextHook()(v0, v1)
+	m.GetPullRequestStatusesFunc.appendCall(AzureDevOpsClientGetPullRequestStatusesFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetPullRequestStatuses method of the parent MockAzureDevOpsClient
+// instance is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientGetPullRequestStatusesFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetPullRequestStatuses method of the parent MockAzureDevOpsClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *AzureDevOpsClientGetPullRequestStatusesFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetPullRequestStatusesFunc) SetDefaultReturn(r0 []azuredevops.PullRequestBuildStatus, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetPullRequestStatusesFunc) PushReturn(r0 []azuredevops.PullRequestBuildStatus, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetPullRequestStatusesFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetPullRequestStatusesFunc) appendCall(r0 AzureDevOpsClientGetPullRequestStatusesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientGetPullRequestStatusesFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientGetPullRequestStatusesFunc) History() []AzureDevOpsClientGetPullRequestStatusesFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetPullRequestStatusesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetPullRequestStatusesFuncCall is an object that
+// describes an invocation of method GetPullRequestStatuses on an instance
+// of MockAzureDevOpsClient.
+type AzureDevOpsClientGetPullRequestStatusesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.PullRequestBuildStatus
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetPullRequestStatusesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetPullRequestStatusesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetPullRequestsBatchFunc describes the behavior when the
+// GetPullRequestsBatch method of the parent MockAzureDevOpsClient instance
+// is invoked.
+type AzureDevOpsClientGetPullRequestsBatchFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, []string, azuredevops.GetPullRequestsBatchOptions) (azuredevops.GetPullRequestsBatchResult, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, []string, azuredevops.GetPullRequestsBatchOptions) (azuredevops.GetPullRequestsBatchResult, error)
+	history     []AzureDevOpsClientGetPullRequestsBatchFuncCall
+	mutex       sync.Mutex
+}
+
+// GetPullRequestsBatch delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetPullRequestsBatch(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 []string, v3 azuredevops.GetPullRequestsBatchOptions) (azuredevops.GetPullRequestsBatchResult, error) {
+	r0, r1 := m.GetPullRequestsBatchFunc.nextHook()(v0, v1, v2, v3)
+	m.GetPullRequestsBatchFunc.appendCall(AzureDevOpsClientGetPullRequestsBatchFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetPullRequestsBatch
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientGetPullRequestsBatchFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, []string, azuredevops.GetPullRequestsBatchOptions) (azuredevops.GetPullRequestsBatchResult, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetPullRequestsBatch method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientGetPullRequestsBatchFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, []string, azuredevops.GetPullRequestsBatchOptions) (azuredevops.GetPullRequestsBatchResult, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetPullRequestsBatchFunc) SetDefaultReturn(r0 azuredevops.GetPullRequestsBatchResult, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, []string, azuredevops.GetPullRequestsBatchOptions) (azuredevops.GetPullRequestsBatchResult, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetPullRequestsBatchFunc) PushReturn(r0 azuredevops.GetPullRequestsBatchResult, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, []string, azuredevops.GetPullRequestsBatchOptions) (azuredevops.GetPullRequestsBatchResult, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetPullRequestsBatchFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, []string, azuredevops.GetPullRequestsBatchOptions) (azuredevops.GetPullRequestsBatchResult, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetPullRequestsBatchFunc) appendCall(r0 AzureDevOpsClientGetPullRequestsBatchFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientGetPullRequestsBatchFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientGetPullRequestsBatchFunc) History() []AzureDevOpsClientGetPullRequestsBatchFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetPullRequestsBatchFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetPullRequestsBatchFuncCall is an object that describes
+// an invocation of method GetPullRequestsBatch on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientGetPullRequestsBatchFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 azuredevops.GetPullRequestsBatchOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.GetPullRequestsBatchResult
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetPullRequestsBatchFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetPullRequestsBatchFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetPushChangesFunc describes the behavior when the
+// GetPushChanges method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientGetPushChangesFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, int) ([]azuredevops.Change, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, int) ([]azuredevops.Change, error)
+	history     []AzureDevOpsClientGetPushChangesFuncCall
+	mutex       sync.Mutex
+}
+
+// GetPushChanges delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetPushChanges(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 int) ([]azuredevops.Change, error) {
+	r0, r1 := m.GetPushChangesFunc.nextHook()(v0, v1, v2)
+	m.GetPushChangesFunc.appendCall(AzureDevOpsClientGetPushChangesFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetPushChanges
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientGetPushChangesFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, int) ([]azuredevops.Change, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetPushChanges method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientGetPushChangesFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, int) ([]azuredevops.Change, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetPushChangesFunc) SetDefaultReturn(r0 []azuredevops.Change, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, int) ([]azuredevops.Change, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetPushChangesFunc) PushReturn(r0 []azuredevops.Change, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, int) ([]azuredevops.Change, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetPushChangesFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, int) ([]azuredevops.Change, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetPushChangesFunc) appendCall(r0 AzureDevOpsClientGetPushChangesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientGetPushChangesFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientGetPushChangesFunc) History() []AzureDevOpsClientGetPushChangesFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetPushChangesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetPushChangesFuncCall is an object that describes an
+// invocation of method GetPushChanges on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientGetPushChangesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.Change
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetPushChangesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetPushChangesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetReadmeFunc describes the behavior when the GetReadme
+// method of the parent MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientGetReadmeFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs) ([]byte, string, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs) ([]byte, string, error)
+	history     []AzureDevOpsClientGetReadmeFuncCall
+	mutex       sync.Mutex
+}
+
+// GetReadme delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetReadme(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs) ([]byte, string, error) {
+	r0, r1, r2 := m.GetReadmeFunc.nextHook()(v0, v1)
+	m.GetReadmeFunc.appendCall(AzureDevOpsClientGetReadmeFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the GetReadme method of
+// the parent MockAzureDevOpsClient instance is invoked and the hook queue
+// is empty.
+func (f *AzureDevOpsClientGetReadmeFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs) ([]byte, string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetReadme method of the parent MockAzureDevOpsClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientGetReadmeFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs) ([]byte, string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetReadmeFunc) SetDefaultReturn(r0 []byte, r1 string, r2 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs) ([]byte, string, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetReadmeFunc) PushReturn(r0 []byte, r1 string, r2 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs) ([]byte, string, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *AzureDevOpsClientGetReadmeFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs) ([]byte, string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetReadmeFunc) appendCall(r0 AzureDevOpsClientGetReadmeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientGetReadmeFuncCall objects
+// describing the invocations of this function.
+func (f *AzureDevOpsClientGetReadmeFunc) History() []AzureDevOpsClientGetReadmeFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetReadmeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetReadmeFuncCall is an object that describes an
+// invocation of method GetReadme on an instance of MockAzureDevOpsClient.
+type AzureDevOpsClientGetReadmeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []byte
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 string
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetReadmeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetReadmeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// AzureDevOpsClientGetRepoFunc describes the behavior when the GetRepo
+// method of the parent MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientGetRepoFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error)
+	history     []AzureDevOpsClientGetRepoFuncCall
+	mutex       sync.Mutex
+}
+
+// GetRepo delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetRepo(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error) {
+	r0, r1 := m.GetRepoFunc.nextHook()(v0, v1)
+	m.GetRepoFunc.appendCall(AzureDevOpsClientGetRepoFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetRepo method of
+// the parent MockAzureDevOpsClient instance is invoked and the hook queue
+// is empty.
+func (f *AzureDevOpsClientGetRepoFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetRepo method of the parent MockAzureDevOpsClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientGetRepoFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetRepoFunc) SetDefaultReturn(r0 azuredevops.Repository, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetRepoFunc) PushReturn(r0 azuredevops.Repository, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetRepoFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetRepoFunc) appendCall(r0 AzureDevOpsClientGetRepoFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientGetRepoFuncCall objects
+// describing the invocations of this function.
+func (f *AzureDevOpsClientGetRepoFunc) History() []AzureDevOpsClientGetRepoFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetRepoFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetRepoFuncCall is an object that describes an
+// invocation of method GetRepo on an instance of MockAzureDevOpsClient.
+type AzureDevOpsClientGetRepoFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.Repository
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetRepoFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetRepoFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetRepositoryBranchFunc describes the behavior when the
+// GetRepositoryBranch method of the parent MockAzureDevOpsClient instance
+// is invoked.
+type AzureDevOpsClientGetRepositoryBranchFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, string) (azuredevops.Ref, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, string) (azuredevops.Ref, error)
+	history     []AzureDevOpsClientGetRepositoryBranchFuncCall
+	mutex       sync.Mutex
+}
+
+// GetRepositoryBranch delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetRepositoryBranch(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 string) (azuredevops.Ref, error) {
+	r0, r1 := m.GetRepositoryBranchFunc.nextHook()(v0, v1, v2)
+	m.GetRepositoryBranchFunc.appendCall(AzureDevOpsClientGetRepositoryBranchFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetRepositoryBranch
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientGetRepositoryBranchFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, string) (azuredevops.Ref, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetRepositoryBranch method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientGetRepositoryBranchFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, string) (azuredevops.Ref, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetRepositoryBranchFunc) SetDefaultReturn(r0 azuredevops.Ref, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, string) (azuredevops.Ref, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetRepositoryBranchFunc) PushReturn(r0 azuredevops.Ref, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, string) (azuredevops.Ref, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetRepositoryBranchFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, string) (azuredevops.Ref, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetRepositoryBranchFunc) appendCall(r0 AzureDevOpsClientGetRepositoryBranchFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientGetRepositoryBranchFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientGetRepositoryBranchFunc) History() []AzureDevOpsClientGetRepositoryBranchFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetRepositoryBranchFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetRepositoryBranchFuncCall is an object that describes
+// an invocation of method GetRepositoryBranch on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientGetRepositoryBranchFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.Ref
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetRepositoryBranchFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetRepositoryBranchFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetRepositorySizeFunc describes the behavior when the
+// GetRepositorySize method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientGetRepositorySizeFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs) (int64, bool, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs) (int64, bool, error)
+	history     []AzureDevOpsClientGetRepositorySizeFuncCall
+	mutex       sync.Mutex
+}
+
+// GetRepositorySize delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetRepositorySize(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs) (int64, bool, error) {
+	r0, r1, r2 := m.GetRepositorySizeFunc.nextHook()(v0, v1)
+	m.GetRepositorySizeFunc.appendCall(AzureDevOpsClientGetRepositorySizeFuncCall{v0, v1, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the GetRepositorySize
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientGetRepositorySizeFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs) (int64, bool, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetRepositorySize method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientGetRepositorySizeFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs) (int64, bool, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetRepositorySizeFunc) SetDefaultReturn(r0 int64, r1 bool, r2 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs) (int64, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetRepositorySizeFunc) PushReturn(r0 int64, r1 bool, r2 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs) (int64, bool, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *AzureDevOpsClientGetRepositorySizeFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs) (int64, bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetRepositorySizeFunc) appendCall(r0 AzureDevOpsClientGetRepositorySizeFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientGetRepositorySizeFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientGetRepositorySizeFunc) History() []AzureDevOpsClientGetRepositorySizeFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetRepositorySizeFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetRepositorySizeFuncCall is an object that describes an
+// invocation of method GetRepositorySize on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientGetRepositorySizeFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 int64
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 bool
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetRepositorySizeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetRepositorySizeFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// AzureDevOpsClientGetResourceAreasFunc describes the behavior when the
+// GetResourceAreas method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientGetResourceAreasFunc struct {
+	defaultHook func(context.Context) (map[string]string, error)
+	hooks       []func(context.Context) (map[string]string, error)
+	history     []AzureDevOpsClientGetResourceAreasFuncCall
+	mutex       sync.Mutex
+}
+
+// GetResourceAreas delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetResourceAreas(v0 context.Context) (map[string]string, error) {
+	r0, r1 := m.GetResourceAreasFunc.nextHook()(v0)
+	m.GetResourceAreasFunc.appendCall(AzureDevOpsClientGetResourceAreasFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetResourceAreas
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientGetResourceAreasFunc) SetDefaultHook(hook func(context.Context) (map[string]string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetResourceAreas method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientGetResourceAreasFunc) PushHook(hook func(context.Context) (map[string]string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetResourceAreasFunc) SetDefaultReturn(r0 map[string]string, r1 error) {
+	f.SetDefaultHook(func(context.Context) (map[string]string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetResourceAreasFunc) PushReturn(r0 map[string]string, r1 error) {
+	f.PushHook(func(context.Context) (map[string]string, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetResourceAreasFunc) nextHook() func(context.Context) (map[string]string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetResourceAreasFunc) appendCall(r0 AzureDevOpsClientGetResourceAreasFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientGetResourceAreasFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientGetResourceAreasFunc) History() []AzureDevOpsClientGetResourceAreasFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetResourceAreasFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetResourceAreasFuncCall is an object that describes an
+// invocation of method GetResourceAreas on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientGetResourceAreasFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 map[string]string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetResourceAreasFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetResourceAreasFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetSecurityNamespaceIDFunc describes the behavior when
+// the GetSecurityNamespaceID method of the parent MockAzureDevOpsClient
+// instance is invoked.
+type AzureDevOpsClientGetSecurityNamespaceIDFunc struct {
+	defaultHook func(context.Context, string, string) (string, error)
+	hooks       []func(context.Context, string, string) (string, error)
+	history     []AzureDevOpsClientGetSecurityNamespaceIDFuncCall
+	mutex       sync.Mutex
+}
+
+// GetSecurityNamespaceID delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetSecurityNamespaceID(v0 context.Context, v1 string, v2 string) (string, error) {
+	r0, r1 := m.GetSecurityNamespaceIDFunc.nextHook()(v0, v1, v2)
+	m.GetSecurityNamespaceIDFunc.appendCall(AzureDevOpsClientGetSecurityNamespaceIDFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// GetSecurityNamespaceID method of the parent MockAzureDevOpsClient
+// instance is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientGetSecurityNamespaceIDFunc) SetDefaultHook(hook func(context.Context, string, string) (string, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetSecurityNamespaceID method of the parent MockAzureDevOpsClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *AzureDevOpsClientGetSecurityNamespaceIDFunc) PushHook(hook func(context.Context, string, string) (string, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetSecurityNamespaceIDFunc) SetDefaultReturn(r0 string, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string) (string, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetSecurityNamespaceIDFunc) PushReturn(r0 string, r1 error) {
+	f.PushHook(func(context.Context, string, string) (string, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetSecurityNamespaceIDFunc) nextHook() func(context.Context, string, string) (string, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetSecurityNamespaceIDFunc) appendCall(r0 AzureDevOpsClientGetSecurityNamespaceIDFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientGetSecurityNamespaceIDFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientGetSecurityNamespaceIDFunc) History() []AzureDevOpsClientGetSecurityNamespaceIDFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetSecurityNamespaceIDFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetSecurityNamespaceIDFuncCall is an object that
+// describes an invocation of method GetSecurityNamespaceID on an instance
+// of MockAzureDevOpsClient.
+type AzureDevOpsClientGetSecurityNamespaceIDFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 string
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetSecurityNamespaceIDFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetSecurityNamespaceIDFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetTokenInfoFunc describes the behavior when the
+// GetTokenInfo method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientGetTokenInfoFunc struct {
+	defaultHook func(context.Context) (*azuredevops.TokenInfo, error)
+	hooks       []func(context.Context) (*azuredevops.TokenInfo, error)
+	history     []AzureDevOpsClientGetTokenInfoFuncCall
+	mutex       sync.Mutex
+}
+
+// GetTokenInfo delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetTokenInfo(v0 context.Context) (*azuredevops.TokenInfo, error) {
+	r0, r1 := m.GetTokenInfoFunc.nextHook()(v0)
+	m.GetTokenInfoFunc.appendCall(AzureDevOpsClientGetTokenInfoFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the GetTokenInfo method
+// of the parent MockAzureDevOpsClient instance is invoked and the hook
+// queue is empty.
+func (f *AzureDevOpsClientGetTokenInfoFunc) SetDefaultHook(hook func(context.Context) (*azuredevops.TokenInfo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetTokenInfo method of the parent MockAzureDevOpsClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientGetTokenInfoFunc) PushHook(hook func(context.Context) (*azuredevops.TokenInfo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetTokenInfoFunc) SetDefaultReturn(r0 *azuredevops.TokenInfo, r1 error) {
+	f.SetDefaultHook(func(context.Context) (*azuredevops.TokenInfo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetTokenInfoFunc) PushReturn(r0 *azuredevops.TokenInfo, r1 error) {
+	f.PushHook(func(context.Context) (*azuredevops.TokenInfo, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientGetTokenInfoFunc) nextHook() func(context.Context) (*azuredevops.TokenInfo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetTokenInfoFunc) appendCall(r0 AzureDevOpsClientGetTokenInfoFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientGetTokenInfoFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientGetTokenInfoFunc) History() []AzureDevOpsClientGetTokenInfoFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetTokenInfoFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetTokenInfoFuncCall is an object that describes an
+// invocation of method GetTokenInfo on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientGetTokenInfoFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *azuredevops.TokenInfo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetTokenInfoFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetTokenInfoFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientGetURLFunc describes the behavior when the GetURL method
+// of the parent MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientGetURLFunc struct {
+	defaultHook func() *url.URL
+	hooks       []func() *url.URL
+	history     []AzureDevOpsClientGetURLFuncCall
+	mutex       sync.Mutex
+}
+
+// GetURL delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) GetURL() *url.URL {
+	r0 := m.GetURLFunc.nextHook()()
+	m.GetURLFunc.appendCall(AzureDevOpsClientGetURLFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the GetURL method of the
+// parent MockAzureDevOpsClient instance is invoked and the hook queue is
+// empty.
+func (f *AzureDevOpsClientGetURLFunc) SetDefaultHook(hook func() *url.URL) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// GetURL method of the parent MockAzureDevOpsClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientGetURLFunc) PushHook(hook func() *url.URL) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientGetURLFunc) SetDefaultReturn(r0 *url.URL) {
+	f.SetDefaultHook(func() *url.URL {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientGetURLFunc) PushReturn(r0 *url.URL) {
+	f.PushHook(func() *url.URL {
+		return r0
+	})
+}
+
+func (f *AzureDevOpsClientGetURLFunc) nextHook() func() *url.URL {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientGetURLFunc) appendCall(r0 AzureDevOpsClientGetURLFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientGetURLFuncCall objects
+// describing the invocations of this function.
+func (f *AzureDevOpsClientGetURLFunc) History() []AzureDevOpsClientGetURLFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientGetURLFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientGetURLFuncCall is an object that describes an invocation
+// of method GetURL on an instance of MockAzureDevOpsClient.
+type AzureDevOpsClientGetURLFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 *url.URL
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientGetURLFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientGetURLFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// AzureDevOpsClientInspectCredentialsFunc describes the behavior when the
+// InspectCredentials method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientInspectCredentialsFunc struct {
+	defaultHook func(context.Context, string) (azuredevops.CredentialsInfo, error)
+	hooks       []func(context.Context, string) (azuredevops.CredentialsInfo, error)
+	history     []AzureDevOpsClientInspectCredentialsFuncCall
+	mutex       sync.Mutex
+}
+
+// InspectCredentials delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) InspectCredentials(v0 context.Context, v1 string) (azuredevops.CredentialsInfo, error) {
+	r0, r1 := m.InspectCredentialsFunc.nextHook()(v0, v1)
+	m.InspectCredentialsFunc.appendCall(AzureDevOpsClientInspectCredentialsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the InspectCredentials
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientInspectCredentialsFunc) SetDefaultHook(hook func(context.Context, string) (azuredevops.CredentialsInfo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// InspectCredentials method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientInspectCredentialsFunc) PushHook(hook func(context.Context, string) (azuredevops.CredentialsInfo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientInspectCredentialsFunc) SetDefaultReturn(r0 azuredevops.CredentialsInfo, r1 error) {
+	f.SetDefaultHook(func(context.Context, string) (azuredevops.CredentialsInfo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientInspectCredentialsFunc) PushReturn(r0 azuredevops.CredentialsInfo, r1 error) {
+	f.PushHook(func(context.Context, string) (azuredevops.CredentialsInfo, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientInspectCredentialsFunc) nextHook() func(context.Context, string) (azuredevops.CredentialsInfo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientInspectCredentialsFunc) appendCall(r0 AzureDevOpsClientInspectCredentialsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientInspectCredentialsFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientInspectCredentialsFunc) History() []AzureDevOpsClientInspectCredentialsFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientInspectCredentialsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientInspectCredentialsFuncCall is an object that describes
+// an invocation of method InspectCredentials on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientInspectCredentialsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.CredentialsInfo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientInspectCredentialsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientInspectCredentialsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientIsAzureDevOpsServicesFunc describes the behavior when
+// the IsAzureDevOpsServices method of the parent MockAzureDevOpsClient
+// instance is invoked.
+type AzureDevOpsClientIsAzureDevOpsServicesFunc struct {
+	defaultHook func() bool
+	hooks       []func() bool
+	history     []AzureDevOpsClientIsAzureDevOpsServicesFuncCall
+	mutex       sync.Mutex
+}
+
+// IsAzureDevOpsServices delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) IsAzureDevOpsServices() bool {
+	r0 := m.IsAzureDevOpsServicesFunc.nextHook()()
+	m.IsAzureDevOpsServicesFunc.appendCall(AzureDevOpsClientIsAzureDevOpsServicesFuncCall{r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// IsAzureDevOpsServices method of the parent MockAzureDevOpsClient instance
+// is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientIsAzureDevOpsServicesFunc) SetDefaultHook(hook func() bool) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// IsAzureDevOpsServices method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientIsAzureDevOpsServicesFunc) PushHook(hook func() bool) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientIsAzureDevOpsServicesFunc) SetDefaultReturn(r0 bool) {
+	f.SetDefaultHook(func() bool {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientIsAzureDevOpsServicesFunc) PushReturn(r0 bool) {
+	f.PushHook(func() bool {
+		return r0
+	})
+}
+
+func (f *AzureDevOpsClientIsAzureDevOpsServicesFunc) nextHook() func() bool {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientIsAzureDevOpsServicesFunc) appendCall(r0 AzureDevOpsClientIsAzureDevOpsServicesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientIsAzureDevOpsServicesFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientIsAzureDevOpsServicesFunc) History() []AzureDevOpsClientIsAzureDevOpsServicesFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientIsAzureDevOpsServicesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientIsAzureDevOpsServicesFuncCall is an object that
+// describes an invocation of method IsAzureDevOpsServices on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientIsAzureDevOpsServicesFuncCall struct {
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 bool
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientIsAzureDevOpsServicesFuncCall) Args() []interface{} {
+	return []interface{}{}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientIsAzureDevOpsServicesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// AzureDevOpsClientLikeCommentFunc describes the behavior when the
+// LikeComment method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientLikeCommentFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommentArgs) error
+	hooks       []func(context.Context, azuredevops.PullRequestCommentArgs) error
+	history     []AzureDevOpsClientLikeCommentFuncCall
+	mutex       sync.Mutex
+}
+
+// LikeComment delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) LikeComment(v0 context.Context, v1 azuredevops.PullRequestCommentArgs) error {
+	r0 := m.LikeCommentFunc.nextHook()(v0, v1)
+	m.LikeCommentFunc.appendCall(AzureDevOpsClientLikeCommentFuncCall{v0, v1, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the LikeComment method
+// of the parent MockAzureDevOpsClient instance is invoked and the hook
+// queue is empty.
+func (f *AzureDevOpsClientLikeCommentFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommentArgs) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// LikeComment method of the parent MockAzureDevOpsClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientLikeCommentFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommentArgs) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientLikeCommentFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommentArgs) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientLikeCommentFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommentArgs) error {
+		return r0
+	})
+}
+
+func (f *AzureDevOpsClientLikeCommentFunc) nextHook() func(context.Context, azuredevops.PullRequestCommentArgs) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientLikeCommentFunc) appendCall(r0 AzureDevOpsClientLikeCommentFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientLikeCommentFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientLikeCommentFunc) History() []AzureDevOpsClientLikeCommentFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientLikeCommentFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientLikeCommentFuncCall is an object that describes an
+// invocation of method LikeComment on an instance of MockAzureDevOpsClient.
+type AzureDevOpsClientLikeCommentFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommentArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientLikeCommentFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientLikeCommentFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// AzureDevOpsClientListAccessibleOrgsFunc describes the behavior when the
+// ListAccessibleOrgs method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientListAccessibleOrgsFunc struct {
+	defaultHook func(context.Context) ([]azuredevops.Org, error)
+	hooks       []func(context.Context) ([]azuredevops.Org, error)
+	history     []AzureDevOpsClientListAccessibleOrgsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListAccessibleOrgs delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListAccessibleOrgs(v0 context.Context) ([]azuredevops.Org, error) {
+	r0, r1 := m.ListAccessibleOrgsFunc.nextHook()(v0)
+	m.ListAccessibleOrgsFunc.appendCall(AzureDevOpsClientListAccessibleOrgsFuncCall{v0, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListAccessibleOrgs
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientListAccessibleOrgsFunc) SetDefaultHook(hook func(context.Context) ([]azuredevops.Org, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListAccessibleOrgs method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientListAccessibleOrgsFunc) PushHook(hook func(context.Context) ([]azuredevops.Org, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListAccessibleOrgsFunc) SetDefaultReturn(r0 []azuredevops.Org, r1 error) {
+	f.SetDefaultHook(func(context.Context) ([]azuredevops.Org, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListAccessibleOrgsFunc) PushReturn(r0 []azuredevops.Org, r1 error) {
+	f.PushHook(func(context.Context) ([]azuredevops.Org, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListAccessibleOrgsFunc) nextHook() func(context.Context) ([]azuredevops.Org, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListAccessibleOrgsFunc) appendCall(r0 AzureDevOpsClientListAccessibleOrgsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientListAccessibleOrgsFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientListAccessibleOrgsFunc) History() []AzureDevOpsClientListAccessibleOrgsFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListAccessibleOrgsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListAccessibleOrgsFuncCall is an object that describes
+// an invocation of method ListAccessibleOrgs on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientListAccessibleOrgsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.Org
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListAccessibleOrgsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListAccessibleOrgsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListAccessibleRepositoriesFunc describes the behavior
+// when the ListAccessibleRepositories method of the parent
+// MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientListAccessibleRepositoriesFunc struct {
+	defaultHook func(context.Context, string, string, azuredevops.ListAccessibleRepositoriesOptions) ([]azuredevops.Repository, error)
+	hooks       []func(context.Context, string, string, azuredevops.ListAccessibleRepositoriesOptions) ([]azuredevops.Repository, error)
+	history     []AzureDevOpsClientListAccessibleRepositoriesFuncCall
+	mutex       sync.Mutex
+}
+
+// ListAccessibleRepositories delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListAccessibleRepositories(v0 context.Context, v1 string, v2 string, v3 azuredevops.ListAccessibleRepositoriesOptions) ([]azuredevops.Repository, error) {
+	r0, r1 := m.ListAccessibleRepositoriesFunc.nextHook()(v0, v1, v2, v3)
+	m.ListAccessibleRepositoriesFunc.appendCall(AzureDevOpsClientListAccessibleRepositoriesFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListAccessibleRepositories method of the parent MockAzureDevOpsClient
+// instance is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientListAccessibleRepositoriesFunc) SetDefaultHook(hook func(context.Context, string, string, azuredevops.ListAccessibleRepositoriesOptions) ([]azuredevops.Repository, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListAccessibleRepositories method of the parent MockAzureDevOpsClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *AzureDevOpsClientListAccessibleRepositoriesFunc) PushHook(hook func(context.Context, string, string, azuredevops.ListAccessibleRepositoriesOptions) ([]azuredevops.Repository, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListAccessibleRepositoriesFunc) SetDefaultReturn(r0 []azuredevops.Repository, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, azuredevops.ListAccessibleRepositoriesOptions) ([]azuredevops.Repository, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListAccessibleRepositoriesFunc) PushReturn(r0 []azuredevops.Repository, r1 error) {
+	f.PushHook(func(context.Context, string, string, azuredevops.ListAccessibleRepositoriesOptions) ([]azuredevops.Repository, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListAccessibleRepositoriesFunc) nextHook() func(context.Context, string, string, azuredevops.ListAccessibleRepositoriesOptions) ([]azuredevops.Repository, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListAccessibleRepositoriesFunc) appendCall(r0 AzureDevOpsClientListAccessibleRepositoriesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientListAccessibleRepositoriesFuncCall objects describing
+// the invocations of this function.
+func (f *AzureDevOpsClientListAccessibleRepositoriesFunc) History() []AzureDevOpsClientListAccessibleRepositoriesFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListAccessibleRepositoriesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListAccessibleRepositoriesFuncCall is an object that
+// describes an invocation of method ListAccessibleRepositories on an
+// instance of MockAzureDevOpsClient.
+type AzureDevOpsClientListAccessibleRepositoriesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 azuredevops.ListAccessibleRepositoriesOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.Repository
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListAccessibleRepositoriesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListAccessibleRepositoriesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListAuthorizedUserOrganizationsFunc describes the
+// behavior when the ListAuthorizedUserOrganizations method of the parent
+// MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientListAuthorizedUserOrganizationsFunc struct {
+	defaultHook func(context.Context, azuredevops.Profile) ([]azuredevops.Org, error)
+	hooks       []func(context.Context, azuredevops.Profile) ([]azuredevops.Org, error)
+	history     []AzureDevOpsClientListAuthorizedUserOrganizationsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListAuthorizedUserOrganizations delegates to the next hook function in
+// the queue and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListAuthorizedUserOrganizations(v0 context.Context, v1 azuredevops.Profile) ([]azuredevops.Org, error) {
+	r0, r1 := m.ListAuthorizedUserOrganizationsFunc.nextHook()(v0, v1)
+	m.ListAuthorizedUserOrganizationsFunc.appendCall(AzureDevOpsClientListAuthorizedUserOrganizationsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListAuthorizedUserOrganizations method of the parent
+// MockAzureDevOpsClient instance is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientListAuthorizedUserOrganizationsFunc) SetDefaultHook(hook func(context.Context, azuredevops.Profile) ([]azuredevops.Org, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListAuthorizedUserOrganizations method of the parent
+// MockAzureDevOpsClient instance invokes the hook at the front of the queue
+// and discards it. After the queue is empty, the default hook function is
+// invoked for any future action.
+func (f *AzureDevOpsClientListAuthorizedUserOrganizationsFunc) PushHook(hook func(context.Context, azuredevops.Profile) ([]azuredevops.Org, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListAuthorizedUserOrganizationsFunc) SetDefaultReturn(r0 []azuredevops.Org, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.Profile) ([]azuredevops.Org, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListAuthorizedUserOrganizationsFunc) PushReturn(r0 []azuredevops.Org, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.Profile) ([]azuredevops.Org, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListAuthorizedUserOrganizationsFunc) nextHook() func(context.Context, azuredevops.Profile) ([]azuredevops.Org, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListAuthorizedUserOrganizationsFunc) appendCall(r0 AzureDevOpsClientListAuthorizedUserOrganizationsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientListAuthorizedUserOrganizationsFuncCall objects
+// describing the invocations of this function.
+func (f *AzureDevOpsClientListAuthorizedUserOrganizationsFunc) History() []AzureDevOpsClientListAuthorizedUserOrganizationsFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListAuthorizedUserOrganizationsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListAuthorizedUserOrganizationsFuncCall is an object
+// that describes an invocation of method ListAuthorizedUserOrganizations on
+// an instance of MockAzureDevOpsClient.
+type AzureDevOpsClientListAuthorizedUserOrganizationsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.Profile
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.Org
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListAuthorizedUserOrganizationsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListAuthorizedUserOrganizationsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListBranchPoliciesFunc describes the behavior when the
+// ListBranchPolicies method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientListBranchPoliciesFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, string) ([]azuredevops.PolicyConfiguration, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, string) ([]azuredevops.PolicyConfiguration, error)
+	history     []AzureDevOpsClientListBranchPoliciesFuncCall
+	mutex       sync.Mutex
+}
+
+// ListBranchPolicies delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListBranchPolicies(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 string) ([]azuredevops.PolicyConfiguration, error) {
+	r0, r1 := m.ListBranchPoliciesFunc.nextHook()(v0, v1, v2)
+	m.ListBranchPoliciesFunc.appendCall(AzureDevOpsClientListBranchPoliciesFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListBranchPolicies
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientListBranchPoliciesFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, string) ([]azuredevops.PolicyConfiguration, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListBranchPolicies method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientListBranchPoliciesFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, string) ([]azuredevops.PolicyConfiguration, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListBranchPoliciesFunc) SetDefaultReturn(r0 []azuredevops.PolicyConfiguration, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, string) ([]azuredevops.PolicyConfiguration, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListBranchPoliciesFunc) PushReturn(r0 []azuredevops.PolicyConfiguration, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, string) ([]azuredevops.PolicyConfiguration, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListBranchPoliciesFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, string) ([]azuredevops.PolicyConfiguration, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListBranchPoliciesFunc) appendCall(r0 AzureDevOpsClientListBranchPoliciesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientListBranchPoliciesFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientListBranchPoliciesFunc) History() []AzureDevOpsClientListBranchPoliciesFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListBranchPoliciesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListBranchPoliciesFuncCall is an object that describes
+// an invocation of method ListBranchPolicies on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientListBranchPoliciesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.PolicyConfiguration
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListBranchPoliciesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListBranchPoliciesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListBranchesWithStatsFunc describes the behavior when
+// the ListBranchesWithStats method of the parent MockAzureDevOpsClient
+// instance is invoked.
+type AzureDevOpsClientListBranchesWithStatsFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListBranchesWithStatsOptions) ([]azuredevops.BranchStat, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListBranchesWithStatsOptions) ([]azuredevops.BranchStat, error)
+	history     []AzureDevOpsClientListBranchesWithStatsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListBranchesWithStats delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListBranchesWithStats(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 azuredevops.ListBranchesWithStatsOptions) ([]azuredevops.BranchStat, error) {
+	r0, r1 := m.ListBranchesWithStatsFunc.nextHook()(v0, v1, v2)
+	m.ListBranchesWithStatsFunc.appendCall(AzureDevOpsClientListBranchesWithStatsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListBranchesWithStats method of the parent MockAzureDevOpsClient instance
+// is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientListBranchesWithStatsFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListBranchesWithStatsOptions) ([]azuredevops.BranchStat, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListBranchesWithStats method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientListBranchesWithStatsFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListBranchesWithStatsOptions) ([]azuredevops.BranchStat, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListBranchesWithStatsFunc) SetDefaultReturn(r0 []azuredevops.BranchStat, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListBranchesWithStatsOptions) ([]azuredevops.BranchStat, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListBranchesWithStatsFunc) PushReturn(r0 []azuredevops.BranchStat, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListBranchesWithStatsOptions) ([]azuredevops.BranchStat, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListBranchesWithStatsFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListBranchesWithStatsOptions) ([]azuredevops.BranchStat, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListBranchesWithStatsFunc) appendCall(r0 AzureDevOpsClientListBranchesWithStatsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientListBranchesWithStatsFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientListBranchesWithStatsFunc) History() []AzureDevOpsClientListBranchesWithStatsFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListBranchesWithStatsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListBranchesWithStatsFuncCall is an object that
+// describes an invocation of method ListBranchesWithStats on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientListBranchesWithStatsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 azuredevops.ListBranchesWithStatsOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.BranchStat
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListBranchesWithStatsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListBranchesWithStatsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListBuildArtifactsFunc describes the behavior when the
+// ListBuildArtifacts method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientListBuildArtifactsFunc struct {
+	defaultHook func(context.Context, string, string, int) ([]azuredevops.BuildArtifact, error)
+	hooks       []func(context.Context, string, string, int) ([]azuredevops.BuildArtifact, error)
+	history     []AzureDevOpsClientListBuildArtifactsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListBuildArtifacts delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListBuildArtifacts(v0 context.Context, v1 string, v2 string, v3 int) ([]azuredevops.BuildArtifact, error) {
+	r0, r1 := m.ListBuildArtifactsFunc.nextHook()(v0, v1, v2, v3)
+	m.ListBuildArtifactsFunc.appendCall(AzureDevOpsClientListBuildArtifactsFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListBuildArtifacts
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientListBuildArtifactsFunc) SetDefaultHook(hook func(context.Context, string, string, int) ([]azuredevops.BuildArtifact, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListBuildArtifacts method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientListBuildArtifactsFunc) PushHook(hook func(context.Context, string, string, int) ([]azuredevops.BuildArtifact, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListBuildArtifactsFunc) SetDefaultReturn(r0 []azuredevops.BuildArtifact, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, int) ([]azuredevops.BuildArtifact, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListBuildArtifactsFunc) PushReturn(r0 []azuredevops.BuildArtifact, r1 error) {
+	f.PushHook(func(context.Context, string, string, int) ([]azuredevops.BuildArtifact, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListBuildArtifactsFunc) nextHook() func(context.Context, string, string, int) ([]azuredevops.BuildArtifact, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListBuildArtifactsFunc) appendCall(r0 AzureDevOpsClientListBuildArtifactsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientListBuildArtifactsFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientListBuildArtifactsFunc) History() []AzureDevOpsClientListBuildArtifactsFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListBuildArtifactsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListBuildArtifactsFuncCall is an object that describes
+// an invocation of method ListBuildArtifacts on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientListBuildArtifactsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 int
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.BuildArtifact
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListBuildArtifactsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListBuildArtifactsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListBuildDefinitionsFunc describes the behavior when the
+// ListBuildDefinitions method of the parent MockAzureDevOpsClient instance
+// is invoked.
+type AzureDevOpsClientListBuildDefinitionsFunc struct {
+	defaultHook func(context.Context, string, string, azuredevops.ListBuildDefinitionsOptions) ([]azuredevops.BuildDefinition, error)
+	hooks       []func(context.Context, string, string, azuredevops.ListBuildDefinitionsOptions) ([]azuredevops.BuildDefinition, error)
+	history     []AzureDevOpsClientListBuildDefinitionsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListBuildDefinitions delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListBuildDefinitions(v0 context.Context, v1 string, v2 string, v3 azuredevops.ListBuildDefinitionsOptions) ([]azuredevops.BuildDefinition, error) {
+	r0, r1 := m.ListBuildDefinitionsFunc.nextHook()(v0, v1, v2, v3)
+	m.ListBuildDefinitionsFunc.appendCall(AzureDevOpsClientListBuildDefinitionsFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListBuildDefinitions
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientListBuildDefinitionsFunc) SetDefaultHook(hook func(context.Context, string, string, azuredevops.ListBuildDefinitionsOptions) ([]azuredevops.BuildDefinition, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListBuildDefinitions method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientListBuildDefinitionsFunc) PushHook(hook func(context.Context, string, string, azuredevops.ListBuildDefinitionsOptions) ([]azuredevops.BuildDefinition, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListBuildDefinitionsFunc) SetDefaultReturn(r0 []azuredevops.BuildDefinition, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, azuredevops.ListBuildDefinitionsOptions) ([]azuredevops.BuildDefinition, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListBuildDefinitionsFunc) PushReturn(r0 []azuredevops.BuildDefinition, r1 error) {
+	f.PushHook(func(context.Context, string, string, azuredevops.ListBuildDefinitionsOptions) ([]azuredevops.BuildDefinition, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListBuildDefinitionsFunc) nextHook() func(context.Context, string, string, azuredevops.ListBuildDefinitionsOptions) ([]azuredevops.BuildDefinition, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListBuildDefinitionsFunc) appendCall(r0 AzureDevOpsClientListBuildDefinitionsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientListBuildDefinitionsFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientListBuildDefinitionsFunc) History() []AzureDevOpsClientListBuildDefinitionsFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListBuildDefinitionsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListBuildDefinitionsFuncCall is an object that describes
+// an invocation of method ListBuildDefinitions on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientListBuildDefinitionsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 azuredevops.ListBuildDefinitionsOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.BuildDefinition
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListBuildDefinitionsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListBuildDefinitionsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListCommentLikesFunc describes the behavior when the
+// ListCommentLikes method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientListCommentLikesFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommentArgs) ([]azuredevops.CreatorInfo, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommentArgs) ([]azuredevops.CreatorInfo, error)
+	history     []AzureDevOpsClientListCommentLikesFuncCall
+	mutex       sync.Mutex
+}
+
+// ListCommentLikes delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListCommentLikes(v0 context.Context, v1 azuredevops.PullRequestCommentArgs) ([]azuredevops.CreatorInfo, error) {
+	r0, r1 := m.ListCommentLikesFunc.nextHook()(v0, v1)
+	m.ListCommentLikesFunc.appendCall(AzureDevOpsClientListCommentLikesFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListCommentLikes
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientListCommentLikesFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommentArgs) ([]azuredevops.CreatorInfo, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListCommentLikes method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientListCommentLikesFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommentArgs) ([]azuredevops.CreatorInfo, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListCommentLikesFunc) SetDefaultReturn(r0 []azuredevops.CreatorInfo, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommentArgs) ([]azuredevops.CreatorInfo, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListCommentLikesFunc) PushReturn(r0 []azuredevops.CreatorInfo, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommentArgs) ([]azuredevops.CreatorInfo, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListCommentLikesFunc) nextHook() func(context.Context, azuredevops.PullRequestCommentArgs) ([]azuredevops.CreatorInfo, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListCommentLikesFunc) appendCall(r0 AzureDevOpsClientListCommentLikesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientListCommentLikesFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientListCommentLikesFunc) History() []AzureDevOpsClientListCommentLikesFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListCommentLikesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListCommentLikesFuncCall is an object that describes an
+// invocation of method ListCommentLikes on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientListCommentLikesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommentArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.CreatorInfo
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListCommentLikesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListCommentLikesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListCommitsFunc describes the behavior when the
+// ListCommits method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientListCommitsFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListCommitsCriteria) ([]azuredevops.Commit, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListCommitsCriteria) ([]azuredevops.Commit, error)
+	history     []AzureDevOpsClientListCommitsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListCommits delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListCommits(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 azuredevops.ListCommitsCriteria) ([]azuredevops.Commit, error) {
+	r0, r1 := m.ListCommitsFunc.nextHook()(v0, v1, v2)
+	m.ListCommitsFunc.appendCall(AzureDevOpsClientListCommitsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListCommits method
+// of the parent MockAzureDevOpsClient instance is invoked and the hook
+// queue is empty.
+func (f *AzureDevOpsClientListCommitsFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListCommitsCriteria) ([]azuredevops.Commit, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListCommits method of the parent MockAzureDevOpsClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientListCommitsFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListCommitsCriteria) ([]azuredevops.Commit, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListCommitsFunc) SetDefaultReturn(r0 []azuredevops.Commit, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListCommitsCriteria) ([]azuredevops.Commit, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListCommitsFunc) PushReturn(r0 []azuredevops.Commit, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListCommitsCriteria) ([]azuredevops.Commit, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListCommitsFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListCommitsCriteria) ([]azuredevops.Commit, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListCommitsFunc) appendCall(r0 AzureDevOpsClientListCommitsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientListCommitsFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientListCommitsFunc) History() []AzureDevOpsClientListCommitsFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListCommitsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListCommitsFuncCall is an object that describes an
+// invocation of method ListCommits on an instance of MockAzureDevOpsClient.
+type AzureDevOpsClientListCommitsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 azuredevops.ListCommitsCriteria
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.Commit
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListCommitsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListCommitsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListCommitsByProjectFunc describes the behavior when the
+// ListCommitsByProject method of the parent MockAzureDevOpsClient instance
+// is invoked.
+type AzureDevOpsClientListCommitsByProjectFunc struct {
+	defaultHook func(context.Context, string, string, azuredevops.ListCommitsByProjectOptions) ([]azuredevops.ProjectCommit, error)
+	hooks       []func(context.Context, string, string, azuredevops.ListCommitsByProjectOptions) ([]azuredevops.ProjectCommit, error)
+	history     []AzureDevOpsClientListCommitsByProjectFuncCall
+	mutex       sync.Mutex
+}
+
+// ListCommitsByProject delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListCommitsByProject(v0 context.Context, v1 string, v2 string, v3 azuredevops.ListCommitsByProjectOptions) ([]azuredevops.ProjectCommit, error) {
+	r0, r1 := m.ListCommitsByProjectFunc.nextHook()(v0, v1, v2, v3)
+	m.ListCommitsByProjectFunc.appendCall(AzureDevOpsClientListCommitsByProjectFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListCommitsByProject
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientListCommitsByProjectFunc) SetDefaultHook(hook func(context.Context, string, string, azuredevops.ListCommitsByProjectOptions) ([]azuredevops.ProjectCommit, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListCommitsByProject method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientListCommitsByProjectFunc) PushHook(hook func(context.Context, string, string, azuredevops.ListCommitsByProjectOptions) ([]azuredevops.ProjectCommit, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListCommitsByProjectFunc) SetDefaultReturn(r0 []azuredevops.ProjectCommit, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, azuredevops.ListCommitsByProjectOptions) ([]azuredevops.ProjectCommit, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListCommitsByProjectFunc) PushReturn(r0 []azuredevops.ProjectCommit, r1 error) {
+	f.PushHook(func(context.Context, string, string, azuredevops.ListCommitsByProjectOptions) ([]azuredevops.ProjectCommit, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListCommitsByProjectFunc) nextHook() func(context.Context, string, string, azuredevops.ListCommitsByProjectOptions) ([]azuredevops.ProjectCommit, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListCommitsByProjectFunc) appendCall(r0 AzureDevOpsClientListCommitsByProjectFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientListCommitsByProjectFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientListCommitsByProjectFunc) History() []AzureDevOpsClientListCommitsByProjectFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListCommitsByProjectFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListCommitsByProjectFuncCall is an object that describes
+// an invocation of method ListCommitsByProject on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientListCommitsByProjectFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 azuredevops.ListCommitsByProjectOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.ProjectCommit
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListCommitsByProjectFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListCommitsByProjectFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListDefaultReviewersFunc describes the behavior when the
+// ListDefaultReviewers method of the parent MockAzureDevOpsClient instance
+// is invoked.
+type AzureDevOpsClientListDefaultReviewersFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs) ([]azuredevops.RequiredReviewer, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs) ([]azuredevops.RequiredReviewer, error)
+	history     []AzureDevOpsClientListDefaultReviewersFuncCall
+	mutex       sync.Mutex
+}
+
+// ListDefaultReviewers delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListDefaultReviewers(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs) ([]azuredevops.RequiredReviewer, error) {
+	r0, r1 := m.ListDefaultReviewersFunc.nextHook()(v0, v1)
+	m.ListDefaultReviewersFunc.appendCall(AzureDevOpsClientListDefaultReviewersFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListDefaultReviewers
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientListDefaultReviewersFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs) ([]azuredevops.RequiredReviewer, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListDefaultReviewers method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientListDefaultReviewersFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs) ([]azuredevops.RequiredReviewer, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListDefaultReviewersFunc) SetDefaultReturn(r0 []azuredevops.RequiredReviewer, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs) ([]azuredevops.RequiredReviewer, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListDefaultReviewersFunc) PushReturn(r0 []azuredevops.RequiredReviewer, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs) ([]azuredevops.RequiredReviewer, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListDefaultReviewersFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs) ([]azuredevops.RequiredReviewer, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListDefaultReviewersFunc) appendCall(r0 AzureDevOpsClientListDefaultReviewersFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientListDefaultReviewersFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientListDefaultReviewersFunc) History() []AzureDevOpsClientListDefaultReviewersFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListDefaultReviewersFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListDefaultReviewersFuncCall is an object that describes
+// an invocation of method ListDefaultReviewers on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientListDefaultReviewersFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.RequiredReviewer
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListDefaultReviewersFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListDefaultReviewersFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListItemsFunc describes the behavior when the ListItems
+// method of the parent MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientListItemsFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListItemsOptions) ([]azuredevops.Item, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListItemsOptions) ([]azuredevops.Item, error)
+	history     []AzureDevOpsClientListItemsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListItems delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListItems(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 azuredevops.ListItemsOptions) ([]azuredevops.Item, error) {
+	r0, r1 := m.ListItemsFunc.nextHook()(v0, v1, v2)
+	m.ListItemsFunc.appendCall(AzureDevOpsClientListItemsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListItems method of
+// the parent MockAzureDevOpsClient instance is invoked and the hook queue
+// is empty.
+func (f *AzureDevOpsClientListItemsFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListItemsOptions) ([]azuredevops.Item, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListItems method of the parent MockAzureDevOpsClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientListItemsFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListItemsOptions) ([]azuredevops.Item, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListItemsFunc) SetDefaultReturn(r0 []azuredevops.Item, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListItemsOptions) ([]azuredevops.Item, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListItemsFunc) PushReturn(r0 []azuredevops.Item, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListItemsOptions) ([]azuredevops.Item, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListItemsFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListItemsOptions) ([]azuredevops.Item, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListItemsFunc) appendCall(r0 AzureDevOpsClientListItemsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientListItemsFuncCall objects
+// describing the invocations of this function.
+func (f *AzureDevOpsClientListItemsFunc) History() []AzureDevOpsClientListItemsFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListItemsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListItemsFuncCall is an object that describes an
+// invocation of method ListItems on an instance of MockAzureDevOpsClient.
+type AzureDevOpsClientListItemsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 azuredevops.ListItemsOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.Item
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListItemsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListItemsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListPipelinesFunc describes the behavior when the
+// ListPipelines method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientListPipelinesFunc struct {
+	defaultHook func(context.Context, string, string, azuredevops.ListPipelinesOptions) ([]azuredevops.Pipeline, error)
+	hooks       []func(context.Context, string, string, azuredevops.ListPipelinesOptions) ([]azuredevops.Pipeline, error)
+	history     []AzureDevOpsClientListPipelinesFuncCall
+	mutex       sync.Mutex
+}
+
+// ListPipelines delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListPipelines(v0 context.Context, v1 string, v2 string, v3 azuredevops.ListPipelinesOptions) ([]azuredevops.Pipeline, error) {
+	r0, r1 := m.ListPipelinesFunc.nextHook()(v0, v1, v2, v3)
+	m.ListPipelinesFunc.appendCall(AzureDevOpsClientListPipelinesFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListPipelines method
+// of the parent MockAzureDevOpsClient instance is invoked and the hook
+// queue is empty.
+func (f *AzureDevOpsClientListPipelinesFunc) SetDefaultHook(hook func(context.Context, string, string, azuredevops.ListPipelinesOptions) ([]azuredevops.Pipeline, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListPipelines method of the parent MockAzureDevOpsClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientListPipelinesFunc) PushHook(hook func(context.Context, string, string, azuredevops.ListPipelinesOptions) ([]azuredevops.Pipeline, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListPipelinesFunc) SetDefaultReturn(r0 []azuredevops.Pipeline, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, azuredevops.ListPipelinesOptions) ([]azuredevops.Pipeline, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListPipelinesFunc) PushReturn(r0 []azuredevops.Pipeline, r1 error) {
+	f.PushHook(func(context.Context, string, string, azuredevops.ListPipelinesOptions) ([]azuredevops.Pipeline, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListPipelinesFunc) nextHook() func(context.Context, string, string, azuredevops.ListPipelinesOptions) ([]azuredevops.Pipeline, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListPipelinesFunc) appendCall(r0 AzureDevOpsClientListPipelinesFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientListPipelinesFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientListPipelinesFunc) History() []AzureDevOpsClientListPipelinesFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListPipelinesFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListPipelinesFuncCall is an object that describes an
+// invocation of method ListPipelines on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientListPipelinesFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 azuredevops.ListPipelinesOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.Pipeline
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListPipelinesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListPipelinesFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListPolicyEvaluationsFunc describes the behavior when
+// the ListPolicyEvaluations method of the parent MockAzureDevOpsClient
+// instance is invoked.
+type AzureDevOpsClientListPolicyEvaluationsFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PolicyEvaluation, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PolicyEvaluation, error)
+	history     []AzureDevOpsClientListPolicyEvaluationsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListPolicyEvaluations delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListPolicyEvaluations(v0 context.Context, v1 azuredevops.PullRequestCommonArgs) ([]azuredevops.PolicyEvaluation, error) {
+	r0, r1 := m.ListPolicyEvaluationsFunc.nextHook()(v0, v1)
+	m.ListPolicyEvaluationsFunc.appendCall(AzureDevOpsClientListPolicyEvaluationsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListPolicyEvaluations method of the parent MockAzureDevOpsClient instance
+// is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientListPolicyEvaluationsFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PolicyEvaluation, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListPolicyEvaluations method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientListPolicyEvaluationsFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PolicyEvaluation, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListPolicyEvaluationsFunc) SetDefaultReturn(r0 []azuredevops.PolicyEvaluation, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PolicyEvaluation, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListPolicyEvaluationsFunc) PushReturn(r0 []azuredevops.PolicyEvaluation, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PolicyEvaluation, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListPolicyEvaluationsFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PolicyEvaluation, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListPolicyEvaluationsFunc) appendCall(r0 AzureDevOpsClientListPolicyEvaluationsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientListPolicyEvaluationsFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientListPolicyEvaluationsFunc) History() []AzureDevOpsClientListPolicyEvaluationsFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListPolicyEvaluationsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListPolicyEvaluationsFuncCall is an object that
+// describes an invocation of method ListPolicyEvaluations on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientListPolicyEvaluationsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.PolicyEvaluation
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListPolicyEvaluationsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListPolicyEvaluationsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListPullRequestCommentsUpdatedSinceFunc describes the
+// behavior when the ListPullRequestCommentsUpdatedSince method of the
+// parent MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientListPullRequestCommentsUpdatedSinceFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs, time.Time) ([]azuredevops.InlineComment, time.Time, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs, time.Time) ([]azuredevops.InlineComment, time.Time, error)
+	history     []AzureDevOpsClientListPullRequestCommentsUpdatedSinceFuncCall
+	mutex       sync.Mutex
+}
+
+// ListPullRequestCommentsUpdatedSince delegates to the next hook function
+// in the queue and stores the parameter and result values of this
+// invocation.
+func (m *MockAzureDevOpsClient) ListPullRequestCommentsUpdatedSince(v0 context.Context, v1 azuredevops.PullRequestCommonArgs, v2 time.Time) ([]azuredevops.InlineComment, time.Time, error) {
+	r0, r1, r2 := m.ListPullRequestCommentsUpdatedSinceFunc.nextHook()(v0, v1, v2)
+	m.ListPullRequestCommentsUpdatedSinceFunc.appendCall(AzureDevOpsClientListPullRequestCommentsUpdatedSinceFuncCall{v0, v1, v2, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the
+// ListPullRequestCommentsUpdatedSince method of the parent
+// MockAzureDevOpsClient instance is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientListPullRequestCommentsUpdatedSinceFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, time.Time) ([]azuredevops.InlineComment, time.Time, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListPullRequestCommentsUpdatedSince method of the parent
+// MockAzureDevOpsClient instance invokes the hook at the front of the queue
+// and discards it. After the queue is empty, the default hook function is
+// invoked for any future action.
+func (f *AzureDevOpsClientListPullRequestCommentsUpdatedSinceFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, time.Time) ([]azuredevops.InlineComment, time.Time, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListPullRequestCommentsUpdatedSinceFunc) SetDefaultReturn(r0 []azuredevops.InlineComment, r1 time.Time, r2 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs, time.Time) ([]azuredevops.InlineComment, time.Time, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListPullRequestCommentsUpdatedSinceFunc) PushReturn(r0 []azuredevops.InlineComment, r1 time.Time, r2 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs, time.Time) ([]azuredevops.InlineComment, time.Time, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *AzureDevOpsClientListPullRequestCommentsUpdatedSinceFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs, time.Time) ([]azuredevops.InlineComment, time.Time, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListPullRequestCommentsUpdatedSinceFunc) appendCall(r0 AzureDevOpsClientListPullRequestCommentsUpdatedSinceFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientListPullRequestCommentsUpdatedSinceFuncCall objects
+// describing the invocations of this function.
+func (f *AzureDevOpsClientListPullRequestCommentsUpdatedSinceFunc) History() []AzureDevOpsClientListPullRequestCommentsUpdatedSinceFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListPullRequestCommentsUpdatedSinceFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListPullRequestCommentsUpdatedSinceFuncCall is an object
+// that describes an invocation of method
+// ListPullRequestCommentsUpdatedSince on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientListPullRequestCommentsUpdatedSinceFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 time.Time
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.InlineComment
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 time.Time
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListPullRequestCommentsUpdatedSinceFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListPullRequestCommentsUpdatedSinceFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// AzureDevOpsClientListPullRequestInlineCommentsFunc describes the behavior
+// when the ListPullRequestInlineComments method of the parent
+// MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientListPullRequestInlineCommentsFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.InlineComment, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.InlineComment, error)
+	history     []AzureDevOpsClientListPullRequestInlineCommentsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListPullRequestInlineComments delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListPullRequestInlineComments(v0 context.Context, v1 azuredevops.PullRequestCommonArgs) ([]azuredevops.InlineComment, error) {
+	r0, r1 := m.ListPullRequestInlineCommentsFunc.nextHook()(v0, v1)
+	m.ListPullRequestInlineCommentsFunc.appendCall(AzureDevOpsClientListPullRequestInlineCommentsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListPullRequestInlineComments method of the parent MockAzureDevOpsClient
+// instance is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientListPullRequestInlineCommentsFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.InlineComment, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListPullRequestInlineComments method of the parent MockAzureDevOpsClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *AzureDevOpsClientListPullRequestInlineCommentsFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.InlineComment, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListPullRequestInlineCommentsFunc) SetDefaultReturn(r0 []azuredevops.InlineComment, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.InlineComment, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListPullRequestInlineCommentsFunc) PushReturn(r0 []azuredevops.InlineComment, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.InlineComment, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListPullRequestInlineCommentsFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.InlineComment, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListPullRequestInlineCommentsFunc) appendCall(r0 AzureDevOpsClientListPullRequestInlineCommentsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientListPullRequestInlineCommentsFuncCall objects describing
+// the invocations of this function.
+func (f *AzureDevOpsClientListPullRequestInlineCommentsFunc) History() []AzureDevOpsClientListPullRequestInlineCommentsFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListPullRequestInlineCommentsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListPullRequestInlineCommentsFuncCall is an object that
+// describes an invocation of method ListPullRequestInlineComments on an
+// instance of MockAzureDevOpsClient.
+type AzureDevOpsClientListPullRequestInlineCommentsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.InlineComment
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListPullRequestInlineCommentsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListPullRequestInlineCommentsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListPullRequestReviewersFunc describes the behavior when
+// the ListPullRequestReviewers method of the parent MockAzureDevOpsClient
+// instance is invoked.
+type AzureDevOpsClientListPullRequestReviewersFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.ListPullRequestReviewersOptions) ([]azuredevops.Reviewer, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.ListPullRequestReviewersOptions) ([]azuredevops.Reviewer, error)
+	history     []AzureDevOpsClientListPullRequestReviewersFuncCall
+	mutex       sync.Mutex
+}
+
+// ListPullRequestReviewers delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListPullRequestReviewers(v0 context.Context, v1 azuredevops.PullRequestCommonArgs, v2 azuredevops.ListPullRequestReviewersOptions) ([]azuredevops.Reviewer, error) {
+	r0, r1 := m.ListPullRequestReviewersFunc.nextHook()(v0, v1, v2)
+	m.ListPullRequestReviewersFunc.appendCall(AzureDevOpsClientListPullRequestReviewersFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListPullRequestReviewers method of the parent MockAzureDevOpsClient
+// instance is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientListPullRequestReviewersFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.ListPullRequestReviewersOptions) ([]azuredevops.Reviewer, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListPullRequestReviewers method of the parent MockAzureDevOpsClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *AzureDevOpsClientListPullRequestReviewersFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.ListPullRequestReviewersOptions) ([]azuredevops.Reviewer, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListPullRequestReviewersFunc) SetDefaultReturn(r0 []azuredevops.Reviewer, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.ListPullRequestReviewersOptions) ([]azuredevops.Reviewer, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListPullRequestReviewersFunc) PushReturn(r0 []azuredevops.Reviewer, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.ListPullRequestReviewersOptions) ([]azuredevops.Reviewer, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListPullRequestReviewersFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.ListPullRequestReviewersOptions) ([]azuredevops.Reviewer, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListPullRequestReviewersFunc) appendCall(r0 AzureDevOpsClientListPullRequestReviewersFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientListPullRequestReviewersFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientListPullRequestReviewersFunc) History() []AzureDevOpsClientListPullRequestReviewersFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListPullRequestReviewersFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListPullRequestReviewersFuncCall is an object that
+// describes an invocation of method ListPullRequestReviewers on an instance
+// of MockAzureDevOpsClient.
+type AzureDevOpsClientListPullRequestReviewersFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 azuredevops.ListPullRequestReviewersOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.Reviewer
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListPullRequestReviewersFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListPullRequestReviewersFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListPullRequestThreadsFunc describes the behavior when
+// the ListPullRequestThreads method of the parent MockAzureDevOpsClient
+// instance is invoked.
+type AzureDevOpsClientListPullRequestThreadsFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.ListPullRequestThreadsOptions) ([]azuredevops.PullRequestCommentResponse, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.ListPullRequestThreadsOptions) ([]azuredevops.PullRequestCommentResponse, error)
+	history     []AzureDevOpsClientListPullRequestThreadsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListPullRequestThreads delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListPullRequestThreads(v0 context.Context, v1 azuredevops.PullRequestCommonArgs, v2 azuredevops.ListPullRequestThreadsOptions) ([]azuredevops.PullRequestCommentResponse, error) {
+	r0, r1 := m.ListPullRequestThreadsFunc.nextHook()(v0, v1, v2)
+	m.ListPullRequestThreadsFunc.appendCall(AzureDevOpsClientListPullRequestThreadsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListPullRequestThreads method of the parent MockAzureDevOpsClient
+// instance is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientListPullRequestThreadsFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.ListPullRequestThreadsOptions) ([]azuredevops.PullRequestCommentResponse, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListPullRequestThreads method of the parent MockAzureDevOpsClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *AzureDevOpsClientListPullRequestThreadsFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.ListPullRequestThreadsOptions) ([]azuredevops.PullRequestCommentResponse, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListPullRequestThreadsFunc) SetDefaultReturn(r0 []azuredevops.PullRequestCommentResponse, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.ListPullRequestThreadsOptions) ([]azuredevops.PullRequestCommentResponse, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListPullRequestThreadsFunc) PushReturn(r0 []azuredevops.PullRequestCommentResponse, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.ListPullRequestThreadsOptions) ([]azuredevops.PullRequestCommentResponse, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListPullRequestThreadsFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.ListPullRequestThreadsOptions) ([]azuredevops.PullRequestCommentResponse, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListPullRequestThreadsFunc) appendCall(r0 AzureDevOpsClientListPullRequestThreadsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientListPullRequestThreadsFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientListPullRequestThreadsFunc) History() []AzureDevOpsClientListPullRequestThreadsFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListPullRequestThreadsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListPullRequestThreadsFuncCall is an object that
+// describes an invocation of method ListPullRequestThreads on an instance
+// of MockAzureDevOpsClient.
+type AzureDevOpsClientListPullRequestThreadsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 azuredevops.ListPullRequestThreadsOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.PullRequestCommentResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListPullRequestThreadsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListPullRequestThreadsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListPullRequestThreadsUpdatedSinceFunc describes the
+// behavior when the ListPullRequestThreadsUpdatedSince method of the parent
+// MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientListPullRequestThreadsUpdatedSinceFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs, time.Time, azuredevops.ListPullRequestThreadsOptions) ([]azuredevops.PullRequestCommentResponse, time.Time, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs, time.Time, azuredevops.ListPullRequestThreadsOptions) ([]azuredevops.PullRequestCommentResponse, time.Time, error)
+	history     []AzureDevOpsClientListPullRequestThreadsUpdatedSinceFuncCall
+	mutex       sync.Mutex
+}
+
+// ListPullRequestThreadsUpdatedSince delegates to the next hook function in
+// the queue and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListPullRequestThreadsUpdatedSince(v0 context.Context, v1 azuredevops.PullRequestCommonArgs, v2 time.Time, v3 azuredevops.ListPullRequestThreadsOptions) ([]azuredevops.PullRequestCommentResponse, time.Time, error) {
+	r0, r1, r2 := m.ListPullRequestThreadsUpdatedSinceFunc.nextHook()(v0, v1, v2, v3)
+	m.ListPullRequestThreadsUpdatedSinceFunc.appendCall(AzureDevOpsClientListPullRequestThreadsUpdatedSinceFuncCall{v0, v1, v2, v3, r0, r1, r2})
+	return r0, r1, r2
+}
+
+// SetDefaultHook sets function that is called when the
+// ListPullRequestThreadsUpdatedSince method of the parent
+// MockAzureDevOpsClient instance is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientListPullRequestThreadsUpdatedSinceFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, time.Time, azuredevops.ListPullRequestThreadsOptions) ([]azuredevops.PullRequestCommentResponse, time.Time, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListPullRequestThreadsUpdatedSince method of the parent
+// MockAzureDevOpsClient instance invokes the hook at the front of the queue
+// and discards it. After the queue is empty, the default hook function is
+// invoked for any future action.
+func (f *AzureDevOpsClientListPullRequestThreadsUpdatedSinceFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, time.Time, azuredevops.ListPullRequestThreadsOptions) ([]azuredevops.PullRequestCommentResponse, time.Time, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListPullRequestThreadsUpdatedSinceFunc) SetDefaultReturn(r0 []azuredevops.PullRequestCommentResponse, r1 time.Time, r2 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs, time.Time, azuredevops.ListPullRequestThreadsOptions) ([]azuredevops.PullRequestCommentResponse, time.Time, error) {
+		return r0, r1, r2
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListPullRequestThreadsUpdatedSinceFunc) PushReturn(r0 []azuredevops.PullRequestCommentResponse, r1 time.Time, r2 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs, time.Time, azuredevops.ListPullRequestThreadsOptions) ([]azuredevops.PullRequestCommentResponse, time.Time, error) {
+		return r0, r1, r2
+	})
+}
+
+func (f *AzureDevOpsClientListPullRequestThreadsUpdatedSinceFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs, time.Time, azuredevops.ListPullRequestThreadsOptions) ([]azuredevops.PullRequestCommentResponse, time.Time, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListPullRequestThreadsUpdatedSinceFunc) appendCall(r0 AzureDevOpsClientListPullRequestThreadsUpdatedSinceFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientListPullRequestThreadsUpdatedSinceFuncCall objects
+// describing the invocations of this function.
+func (f *AzureDevOpsClientListPullRequestThreadsUpdatedSinceFunc) History() []AzureDevOpsClientListPullRequestThreadsUpdatedSinceFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListPullRequestThreadsUpdatedSinceFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListPullRequestThreadsUpdatedSinceFuncCall is an object
+// that describes an invocation of method ListPullRequestThreadsUpdatedSince
+// on an instance of MockAzureDevOpsClient.
+type AzureDevOpsClientListPullRequestThreadsUpdatedSinceFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 time.Time
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 azuredevops.ListPullRequestThreadsOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.PullRequestCommentResponse
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 time.Time
+	// Result2 is the value of the 3rd result returned from this method
+	// invocation.
+	Result2 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListPullRequestThreadsUpdatedSinceFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListPullRequestThreadsUpdatedSinceFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1, c.Result2}
+}
+
+// AzureDevOpsClientListPullRequestsFunc describes the behavior when the
+// ListPullRequests method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientListPullRequestsFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.PullRequestSearchCriteria) ([]azuredevops.PullRequest, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.PullRequestSearchCriteria) ([]azuredevops.PullRequest, error)
+	history     []AzureDevOpsClientListPullRequestsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListPullRequests delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListPullRequests(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 azuredevops.PullRequestSearchCriteria) ([]azuredevops.PullRequest, error) {
+	r0, r1 := m.ListPullRequestsFunc.nextHook()(v0, v1, v2)
+	m.ListPullRequestsFunc.appendCall(AzureDevOpsClientListPullRequestsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListPullRequests
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientListPullRequestsFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.PullRequestSearchCriteria) ([]azuredevops.PullRequest, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListPullRequests method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientListPullRequestsFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.PullRequestSearchCriteria) ([]azuredevops.PullRequest, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListPullRequestsFunc) SetDefaultReturn(r0 []azuredevops.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.PullRequestSearchCriteria) ([]azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListPullRequestsFunc) PushReturn(r0 []azuredevops.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.PullRequestSearchCriteria) ([]azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListPullRequestsFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.PullRequestSearchCriteria) ([]azuredevops.PullRequest, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListPullRequestsFunc) appendCall(r0 AzureDevOpsClientListPullRequestsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientListPullRequestsFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientListPullRequestsFunc) History() []AzureDevOpsClientListPullRequestsFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListPullRequestsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListPullRequestsFuncCall is an object that describes an
+// invocation of method ListPullRequests on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientListPullRequestsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 azuredevops.PullRequestSearchCriteria
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.PullRequest
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListPullRequestsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListPullRequestsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListRefsFunc describes the behavior when the ListRefs
+// method of the parent MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientListRefsFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListRefsOptions) ([]azuredevops.Ref, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListRefsOptions) ([]azuredevops.Ref, error)
+	history     []AzureDevOpsClientListRefsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListRefs delegates to the next hook function in the queue and stores the
+// parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListRefs(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 azuredevops.ListRefsOptions) ([]azuredevops.Ref, error) {
+	r0, r1 := m.ListRefsFunc.nextHook()(v0, v1, v2)
+	m.ListRefsFunc.appendCall(AzureDevOpsClientListRefsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListRefs method of
+// the parent MockAzureDevOpsClient instance is invoked and the hook queue
+// is empty.
+func (f *AzureDevOpsClientListRefsFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListRefsOptions) ([]azuredevops.Ref, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListRefs method of the parent MockAzureDevOpsClient instance invokes the
+// hook at the front of the queue and discards it. After the queue is empty,
+// the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientListRefsFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListRefsOptions) ([]azuredevops.Ref, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListRefsFunc) SetDefaultReturn(r0 []azuredevops.Ref, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListRefsOptions) ([]azuredevops.Ref, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListRefsFunc) PushReturn(r0 []azuredevops.Ref, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListRefsOptions) ([]azuredevops.Ref, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListRefsFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.ListRefsOptions) ([]azuredevops.Ref, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListRefsFunc) appendCall(r0 AzureDevOpsClientListRefsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientListRefsFuncCall objects
+// describing the invocations of this function.
+func (f *AzureDevOpsClientListRefsFunc) History() []AzureDevOpsClientListRefsFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListRefsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListRefsFuncCall is an object that describes an
+// invocation of method ListRefs on an instance of MockAzureDevOpsClient.
+type AzureDevOpsClientListRefsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 azuredevops.ListRefsOptions
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.Ref
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListRefsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListRefsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListRepositoriesByProjectOrOrgFunc describes the
+// behavior when the ListRepositoriesByProjectOrOrg method of the parent
+// MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientListRepositoriesByProjectOrOrgFunc struct {
+	defaultHook func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error)
+	hooks       []func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error)
+	history     []AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall
+	mutex       sync.Mutex
+}
+
+// ListRepositoriesByProjectOrOrg delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListRepositoriesByProjectOrOrg(v0 context.Context, v1 azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error) {
+	r0, r1 := m.ListRepositoriesByProjectOrOrgFunc.nextHook()(v0, v1)
+	m.ListRepositoriesByProjectOrOrgFunc.appendCall(AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListRepositoriesByProjectOrOrg method of the parent MockAzureDevOpsClient
+// instance is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc) SetDefaultHook(hook func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListRepositoriesByProjectOrOrg method of the parent MockAzureDevOpsClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc) PushHook(hook func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc) SetDefaultReturn(r0 []azuredevops.Repository, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc) PushReturn(r0 []azuredevops.Repository, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc) nextHook() func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc) appendCall(r0 AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall objects
+// describing the invocations of this function.
+func (f *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc) History() []AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall is an object that
+// describes an invocation of method ListRepositoriesByProjectOrOrg on an
+// instance of MockAzureDevOpsClient.
+type AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.ListRepositoriesByProjectOrOrgArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.Repository
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListRepositoriesByProjectsOrOrgsFunc describes the
+// behavior when the ListRepositoriesByProjectsOrOrgs method of the parent
+// MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientListRepositoriesByProjectsOrOrgsFunc struct {
+	defaultHook func(context.Context, []string) (azuredevops.ListRepositoriesResult, error)
+	hooks       []func(context.Context, []string) (azuredevops.ListRepositoriesResult, error)
+	history     []AzureDevOpsClientListRepositoriesByProjectsOrOrgsFuncCall
+	mutex       sync.Mutex
+}
+
+// ListRepositoriesByProjectsOrOrgs delegates to the next hook function in
+// the queue and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListRepositoriesByProjectsOrOrgs(v0 context.Context, v1 []string) (azuredevops.ListRepositoriesResult, error) {
+	r0, r1 := m.ListRepositoriesByProjectsOrOrgsFunc.nextHook()(v0, v1)
+	m.ListRepositoriesByProjectsOrOrgsFunc.appendCall(AzureDevOpsClientListRepositoriesByProjectsOrOrgsFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ListRepositoriesByProjectsOrOrgs method of the parent
+// MockAzureDevOpsClient instance is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientListRepositoriesByProjectsOrOrgsFunc) SetDefaultHook(hook func(context.Context, []string) (azuredevops.ListRepositoriesResult, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListRepositoriesByProjectsOrOrgs method of the parent
+// MockAzureDevOpsClient instance invokes the hook at the front of the queue
+// and discards it. After the queue is empty, the default hook function is
+// invoked for any future action.
+func (f *AzureDevOpsClientListRepositoriesByProjectsOrOrgsFunc) PushHook(hook func(context.Context, []string) (azuredevops.ListRepositoriesResult, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListRepositoriesByProjectsOrOrgsFunc) SetDefaultReturn(r0 azuredevops.ListRepositoriesResult, r1 error) {
+	f.SetDefaultHook(func(context.Context, []string) (azuredevops.ListRepositoriesResult, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListRepositoriesByProjectsOrOrgsFunc) PushReturn(r0 azuredevops.ListRepositoriesResult, r1 error) {
+	f.PushHook(func(context.Context, []string) (azuredevops.ListRepositoriesResult, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListRepositoriesByProjectsOrOrgsFunc) nextHook() func(context.Context, []string) (azuredevops.ListRepositoriesResult, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListRepositoriesByProjectsOrOrgsFunc) appendCall(r0 AzureDevOpsClientListRepositoriesByProjectsOrOrgsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientListRepositoriesByProjectsOrOrgsFuncCall objects
+// describing the invocations of this function.
+func (f *AzureDevOpsClientListRepositoriesByProjectsOrOrgsFunc) History() []AzureDevOpsClientListRepositoriesByProjectsOrOrgsFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListRepositoriesByProjectsOrOrgsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListRepositoriesByProjectsOrOrgsFuncCall is an object
+// that describes an invocation of method ListRepositoriesByProjectsOrOrgs
+// on an instance of MockAzureDevOpsClient.
+type AzureDevOpsClientListRepositoriesByProjectsOrOrgsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 []string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.ListRepositoriesResult
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListRepositoriesByProjectsOrOrgsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListRepositoriesByProjectsOrOrgsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientListRepositoriesPageFunc describes the behavior when the
+// ListRepositoriesPage method of the parent MockAzureDevOpsClient instance
+// is invoked.
+type AzureDevOpsClientListRepositoriesPageFunc struct {
+	defaultHook func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) (azuredevops.RepositoriesPage, error)
+	hooks       []func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) (azuredevops.RepositoriesPage, error)
+	history     []AzureDevOpsClientListRepositoriesPageFuncCall
+	mutex       sync.Mutex
+}
+
+// ListRepositoriesPage delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ListRepositoriesPage(v0 context.Context, v1 azuredevops.ListRepositoriesByProjectOrOrgArgs) (azuredevops.RepositoriesPage, error) {
+	r0, r1 := m.ListRepositoriesPageFunc.nextHook()(v0, v1)
+	m.ListRepositoriesPageFunc.appendCall(AzureDevOpsClientListRepositoriesPageFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ListRepositoriesPage
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientListRepositoriesPageFunc) SetDefaultHook(hook func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) (azuredevops.RepositoriesPage, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ListRepositoriesPage method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientListRepositoriesPageFunc) PushHook(hook func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) (azuredevops.RepositoriesPage, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientListRepositoriesPageFunc) SetDefaultReturn(r0 azuredevops.RepositoriesPage, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) (azuredevops.RepositoriesPage, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientListRepositoriesPageFunc) PushReturn(r0 azuredevops.RepositoriesPage, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) (azuredevops.RepositoriesPage, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientListRepositoriesPageFunc) nextHook() func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) (azuredevops.RepositoriesPage, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientListRepositoriesPageFunc) appendCall(r0 AzureDevOpsClientListRepositoriesPageFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientListRepositoriesPageFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientListRepositoriesPageFunc) History() []AzureDevOpsClientListRepositoriesPageFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientListRepositoriesPageFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientListRepositoriesPageFuncCall is an object that describes
+// an invocation of method ListRepositoriesPage on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientListRepositoriesPageFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.ListRepositoriesByProjectOrOrgArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.RepositoriesPage
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientListRepositoriesPageFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientListRepositoriesPageFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientPullRequestApprovalStateFunc describes the behavior when
+// the PullRequestApprovalState method of the parent MockAzureDevOpsClient
+// instance is invoked.
+type AzureDevOpsClientPullRequestApprovalStateFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.ApprovalState, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.ApprovalState, error)
+	history     []AzureDevOpsClientPullRequestApprovalStateFuncCall
+	mutex       sync.Mutex
+}
+
+// PullRequestApprovalState delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) PullRequestApprovalState(v0 context.Context, v1 azuredevops.PullRequestCommonArgs) (azuredevops.ApprovalState, error) {
+	r0, r1 := m.PullRequestApprovalStateFunc.nextHook()(v0, v1)
+	m.PullRequestApprovalStateFunc.appendCall(AzureDevOpsClientPullRequestApprovalStateFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// PullRequestApprovalState method of the parent MockAzureDevOpsClient
+// instance is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientPullRequestApprovalStateFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.ApprovalState, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// PullRequestApprovalState method of the parent MockAzureDevOpsClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *AzureDevOpsClientPullRequestApprovalStateFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.ApprovalState, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientPullRequestApprovalStateFunc) SetDefaultReturn(r0 azuredevops.ApprovalState, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.ApprovalState, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientPullRequestApprovalStateFunc) PushReturn(r0 azuredevops.ApprovalState, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.ApprovalState, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientPullRequestApprovalStateFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.ApprovalState, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientPullRequestApprovalStateFunc) appendCall(r0 AzureDevOpsClientPullRequestApprovalStateFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientPullRequestApprovalStateFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientPullRequestApprovalStateFunc) History() []AzureDevOpsClientPullRequestApprovalStateFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientPullRequestApprovalStateFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientPullRequestApprovalStateFuncCall is an object that
+// describes an invocation of method PullRequestApprovalState on an instance
+// of MockAzureDevOpsClient.
+type AzureDevOpsClientPullRequestApprovalStateFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.ApprovalState
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientPullRequestApprovalStateFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientPullRequestApprovalStateFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientQueryAuditLogFunc describes the behavior when the
+// QueryAuditLog method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientQueryAuditLogFunc struct {
+	defaultHook func(context.Context, azuredevops.QueryAuditLogInput) ([]azuredevops.AuditLogEntry, error)
+	hooks       []func(context.Context, azuredevops.QueryAuditLogInput) ([]azuredevops.AuditLogEntry, error)
+	history     []AzureDevOpsClientQueryAuditLogFuncCall
+	mutex       sync.Mutex
+}
+
+// QueryAuditLog delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) QueryAuditLog(v0 context.Context, v1 azuredevops.QueryAuditLogInput) ([]azuredevops.AuditLogEntry, error) {
+	r0, r1 := m.QueryAuditLogFunc.nextHook()(v0, v1)
+	m.QueryAuditLogFunc.appendCall(AzureDevOpsClientQueryAuditLogFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the QueryAuditLog method
+// of the parent MockAzureDevOpsClient instance is invoked and the hook
+// queue is empty.
+func (f *AzureDevOpsClientQueryAuditLogFunc) SetDefaultHook(hook func(context.Context, azuredevops.QueryAuditLogInput) ([]azuredevops.AuditLogEntry, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// QueryAuditLog method of the parent MockAzureDevOpsClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientQueryAuditLogFunc) PushHook(hook func(context.Context, azuredevops.QueryAuditLogInput) ([]azuredevops.AuditLogEntry, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientQueryAuditLogFunc) SetDefaultReturn(r0 []azuredevops.AuditLogEntry, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.QueryAuditLogInput) ([]azuredevops.AuditLogEntry, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientQueryAuditLogFunc) PushReturn(r0 []azuredevops.AuditLogEntry, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.QueryAuditLogInput) ([]azuredevops.AuditLogEntry, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientQueryAuditLogFunc) nextHook() func(context.Context, azuredevops.QueryAuditLogInput) ([]azuredevops.AuditLogEntry, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientQueryAuditLogFunc) appendCall(r0 AzureDevOpsClientQueryAuditLogFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientQueryAuditLogFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientQueryAuditLogFunc) History() []AzureDevOpsClientQueryAuditLogFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientQueryAuditLogFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientQueryAuditLogFuncCall is an object that describes an
+// invocation of method QueryAuditLog on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientQueryAuditLogFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.QueryAuditLogInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.AuditLogEntry
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientQueryAuditLogFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientQueryAuditLogFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientQueryCommitsBatchFunc describes the behavior when the
+// QueryCommitsBatch method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientQueryCommitsBatchFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.QueryCommitsCriteria) ([]azuredevops.Commit, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.QueryCommitsCriteria) ([]azuredevops.Commit, error)
+	history     []AzureDevOpsClientQueryCommitsBatchFuncCall
+	mutex       sync.Mutex
+}
+
+// QueryCommitsBatch delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) QueryCommitsBatch(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 azuredevops.QueryCommitsCriteria) ([]azuredevops.Commit, error) {
+	r0, r1 := m.QueryCommitsBatchFunc.nextHook()(v0, v1, v2)
+	m.QueryCommitsBatchFunc.appendCall(AzureDevOpsClientQueryCommitsBatchFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the QueryCommitsBatch
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientQueryCommitsBatchFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.QueryCommitsCriteria) ([]azuredevops.Commit, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// QueryCommitsBatch method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientQueryCommitsBatchFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.QueryCommitsCriteria) ([]azuredevops.Commit, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientQueryCommitsBatchFunc) SetDefaultReturn(r0 []azuredevops.Commit, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.QueryCommitsCriteria) ([]azuredevops.Commit, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientQueryCommitsBatchFunc) PushReturn(r0 []azuredevops.Commit, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.QueryCommitsCriteria) ([]azuredevops.Commit, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientQueryCommitsBatchFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.QueryCommitsCriteria) ([]azuredevops.Commit, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientQueryCommitsBatchFunc) appendCall(r0 AzureDevOpsClientQueryCommitsBatchFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of AzureDevOpsClientQueryCommitsBatchFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientQueryCommitsBatchFunc) History() []AzureDevOpsClientQueryCommitsBatchFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientQueryCommitsBatchFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientQueryCommitsBatchFuncCall is an object that describes an
+// invocation of method QueryCommitsBatch on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientQueryCommitsBatchFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 azuredevops.QueryCommitsCriteria
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 []azuredevops.Commit
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientQueryCommitsBatchFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientQueryCommitsBatchFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientReactivatePullRequestFunc describes the behavior when
+// the ReactivatePullRequest method of the parent MockAzureDevOpsClient
+// instance is invoked.
+type AzureDevOpsClientReactivatePullRequestFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)
+	history     []AzureDevOpsClientReactivatePullRequestFuncCall
+	mutex       sync.Mutex
+}
+
+// ReactivatePullRequest delegates to the next hook function in the queue
+// and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ReactivatePullRequest(v0 context.Context, v1 azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+	r0, r1 := m.ReactivatePullRequestFunc.nextHook()(v0, v1)
+	m.ReactivatePullRequestFunc.appendCall(AzureDevOpsClientReactivatePullRequestFuncCall{v0, v1, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the
+// ReactivatePullRequest method of the parent MockAzureDevOpsClient instance
+// is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientReactivatePullRequestFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ReactivatePullRequest method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientReactivatePullRequestFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientReactivatePullRequestFunc) SetDefaultReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientReactivatePullRequestFunc) PushReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientReactivatePullRequestFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientReactivatePullRequestFunc) appendCall(r0 AzureDevOpsClientReactivatePullRequestFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientReactivatePullRequestFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientReactivatePullRequestFunc) History() []AzureDevOpsClientReactivatePullRequestFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientReactivatePullRequestFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientReactivatePullRequestFuncCall is an object that
+// describes an invocation of method ReactivatePullRequest on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientReactivatePullRequestFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.PullRequest
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientReactivatePullRequestFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientReactivatePullRequestFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientRemovePullRequestReviewerFunc describes the behavior
+// when the RemovePullRequestReviewer method of the parent
+// MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientRemovePullRequestReviewerFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs, string) error
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs, string) error
+	history     []AzureDevOpsClientRemovePullRequestReviewerFuncCall
+	mutex       sync.Mutex
+}
+
+// RemovePullRequestReviewer delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) RemovePullRequestReviewer(v0 context.Context, v1 azuredevops.PullRequestCommonArgs, v2 string) error {
+	r0 := m.RemovePullRequestReviewerFunc.nextHook()(v0, v1, v2)
+	m.RemovePullRequestReviewerFunc.appendCall(AzureDevOpsClientRemovePullRequestReviewerFuncCall{v0, v1, v2, r0})
+	return r0
+}
+
+// SetDefaultHook sets function that is called when the
+// RemovePullRequestReviewer method of the parent MockAzureDevOpsClient
+// instance is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientRemovePullRequestReviewerFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, string) error) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// RemovePullRequestReviewer method of the parent MockAzureDevOpsClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *AzureDevOpsClientRemovePullRequestReviewerFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, string) error) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientRemovePullRequestReviewerFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs, string) error {
+		return r0
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientRemovePullRequestReviewerFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs, string) error {
+		return r0
+	})
+}
+
+func (f *AzureDevOpsClientRemovePullRequestReviewerFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs, string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientRemovePullRequestReviewerFunc) appendCall(r0 AzureDevOpsClientRemovePullRequestReviewerFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientRemovePullRequestReviewerFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientRemovePullRequestReviewerFunc) History() []AzureDevOpsClientRemovePullRequestReviewerFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientRemovePullRequestReviewerFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientRemovePullRequestReviewerFuncCall is an object that
+// describes an invocation of method RemovePullRequestReviewer on an
+// instance of MockAzureDevOpsClient.
+type AzureDevOpsClientRemovePullRequestReviewerFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientRemovePullRequestReviewerFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientRemovePullRequestReviewerFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0}
+}
+
+// AzureDevOpsClientResolveCommitAuthorsFunc describes the behavior when the
+// ResolveCommitAuthors method of the parent MockAzureDevOpsClient instance
+// is invoked.
+type AzureDevOpsClientResolveCommitAuthorsFunc struct {
+	defaultHook func(context.Context, string, []string) (map[string]azuredevops.User, error)
+	hooks       []func(context.Context, string, []string) (map[string]azuredevops.User, error)
+	history     []AzureDevOpsClientResolveCommitAuthorsFuncCall
+	mutex       sync.Mutex
+}
+
+// ResolveCommitAuthors delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) ResolveCommitAuthors(v0 context.Context, v1 string, v2 []string) (map[string]azuredevops.User, error) {
+	r0, r1 := m.ResolveCommitAuthorsFunc.nextHook()(v0, v1, v2)
+	m.ResolveCommitAuthorsFunc.appendCall(AzureDevOpsClientResolveCommitAuthorsFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
+}
+
+// SetDefaultHook sets function that is called when the ResolveCommitAuthors
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientResolveCommitAuthorsFunc) SetDefaultHook(hook func(context.Context, string, []string) (map[string]azuredevops.User, error)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// ResolveCommitAuthors method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientResolveCommitAuthorsFunc) PushHook(hook func(context.Context, string, []string) (map[string]azuredevops.User, error)) {
+	f.mutex.Lock()
+	f.hooks = append(f.hooks, hook)
+	f.mutex.Unlock()
+}
+
+// SetDefaultReturn calls SetDefaultHook with a function that returns the
+// given values.
+func (f *AzureDevOpsClientResolveCommitAuthorsFunc) SetDefaultReturn(r0 map[string]azuredevops.User, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, []string) (map[string]azuredevops.User, error) {
+		return r0, r1
+	})
+}
+
+// PushReturn calls PushHook with a function that returns the given values.
+func (f *AzureDevOpsClientResolveCommitAuthorsFunc) PushReturn(r0 map[string]azuredevops.User, r1 error) {
+	f.PushHook(func(context.Context, string, []string) (map[string]azuredevops.User, error) {
+		return r0, r1
+	})
+}
+
+func (f *AzureDevOpsClientResolveCommitAuthorsFunc) nextHook() func(context.Context, string, []string) (map[string]azuredevops.User, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if len(f.hooks) == 0 {
+		return f.defaultHook
+	}
+
+	hook := f.hooks[0]
+	f.hooks = f.hooks[1:]
+	return hook
+}
+
+func (f *AzureDevOpsClientResolveCommitAuthorsFunc) appendCall(r0 AzureDevOpsClientResolveCommitAuthorsFuncCall) {
+	f.mutex.Lock()
+	f.history = append(f.history, r0)
+	f.mutex.Unlock()
+}
+
+// History returns a sequence of
+// AzureDevOpsClientResolveCommitAuthorsFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientResolveCommitAuthorsFunc) History() []AzureDevOpsClientResolveCommitAuthorsFuncCall {
+	f.mutex.Lock()
+	history := make([]AzureDevOpsClientResolveCommitAuthorsFuncCall, len(f.history))
+	copy(history, f.history)
+	f.mutex.Unlock()
+
+	return history
+}
+
+// AzureDevOpsClientResolveCommitAuthorsFuncCall is an object that describes
+// an invocation of method ResolveCommitAuthors on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientResolveCommitAuthorsFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []string
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 map[string]azuredevops.User
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
+}
+
+// Args returns an interface slice containing the arguments of this
+// invocation.
+func (c AzureDevOpsClientResolveCommitAuthorsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+}
+
+// Results returns an interface slice containing the results of this
+// invocation.
+func (c AzureDevOpsClientResolveCommitAuthorsFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
+}
+
+// AzureDevOpsClientRunPipelineFunc describes the behavior when the
+// RunPipeline method of the parent MockAzureDevOpsClient instance is
 // invoked.
-type AzureDevOpsClientAbandonPullRequestFunc struct {
-	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)
-	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)
-	history     []AzureDevOpsClientAbandonPullRequestFuncCall
+type AzureDevOpsClientRunPipelineFunc struct {
+	defaultHook func(context.Context, string, string, azuredevops.RunPipelineInput) (azuredevops.PipelineRun, error)
+	hooks       []func(context.Context, string, string, azuredevops.RunPipelineInput) (azuredevops.PipelineRun, error)
+	history     []AzureDevOpsClientRunPipelineFuncCall
 	mutex       sync.Mutex
 }
 
-// AbandonPullRequest delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockAzureDevOpsClient) AbandonPullRequest(v0 context.Context, v1 azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
-	r0, r1 := m.AbandonPullRequestFunc.nextHook()(v0, v1)
-	m.AbandonPullRequestFunc.appendCall(AzureDevOpsClientAbandonPullRequestFuncCall{v0, v1, r0, r1})
+// RunPipeline delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) RunPipeline(v0 context.Context, v1 string, v2 string, v3 azuredevops.RunPipelineInput) (azuredevops.PipelineRun, error) {
+	r0, r1 := m.RunPipelineFunc.nextHook()(v0, v1, v2, v3)
+	m.RunPipelineFunc.appendCall(AzureDevOpsClientRunPipelineFuncCall{v0, v1, v2, v3, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the AbandonPullRequest
-// method of the parent MockAzureDevOpsClient instance is invoked and the
-// hook queue is empty.
-func (f *AzureDevOpsClientAbandonPullRequestFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)) {
+// SetDefaultHook sets function that is called when the RunPipeline method
+// of the parent MockAzureDevOpsClient instance is invoked and the hook
+// queue is empty.
+func (f *AzureDevOpsClientRunPipelineFunc) SetDefaultHook(hook func(context.Context, string, string, azuredevops.RunPipelineInput) (azuredevops.PipelineRun, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// AbandonPullRequest method of the parent MockAzureDevOpsClient instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *AzureDevOpsClientAbandonPullRequestFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)) {
+// RunPipeline method of the parent MockAzureDevOpsClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientRunPipelineFunc) PushHook(hook func(context.Context, string, string, azuredevops.RunPipelineInput) (azuredevops.PipelineRun, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6441,20 +15588,20 @@ func (f *AzureDevOpsClientAbandonPullRequestFunc) PushHook(hook func(context.Con
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *AzureDevOpsClientAbandonPullRequestFunc) SetDefaultReturn(r0 azuredevops.PullRequest, r1 error) {
-	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+func (f *AzureDevOpsClientRunPipelineFunc) SetDefaultReturn(r0 azuredevops.PipelineRun, r1 error) {
+	f.SetDefaultHook(func(context.Context, string, string, azuredevops.RunPipelineInput) (azuredevops.PipelineRun, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *AzureDevOpsClientAbandonPullRequestFunc) PushReturn(r0 azuredevops.PullRequest, r1 error) {
-	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+func (f *AzureDevOpsClientRunPipelineFunc) PushReturn(r0 azuredevops.PipelineRun, r1 error) {
+	f.PushHook(func(context.Context, string, string, azuredevops.RunPipelineInput) (azuredevops.PipelineRun, error) {
 		return r0, r1
 	})
 }
 
-func (f *AzureDevOpsClientAbandonPullRequestFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+func (f *AzureDevOpsClientRunPipelineFunc) nextHook() func(context.Context, string, string, azuredevops.RunPipelineInput) (azuredevops.PipelineRun, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6467,36 +15614,41 @@ func (f *AzureDevOpsClientAbandonPullRequestFunc) nextHook() func(context.Contex
 	return hook
 }
 
-func (f *AzureDevOpsClientAbandonPullRequestFunc) appendCall(r0 AzureDevOpsClientAbandonPullRequestFuncCall) {
+func (f *AzureDevOpsClientRunPipelineFunc) appendCall(r0 AzureDevOpsClientRunPipelineFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of AzureDevOpsClientAbandonPullRequestFuncCall
+// History returns a sequence of AzureDevOpsClientRunPipelineFuncCall
 // objects describing the invocations of this function.
-func (f *AzureDevOpsClientAbandonPullRequestFunc) History() []AzureDevOpsClientAbandonPullRequestFuncCall {
+func (f *AzureDevOpsClientRunPipelineFunc) History() []AzureDevOpsClientRunPipelineFuncCall {
 	f.mutex.Lock()
-	history := make([]AzureDevOpsClientAbandonPullRequestFuncCall, len(f.history))
+	history := make([]AzureDevOpsClientRunPipelineFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// AzureDevOpsClientAbandonPullRequestFuncCall is an object that describes
-// an invocation of method AbandonPullRequest on an instance of
-// MockAzureDevOpsClient.
-type AzureDevOpsClientAbandonPullRequestFuncCall struct {
+// AzureDevOpsClientRunPipelineFuncCall is an object that describes an
+// invocation of method RunPipeline on an instance of MockAzureDevOpsClient.
+type AzureDevOpsClientRunPipelineFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 azuredevops.PullRequestCommonArgs
+	Arg1 string
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 azuredevops.RunPipelineInput
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 azuredevops.PullRequest
+	Result0 azuredevops.PipelineRun
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -6504,46 +15656,46 @@ type AzureDevOpsClientAbandonPullRequestFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c AzureDevOpsClientAbandonPullRequestFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c AzureDevOpsClientRunPipelineFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c AzureDevOpsClientAbandonPullRequestFuncCall) Results() []interface{} {
+func (c AzureDevOpsClientRunPipelineFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// AzureDevOpsClientAuthenticatorFunc describes the behavior when the
-// Authenticator method of the parent MockAzureDevOpsClient instance is
+// AzureDevOpsClientSetAPIVersionFunc describes the behavior when the
+// SetAPIVersion method of the parent MockAzureDevOpsClient instance is
 // invoked.
-type AzureDevOpsClientAuthenticatorFunc struct {
-	defaultHook func() auth.Authenticator
-	hooks       []func() auth.Authenticator
-	history     []AzureDevOpsClientAuthenticatorFuncCall
+type AzureDevOpsClientSetAPIVersionFunc struct {
+	defaultHook func(string)
+	hooks       []func(string)
+	history     []AzureDevOpsClientSetAPIVersionFuncCall
 	mutex       sync.Mutex
 }
 
-// Authenticator delegates to the next hook function in the queue and stores
+// SetAPIVersion delegates to the next hook function in the queue and stores
 // the parameter and result values of this invocation.
-func (m *MockAzureDevOpsClient) Authenticator() auth.Authenticator {
-	r0 := m.AuthenticatorFunc.nextHook()()
-	m.AuthenticatorFunc.appendCall(AzureDevOpsClientAuthenticatorFuncCall{r0})
-	return r0
+func (m *MockAzureDevOpsClient) SetAPIVersion(v0 string) {
+	m.SetAPIVersionFunc.nextHook()(v0)
+	m.SetAPIVersionFunc.appendCall(AzureDevOpsClientSetAPIVersionFuncCall{v0})
+	return
 }
 
-// SetDefaultHook sets function that is called when the Authenticator method
+// SetDefaultHook sets function that is called when the SetAPIVersion method
 // of the parent MockAzureDevOpsClient instance is invoked and the hook
 // queue is empty.
-func (f *AzureDevOpsClientAuthenticatorFunc) SetDefaultHook(hook func() auth.Authenticator) {
+func (f *AzureDevOpsClientSetAPIVersionFunc) SetDefaultHook(hook func(string)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// Authenticator method of the parent MockAzureDevOpsClient instance invokes
+// SetAPIVersion method of the parent MockAzureDevOpsClient instance invokes
 // the hook at the front of the queue and discards it. After the queue is
 // empty, the default hook function is invoked for any future action.
-func (f *AzureDevOpsClientAuthenticatorFunc) PushHook(hook func() auth.Authenticator) {
+func (f *AzureDevOpsClientSetAPIVersionFunc) PushHook(hook func(string)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6551,20 +15703,20 @@ func (f *AzureDevOpsClientAuthenticatorFunc) PushHook(hook func() auth.Authentic
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *AzureDevOpsClientAuthenticatorFunc) SetDefaultReturn(r0 auth.Authenticator) {
-	f.SetDefaultHook(func() auth.Authenticator {
-		return r0
+func (f *AzureDevOpsClientSetAPIVersionFunc) SetDefaultReturn() {
+	f.SetDefaultHook(func(string) {
+		return
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *AzureDevOpsClientAuthenticatorFunc) PushReturn(r0 auth.Authenticator) {
-	f.PushHook(func() auth.Authenticator {
-		return r0
+func (f *AzureDevOpsClientSetAPIVersionFunc) PushReturn() {
+	f.PushHook(func(string) {
+		return
 	})
 }
 
-func (f *AzureDevOpsClientAuthenticatorFunc) nextHook() func() auth.Authenticator {
+func (f *AzureDevOpsClientSetAPIVersionFunc) nextHook() func(string) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6577,75 +15729,75 @@ func (f *AzureDevOpsClientAuthenticatorFunc) nextHook() func() auth.Authenticato
 	return hook
 }
 
-func (f *AzureDevOpsClientAuthenticatorFunc) appendCall(r0 AzureDevOpsClientAuthenticatorFuncCall) {
+func (f *AzureDevOpsClientSetAPIVersionFunc) appendCall(r0 AzureDevOpsClientSetAPIVersionFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of AzureDevOpsClientAuthenticatorFuncCall
+// History returns a sequence of AzureDevOpsClientSetAPIVersionFuncCall
 // objects describing the invocations of this function.
-func (f *AzureDevOpsClientAuthenticatorFunc) History() []AzureDevOpsClientAuthenticatorFuncCall {
+func (f *AzureDevOpsClientSetAPIVersionFunc) History() []AzureDevOpsClientSetAPIVersionFuncCall {
 	f.mutex.Lock()
-	history := make([]AzureDevOpsClientAuthenticatorFuncCall, len(f.history))
+	history := make([]AzureDevOpsClientSetAPIVersionFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// AzureDevOpsClientAuthenticatorFuncCall is an object that describes an
-// invocation of method Authenticator on an instance of
+// AzureDevOpsClientSetAPIVersionFuncCall is an object that describes an
+// invocation of method SetAPIVersion on an instance of
 // MockAzureDevOpsClient.
-type AzureDevOpsClientAuthenticatorFuncCall struct {
-	// Result0 is the value of the 1st result returned from this method
+type AzureDevOpsClientSetAPIVersionFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Result0 auth.Authenticator
+	Arg0 string
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c AzureDevOpsClientAuthenticatorFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c AzureDevOpsClientSetAPIVersionFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c AzureDevOpsClientAuthenticatorFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c AzureDevOpsClientSetAPIVersionFuncCall) Results() []interface{} {
+	return []interface{}{}
 }
 
-// AzureDevOpsClientCompletePullRequestFunc describes the behavior when the
-// CompletePullRequest method of the parent MockAzureDevOpsClient instance
-// is invoked.
-type AzureDevOpsClientCompletePullRequestFunc struct {
-	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error)
-	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error)
-	history     []AzureDevOpsClientCompletePullRequestFuncCall
+// AzureDevOpsClientSetCaptureRawJSONFunc describes the behavior when the
+// SetCaptureRawJSON method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientSetCaptureRawJSONFunc struct {
+	defaultHook func(bool)
+	hooks       []func(bool)
+	history     []AzureDevOpsClientSetCaptureRawJSONFuncCall
 	mutex       sync.Mutex
 }
 
-// CompletePullRequest delegates to the next hook function in the queue and
+// SetCaptureRawJSON delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockAzureDevOpsClient) CompletePullRequest(v0 context.Context, v1 azuredevops.PullRequestCommonArgs, v2 azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error) {
-	r0, r1 := m.CompletePullRequestFunc.nextHook()(v0, v1, v2)
-	m.CompletePullRequestFunc.appendCall(AzureDevOpsClientCompletePullRequestFuncCall{v0, v1, v2, r0, r1})
-	return r0, r1
+func (m *MockAzureDevOpsClient) SetCaptureRawJSON(v0 bool) {
+	m.SetCaptureRawJSONFunc.nextHook()(v0)
+	m.SetCaptureRawJSONFunc.appendCall(AzureDevOpsClientSetCaptureRawJSONFuncCall{v0})
+	return
 }
 
-// SetDefaultHook sets function that is called when the CompletePullRequest
+// SetDefaultHook sets function that is called when the SetCaptureRawJSON
 // method of the parent MockAzureDevOpsClient instance is invoked and the
 // hook queue is empty.
-func (f *AzureDevOpsClientCompletePullRequestFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error)) {
+func (f *AzureDevOpsClientSetCaptureRawJSONFunc) SetDefaultHook(hook func(bool)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CompletePullRequest method of the parent MockAzureDevOpsClient instance
+// SetCaptureRawJSON method of the parent MockAzureDevOpsClient instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *AzureDevOpsClientCompletePullRequestFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error)) {
+func (f *AzureDevOpsClientSetCaptureRawJSONFunc) PushHook(hook func(bool)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6653,20 +15805,20 @@ func (f *AzureDevOpsClientCompletePullRequestFunc) PushHook(hook func(context.Co
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *AzureDevOpsClientCompletePullRequestFunc) SetDefaultReturn(r0 azuredevops.PullRequest, r1 error) {
-	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error) {
-		return r0, r1
+func (f *AzureDevOpsClientSetCaptureRawJSONFunc) SetDefaultReturn() {
+	f.SetDefaultHook(func(bool) {
+		return
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *AzureDevOpsClientCompletePullRequestFunc) PushReturn(r0 azuredevops.PullRequest, r1 error) {
-	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error) {
-		return r0, r1
+func (f *AzureDevOpsClientSetCaptureRawJSONFunc) PushReturn() {
+	f.PushHook(func(bool) {
+		return
 	})
 }
 
-func (f *AzureDevOpsClientCompletePullRequestFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCompleteInput) (azuredevops.PullRequest, error) {
+func (f *AzureDevOpsClientSetCaptureRawJSONFunc) nextHook() func(bool) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6679,88 +15831,75 @@ func (f *AzureDevOpsClientCompletePullRequestFunc) nextHook() func(context.Conte
 	return hook
 }
 
-func (f *AzureDevOpsClientCompletePullRequestFunc) appendCall(r0 AzureDevOpsClientCompletePullRequestFuncCall) {
+func (f *AzureDevOpsClientSetCaptureRawJSONFunc) appendCall(r0 AzureDevOpsClientSetCaptureRawJSONFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// AzureDevOpsClientCompletePullRequestFuncCall objects describing the
-// invocations of this function.
-func (f *AzureDevOpsClientCompletePullRequestFunc) History() []AzureDevOpsClientCompletePullRequestFuncCall {
+// History returns a sequence of AzureDevOpsClientSetCaptureRawJSONFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientSetCaptureRawJSONFunc) History() []AzureDevOpsClientSetCaptureRawJSONFuncCall {
 	f.mutex.Lock()
-	history := make([]AzureDevOpsClientCompletePullRequestFuncCall, len(f.history))
+	history := make([]AzureDevOpsClientSetCaptureRawJSONFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// AzureDevOpsClientCompletePullRequestFuncCall is an object that describes
-// an invocation of method CompletePullRequest on an instance of
+// AzureDevOpsClientSetCaptureRawJSONFuncCall is an object that describes an
+// invocation of method SetCaptureRawJSON on an instance of
 // MockAzureDevOpsClient.
-type AzureDevOpsClientCompletePullRequestFuncCall struct {
+type AzureDevOpsClientSetCaptureRawJSONFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 azuredevops.PullRequestCommonArgs
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 azuredevops.PullRequestCompleteInput
-	// Result0 is the value of the 1st result returned from this method
-	// invocation.
-	Result0 azuredevops.PullRequest
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Arg0 bool
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c AzureDevOpsClientCompletePullRequestFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c AzureDevOpsClientSetCaptureRawJSONFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c AzureDevOpsClientCompletePullRequestFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c AzureDevOpsClientSetCaptureRawJSONFuncCall) Results() []interface{} {
+	return []interface{}{}
 }
 
-// AzureDevOpsClientCreatePullRequestFunc describes the behavior when the
-// CreatePullRequest method of the parent MockAzureDevOpsClient instance is
+// AzureDevOpsClientSetFollowRedirectsFunc describes the behavior when the
+// SetFollowRedirects method of the parent MockAzureDevOpsClient instance is
 // invoked.
-type AzureDevOpsClientCreatePullRequestFunc struct {
-	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error)
-	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error)
-	history     []AzureDevOpsClientCreatePullRequestFuncCall
+type AzureDevOpsClientSetFollowRedirectsFunc struct {
+	defaultHook func(bool)
+	hooks       []func(bool)
+	history     []AzureDevOpsClientSetFollowRedirectsFuncCall
 	mutex       sync.Mutex
 }
 
-// CreatePullRequest delegates to the next hook function in the queue and
+// SetFollowRedirects delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockAzureDevOpsClient) CreatePullRequest(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error) {
-	r0, r1 := m.CreatePullRequestFunc.nextHook()(v0, v1, v2)
-	m.CreatePullRequestFunc.appendCall(AzureDevOpsClientCreatePullRequestFuncCall{v0, v1, v2, r0, r1})
-	return r0, r1
+func (m *MockAzureDevOpsClient) SetFollowRedirects(v0 bool) {
+	m.SetFollowRedirectsFunc.nextHook()(v0)
+	m.SetFollowRedirectsFunc.appendCall(AzureDevOpsClientSetFollowRedirectsFuncCall{v0})
+	return
 }
 
-// SetDefaultHook sets function that is called when the CreatePullRequest
+// SetDefaultHook sets function that is called when the SetFollowRedirects
 // method of the parent MockAzureDevOpsClient instance is invoked and the
 // hook queue is empty.
-func (f *AzureDevOpsClientCreatePullRequestFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error)) {
+func (f *AzureDevOpsClientSetFollowRedirectsFunc) SetDefaultHook(hook func(bool)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// CreatePullRequest method of the parent MockAzureDevOpsClient instance
+// SetFollowRedirects method of the parent MockAzureDevOpsClient instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *AzureDevOpsClientCreatePullRequestFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error)) {
+func (f *AzureDevOpsClientSetFollowRedirectsFunc) PushHook(hook func(bool)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6768,20 +15907,20 @@ func (f *AzureDevOpsClientCreatePullRequestFunc) PushHook(hook func(context.Cont
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *AzureDevOpsClientCreatePullRequestFunc) SetDefaultReturn(r0 azuredevops.PullRequest, r1 error) {
-	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error) {
-		return r0, r1
+func (f *AzureDevOpsClientSetFollowRedirectsFunc) SetDefaultReturn() {
+	f.SetDefaultHook(func(bool) {
+		return
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *AzureDevOpsClientCreatePullRequestFunc) PushReturn(r0 azuredevops.PullRequest, r1 error) {
-	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error) {
-		return r0, r1
+func (f *AzureDevOpsClientSetFollowRedirectsFunc) PushReturn() {
+	f.PushHook(func(bool) {
+		return
 	})
 }
 
-func (f *AzureDevOpsClientCreatePullRequestFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.CreatePullRequestInput) (azuredevops.PullRequest, error) {
+func (f *AzureDevOpsClientSetFollowRedirectsFunc) nextHook() func(bool) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6794,87 +15933,75 @@ func (f *AzureDevOpsClientCreatePullRequestFunc) nextHook() func(context.Context
 	return hook
 }
 
-func (f *AzureDevOpsClientCreatePullRequestFunc) appendCall(r0 AzureDevOpsClientCreatePullRequestFuncCall) {
+func (f *AzureDevOpsClientSetFollowRedirectsFunc) appendCall(r0 AzureDevOpsClientSetFollowRedirectsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of AzureDevOpsClientCreatePullRequestFuncCall
+// History returns a sequence of AzureDevOpsClientSetFollowRedirectsFuncCall
 // objects describing the invocations of this function.
-func (f *AzureDevOpsClientCreatePullRequestFunc) History() []AzureDevOpsClientCreatePullRequestFuncCall {
+func (f *AzureDevOpsClientSetFollowRedirectsFunc) History() []AzureDevOpsClientSetFollowRedirectsFuncCall {
 	f.mutex.Lock()
-	history := make([]AzureDevOpsClientCreatePullRequestFuncCall, len(f.history))
+	history := make([]AzureDevOpsClientSetFollowRedirectsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// AzureDevOpsClientCreatePullRequestFuncCall is an object that describes an
-// invocation of method CreatePullRequest on an instance of
+// AzureDevOpsClientSetFollowRedirectsFuncCall is an object that describes
+// an invocation of method SetFollowRedirects on an instance of
 // MockAzureDevOpsClient.
-type AzureDevOpsClientCreatePullRequestFuncCall struct {
+type AzureDevOpsClientSetFollowRedirectsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 azuredevops.OrgProjectRepoArgs
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 azuredevops.CreatePullRequestInput
-	// Result0 is the value of the 1st result returned from this method
-	// invocation.
-	Result0 azuredevops.PullRequest
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Arg0 bool
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c AzureDevOpsClientCreatePullRequestFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c AzureDevOpsClientSetFollowRedirectsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c AzureDevOpsClientCreatePullRequestFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c AzureDevOpsClientSetFollowRedirectsFuncCall) Results() []interface{} {
+	return []interface{}{}
 }
 
-// AzureDevOpsClientCreatePullRequestCommentThreadFunc describes the
-// behavior when the CreatePullRequestCommentThread method of the parent
-// MockAzureDevOpsClient instance is invoked.
-type AzureDevOpsClientCreatePullRequestCommentThreadFunc struct {
-	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error)
-	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error)
-	history     []AzureDevOpsClientCreatePullRequestCommentThreadFuncCall
+// AzureDevOpsClientSetJobIDMetricLabelFunc describes the behavior when the
+// SetJobIDMetricLabel method of the parent MockAzureDevOpsClient instance
+// is invoked.
+type AzureDevOpsClientSetJobIDMetricLabelFunc struct {
+	defaultHook func(bool)
+	hooks       []func(bool)
+	history     []AzureDevOpsClientSetJobIDMetricLabelFuncCall
 	mutex       sync.Mutex
 }
 
-// CreatePullRequestCommentThread delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockAzureDevOpsClient) CreatePullRequestCommentThread(v0 context.Context, v1 azuredevops.PullRequestCommonArgs, v2 azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error) {
-	r0, r1 := m.CreatePullRequestCommentThreadFunc.nextHook()(v0, v1, v2)
-	m.CreatePullRequestCommentThreadFunc.appendCall(AzureDevOpsClientCreatePullRequestCommentThreadFuncCall{v0, v1, v2, r0, r1})
-	return r0, r1
-}
-
-// SetDefaultHook sets function that is called when the
-// CreatePullRequestCommentThread method of the parent MockAzureDevOpsClient
-// instance is invoked and the hook queue is empty.
-func (f *AzureDevOpsClientCreatePullRequestCommentThreadFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error)) {
-	f.defaultHook = hook
+// SetJobIDMetricLabel delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) SetJobIDMetricLabel(v0 bool) {
+	m.SetJobIDMetricLabelFunc.nextHook()(v0)
+	m.SetJobIDMetricLabelFunc.appendCall(AzureDevOpsClientSetJobIDMetricLabelFuncCall{v0})
+	return
 }
 
-// PushHook adds a function to the end of hook queue. Each invocation of the
-// CreatePullRequestCommentThread method of the parent MockAzureDevOpsClient
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *AzureDevOpsClientCreatePullRequestCommentThreadFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error)) {
+// SetDefaultHook sets function that is called when the SetJobIDMetricLabel
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientSetJobIDMetricLabelFunc) SetDefaultHook(hook func(bool)) {
+	f.defaultHook = hook
+}
+
+// PushHook adds a function to the end of hook queue. Each invocation of the
+// SetJobIDMetricLabel method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientSetJobIDMetricLabelFunc) PushHook(hook func(bool)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6882,20 +16009,20 @@ func (f *AzureDevOpsClientCreatePullRequestCommentThreadFunc) PushHook(hook func
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *AzureDevOpsClientCreatePullRequestCommentThreadFunc) SetDefaultReturn(r0 azuredevops.PullRequestCommentResponse, r1 error) {
-	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error) {
-		return r0, r1
+func (f *AzureDevOpsClientSetJobIDMetricLabelFunc) SetDefaultReturn() {
+	f.SetDefaultHook(func(bool) {
+		return
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *AzureDevOpsClientCreatePullRequestCommentThreadFunc) PushReturn(r0 azuredevops.PullRequestCommentResponse, r1 error) {
-	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error) {
-		return r0, r1
+func (f *AzureDevOpsClientSetJobIDMetricLabelFunc) PushReturn() {
+	f.PushHook(func(bool) {
+		return
 	})
 }
 
-func (f *AzureDevOpsClientCreatePullRequestCommentThreadFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestCommentInput) (azuredevops.PullRequestCommentResponse, error) {
+func (f *AzureDevOpsClientSetJobIDMetricLabelFunc) nextHook() func(bool) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -6908,88 +16035,76 @@ func (f *AzureDevOpsClientCreatePullRequestCommentThreadFunc) nextHook() func(co
 	return hook
 }
 
-func (f *AzureDevOpsClientCreatePullRequestCommentThreadFunc) appendCall(r0 AzureDevOpsClientCreatePullRequestCommentThreadFuncCall) {
+func (f *AzureDevOpsClientSetJobIDMetricLabelFunc) appendCall(r0 AzureDevOpsClientSetJobIDMetricLabelFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// AzureDevOpsClientCreatePullRequestCommentThreadFuncCall objects
-// describing the invocations of this function.
-func (f *AzureDevOpsClientCreatePullRequestCommentThreadFunc) History() []AzureDevOpsClientCreatePullRequestCommentThreadFuncCall {
+// AzureDevOpsClientSetJobIDMetricLabelFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientSetJobIDMetricLabelFunc) History() []AzureDevOpsClientSetJobIDMetricLabelFuncCall {
 	f.mutex.Lock()
-	history := make([]AzureDevOpsClientCreatePullRequestCommentThreadFuncCall, len(f.history))
+	history := make([]AzureDevOpsClientSetJobIDMetricLabelFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// AzureDevOpsClientCreatePullRequestCommentThreadFuncCall is an object that
-// describes an invocation of method CreatePullRequestCommentThread on an
-// instance of MockAzureDevOpsClient.
-type AzureDevOpsClientCreatePullRequestCommentThreadFuncCall struct {
+// AzureDevOpsClientSetJobIDMetricLabelFuncCall is an object that describes
+// an invocation of method SetJobIDMetricLabel on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientSetJobIDMetricLabelFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 azuredevops.PullRequestCommonArgs
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 azuredevops.PullRequestCommentInput
-	// Result0 is the value of the 1st result returned from this method
-	// invocation.
-	Result0 azuredevops.PullRequestCommentResponse
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Arg0 bool
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c AzureDevOpsClientCreatePullRequestCommentThreadFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c AzureDevOpsClientSetJobIDMetricLabelFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c AzureDevOpsClientCreatePullRequestCommentThreadFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c AzureDevOpsClientSetJobIDMetricLabelFuncCall) Results() []interface{} {
+	return []interface{}{}
 }
 
-// AzureDevOpsClientForkRepositoryFunc describes the behavior when the
-// ForkRepository method of the parent MockAzureDevOpsClient instance is
+// AzureDevOpsClientSetProbeNotFoundFunc describes the behavior when the
+// SetProbeNotFound method of the parent MockAzureDevOpsClient instance is
 // invoked.
-type AzureDevOpsClientForkRepositoryFunc struct {
-	defaultHook func(context.Context, string, azuredevops.ForkRepositoryInput) (azuredevops.Repository, error)
-	hooks       []func(context.Context, string, azuredevops.ForkRepositoryInput) (azuredevops.Repository, error)
-	history     []AzureDevOpsClientForkRepositoryFuncCall
+type AzureDevOpsClientSetProbeNotFoundFunc struct {
+	defaultHook func(bool)
+	hooks       []func(bool)
+	history     []AzureDevOpsClientSetProbeNotFoundFuncCall
 	mutex       sync.Mutex
 }
 
-// ForkRepository delegates to the next hook function in the queue and
+// SetProbeNotFound delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockAzureDevOpsClient) ForkRepository(v0 context.Context, v1 string, v2 azuredevops.ForkRepositoryInput) (azuredevops.Repository, error) {
-	r0, r1 := m.ForkRepositoryFunc.nextHook()(v0, v1, v2)
-	m.ForkRepositoryFunc.appendCall(AzureDevOpsClientForkRepositoryFuncCall{v0, v1, v2, r0, r1})
-	return r0, r1
+func (m *MockAzureDevOpsClient) SetProbeNotFound(v0 bool) {
+	m.SetProbeNotFoundFunc.nextHook()(v0)
+	m.SetProbeNotFoundFunc.appendCall(AzureDevOpsClientSetProbeNotFoundFuncCall{v0})
+	return
 }
 
-// SetDefaultHook sets function that is called when the ForkRepository
+// SetDefaultHook sets function that is called when the SetProbeNotFound
 // method of the parent MockAzureDevOpsClient instance is invoked and the
 // hook queue is empty.
-func (f *AzureDevOpsClientForkRepositoryFunc) SetDefaultHook(hook func(context.Context, string, azuredevops.ForkRepositoryInput) (azuredevops.Repository, error)) {
+func (f *AzureDevOpsClientSetProbeNotFoundFunc) SetDefaultHook(hook func(bool)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ForkRepository method of the parent MockAzureDevOpsClient instance
+// SetProbeNotFound method of the parent MockAzureDevOpsClient instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *AzureDevOpsClientForkRepositoryFunc) PushHook(hook func(context.Context, string, azuredevops.ForkRepositoryInput) (azuredevops.Repository, error)) {
+func (f *AzureDevOpsClientSetProbeNotFoundFunc) PushHook(hook func(bool)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -6997,20 +16112,20 @@ func (f *AzureDevOpsClientForkRepositoryFunc) PushHook(hook func(context.Context
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *AzureDevOpsClientForkRepositoryFunc) SetDefaultReturn(r0 azuredevops.Repository, r1 error) {
-	f.SetDefaultHook(func(context.Context, string, azuredevops.ForkRepositoryInput) (azuredevops.Repository, error) {
-		return r0, r1
+func (f *AzureDevOpsClientSetProbeNotFoundFunc) SetDefaultReturn() {
+	f.SetDefaultHook(func(bool) {
+		return
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *AzureDevOpsClientForkRepositoryFunc) PushReturn(r0 azuredevops.Repository, r1 error) {
-	f.PushHook(func(context.Context, string, azuredevops.ForkRepositoryInput) (azuredevops.Repository, error) {
-		return r0, r1
+func (f *AzureDevOpsClientSetProbeNotFoundFunc) PushReturn() {
+	f.PushHook(func(bool) {
+		return
 	})
 }
 
-func (f *AzureDevOpsClientForkRepositoryFunc) nextHook() func(context.Context, string, azuredevops.ForkRepositoryInput) (azuredevops.Repository, error) {
+func (f *AzureDevOpsClientSetProbeNotFoundFunc) nextHook() func(bool) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -7023,87 +16138,75 @@ func (f *AzureDevOpsClientForkRepositoryFunc) nextHook() func(context.Context, s
 	return hook
 }
 
-func (f *AzureDevOpsClientForkRepositoryFunc) appendCall(r0 AzureDevOpsClientForkRepositoryFuncCall) {
+func (f *AzureDevOpsClientSetProbeNotFoundFunc) appendCall(r0 AzureDevOpsClientSetProbeNotFoundFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of AzureDevOpsClientForkRepositoryFuncCall
+// History returns a sequence of AzureDevOpsClientSetProbeNotFoundFuncCall
 // objects describing the invocations of this function.
-func (f *AzureDevOpsClientForkRepositoryFunc) History() []AzureDevOpsClientForkRepositoryFuncCall {
+func (f *AzureDevOpsClientSetProbeNotFoundFunc) History() []AzureDevOpsClientSetProbeNotFoundFuncCall {
 	f.mutex.Lock()
-	history := make([]AzureDevOpsClientForkRepositoryFuncCall, len(f.history))
+	history := make([]AzureDevOpsClientSetProbeNotFoundFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// AzureDevOpsClientForkRepositoryFuncCall is an object that describes an
-// invocation of method ForkRepository on an instance of
+// AzureDevOpsClientSetProbeNotFoundFuncCall is an object that describes an
+// invocation of method SetProbeNotFound on an instance of
 // MockAzureDevOpsClient.
-type AzureDevOpsClientForkRepositoryFuncCall struct {
+type AzureDevOpsClientSetProbeNotFoundFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 string
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 azuredevops.ForkRepositoryInput
-	// Result0 is the value of the 1st result returned from this method
-	// invocation.
-	Result0 azuredevops.Repository
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Arg0 bool
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c AzureDevOpsClientForkRepositoryFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c AzureDevOpsClientSetProbeNotFoundFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c AzureDevOpsClientForkRepositoryFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c AzureDevOpsClientSetProbeNotFoundFuncCall) Results() []interface{} {
+	return []interface{}{}
 }
 
-// AzureDevOpsClientGetAuthorizedProfileFunc describes the behavior when the
-// GetAuthorizedProfile method of the parent MockAzureDevOpsClient instance
+// AzureDevOpsClientSetProjectIDCacheTTLFunc describes the behavior when the
+// SetProjectIDCacheTTL method of the parent MockAzureDevOpsClient instance
 // is invoked.
-type AzureDevOpsClientGetAuthorizedProfileFunc struct {
-	defaultHook func(context.Context) (azuredevops.Profile, error)
-	hooks       []func(context.Context) (azuredevops.Profile, error)
-	history     []AzureDevOpsClientGetAuthorizedProfileFuncCall
+type AzureDevOpsClientSetProjectIDCacheTTLFunc struct {
+	defaultHook func(time.Duration)
+	hooks       []func(time.Duration)
+	history     []AzureDevOpsClientSetProjectIDCacheTTLFuncCall
 	mutex       sync.Mutex
 }
 
-// GetAuthorizedProfile delegates to the next hook function in the queue and
+// SetProjectIDCacheTTL delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockAzureDevOpsClient) GetAuthorizedProfile(v0 context.Context) (azuredevops.Profile, error) {
-	r0, r1 := m.GetAuthorizedProfileFunc.nextHook()(v0)
-	m.GetAuthorizedProfileFunc.appendCall(AzureDevOpsClientGetAuthorizedProfileFuncCall{v0, r0, r1})
-	return r0, r1
+func (m *MockAzureDevOpsClient) SetProjectIDCacheTTL(v0 time.Duration) {
+	m.SetProjectIDCacheTTLFunc.nextHook()(v0)
+	m.SetProjectIDCacheTTLFunc.appendCall(AzureDevOpsClientSetProjectIDCacheTTLFuncCall{v0})
+	return
 }
 
-// SetDefaultHook sets function that is called when the GetAuthorizedProfile
+// SetDefaultHook sets function that is called when the SetProjectIDCacheTTL
 // method of the parent MockAzureDevOpsClient instance is invoked and the
 // hook queue is empty.
-func (f *AzureDevOpsClientGetAuthorizedProfileFunc) SetDefaultHook(hook func(context.Context) (azuredevops.Profile, error)) {
+func (f *AzureDevOpsClientSetProjectIDCacheTTLFunc) SetDefaultHook(hook func(time.Duration)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetAuthorizedProfile method of the parent MockAzureDevOpsClient instance
+// SetProjectIDCacheTTL method of the parent MockAzureDevOpsClient instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *AzureDevOpsClientGetAuthorizedProfileFunc) PushHook(hook func(context.Context) (azuredevops.Profile, error)) {
+func (f *AzureDevOpsClientSetProjectIDCacheTTLFunc) PushHook(hook func(time.Duration)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -7111,20 +16214,20 @@ func (f *AzureDevOpsClientGetAuthorizedProfileFunc) PushHook(hook func(context.C
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *AzureDevOpsClientGetAuthorizedProfileFunc) SetDefaultReturn(r0 azuredevops.Profile, r1 error) {
-	f.SetDefaultHook(func(context.Context) (azuredevops.Profile, error) {
-		return r0, r1
+func (f *AzureDevOpsClientSetProjectIDCacheTTLFunc) SetDefaultReturn() {
+	f.SetDefaultHook(func(time.Duration) {
+		return
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *AzureDevOpsClientGetAuthorizedProfileFunc) PushReturn(r0 azuredevops.Profile, r1 error) {
-	f.PushHook(func(context.Context) (azuredevops.Profile, error) {
-		return r0, r1
+func (f *AzureDevOpsClientSetProjectIDCacheTTLFunc) PushReturn() {
+	f.PushHook(func(time.Duration) {
+		return
 	})
 }
 
-func (f *AzureDevOpsClientGetAuthorizedProfileFunc) nextHook() func(context.Context) (azuredevops.Profile, error) {
+func (f *AzureDevOpsClientSetProjectIDCacheTTLFunc) nextHook() func(time.Duration) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -7137,81 +16240,76 @@ func (f *AzureDevOpsClientGetAuthorizedProfileFunc) nextHook() func(context.Cont
 	return hook
 }
 
-func (f *AzureDevOpsClientGetAuthorizedProfileFunc) appendCall(r0 AzureDevOpsClientGetAuthorizedProfileFuncCall) {
+func (f *AzureDevOpsClientSetProjectIDCacheTTLFunc) appendCall(r0 AzureDevOpsClientSetProjectIDCacheTTLFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// AzureDevOpsClientGetAuthorizedProfileFuncCall objects describing the
+// AzureDevOpsClientSetProjectIDCacheTTLFuncCall objects describing the
 // invocations of this function.
-func (f *AzureDevOpsClientGetAuthorizedProfileFunc) History() []AzureDevOpsClientGetAuthorizedProfileFuncCall {
+func (f *AzureDevOpsClientSetProjectIDCacheTTLFunc) History() []AzureDevOpsClientSetProjectIDCacheTTLFuncCall {
 	f.mutex.Lock()
-	history := make([]AzureDevOpsClientGetAuthorizedProfileFuncCall, len(f.history))
+	history := make([]AzureDevOpsClientSetProjectIDCacheTTLFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// AzureDevOpsClientGetAuthorizedProfileFuncCall is an object that describes
-// an invocation of method GetAuthorizedProfile on an instance of
+// AzureDevOpsClientSetProjectIDCacheTTLFuncCall is an object that describes
+// an invocation of method SetProjectIDCacheTTL on an instance of
 // MockAzureDevOpsClient.
-type AzureDevOpsClientGetAuthorizedProfileFuncCall struct {
+type AzureDevOpsClientSetProjectIDCacheTTLFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Result0 is the value of the 1st result returned from this method
-	// invocation.
-	Result0 azuredevops.Profile
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Arg0 time.Duration
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c AzureDevOpsClientGetAuthorizedProfileFuncCall) Args() []interface{} {
+func (c AzureDevOpsClientSetProjectIDCacheTTLFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c AzureDevOpsClientGetAuthorizedProfileFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c AzureDevOpsClientSetProjectIDCacheTTLFuncCall) Results() []interface{} {
+	return []interface{}{}
 }
 
-// AzureDevOpsClientGetProjectFunc describes the behavior when the
-// GetProject method of the parent MockAzureDevOpsClient instance is
-// invoked.
-type AzureDevOpsClientGetProjectFunc struct {
-	defaultHook func(context.Context, string, string) (azuredevops.Project, error)
-	hooks       []func(context.Context, string, string) (azuredevops.Project, error)
-	history     []AzureDevOpsClientGetProjectFuncCall
+// AzureDevOpsClientSetPullRequestAutoCompleteFunc describes the behavior
+// when the SetPullRequestAutoComplete method of the parent
+// MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientSetPullRequestAutoCompleteFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestAutoCompleteInput) (azuredevops.PullRequest, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestAutoCompleteInput) (azuredevops.PullRequest, error)
+	history     []AzureDevOpsClientSetPullRequestAutoCompleteFuncCall
 	mutex       sync.Mutex
 }
 
-// GetProject delegates to the next hook function in the queue and stores
-// the parameter and result values of this invocation.
-func (m *MockAzureDevOpsClient) GetProject(v0 context.Context, v1 string, v2 string) (azuredevops.Project, error) {
-	r0, r1 := m.GetProjectFunc.nextHook()(v0, v1, v2)
-	m.GetProjectFunc.appendCall(AzureDevOpsClientGetProjectFuncCall{v0, v1, v2, r0, r1})
+// SetPullRequestAutoComplete delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) SetPullRequestAutoComplete(v0 context.Context, v1 azuredevops.PullRequestCommonArgs, v2 azuredevops.PullRequestAutoCompleteInput) (azuredevops.PullRequest, error) {
+	r0, r1 := m.SetPullRequestAutoCompleteFunc.nextHook()(v0, v1, v2)
+	m.SetPullRequestAutoCompleteFunc.appendCall(AzureDevOpsClientSetPullRequestAutoCompleteFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetProject method of
-// the parent MockAzureDevOpsClient instance is invoked and the hook queue
-// is empty.
-func (f *AzureDevOpsClientGetProjectFunc) SetDefaultHook(hook func(context.Context, string, string) (azuredevops.Project, error)) {
+// SetDefaultHook sets function that is called when the
+// SetPullRequestAutoComplete method of the parent MockAzureDevOpsClient
+// instance is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientSetPullRequestAutoCompleteFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestAutoCompleteInput) (azuredevops.PullRequest, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetProject method of the parent MockAzureDevOpsClient instance invokes
-// the hook at the front of the queue and discards it. After the queue is
-// empty, the default hook function is invoked for any future action.
-func (f *AzureDevOpsClientGetProjectFunc) PushHook(hook func(context.Context, string, string) (azuredevops.Project, error)) {
+// SetPullRequestAutoComplete method of the parent MockAzureDevOpsClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *AzureDevOpsClientSetPullRequestAutoCompleteFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestAutoCompleteInput) (azuredevops.PullRequest, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -7219,20 +16317,20 @@ func (f *AzureDevOpsClientGetProjectFunc) PushHook(hook func(context.Context, st
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *AzureDevOpsClientGetProjectFunc) SetDefaultReturn(r0 azuredevops.Project, r1 error) {
-	f.SetDefaultHook(func(context.Context, string, string) (azuredevops.Project, error) {
+func (f *AzureDevOpsClientSetPullRequestAutoCompleteFunc) SetDefaultReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestAutoCompleteInput) (azuredevops.PullRequest, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *AzureDevOpsClientGetProjectFunc) PushReturn(r0 azuredevops.Project, r1 error) {
-	f.PushHook(func(context.Context, string, string) (azuredevops.Project, error) {
+func (f *AzureDevOpsClientSetPullRequestAutoCompleteFunc) PushReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestAutoCompleteInput) (azuredevops.PullRequest, error) {
 		return r0, r1
 	})
 }
 
-func (f *AzureDevOpsClientGetProjectFunc) nextHook() func(context.Context, string, string) (azuredevops.Project, error) {
+func (f *AzureDevOpsClientSetPullRequestAutoCompleteFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestAutoCompleteInput) (azuredevops.PullRequest, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -7245,38 +16343,40 @@ func (f *AzureDevOpsClientGetProjectFunc) nextHook() func(context.Context, strin
 	return hook
 }
 
-func (f *AzureDevOpsClientGetProjectFunc) appendCall(r0 AzureDevOpsClientGetProjectFuncCall) {
+func (f *AzureDevOpsClientSetPullRequestAutoCompleteFunc) appendCall(r0 AzureDevOpsClientSetPullRequestAutoCompleteFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of AzureDevOpsClientGetProjectFuncCall objects
-// describing the invocations of this function.
-func (f *AzureDevOpsClientGetProjectFunc) History() []AzureDevOpsClientGetProjectFuncCall {
+// History returns a sequence of
+// AzureDevOpsClientSetPullRequestAutoCompleteFuncCall objects describing
+// the invocations of this function.
+func (f *AzureDevOpsClientSetPullRequestAutoCompleteFunc) History() []AzureDevOpsClientSetPullRequestAutoCompleteFuncCall {
 	f.mutex.Lock()
-	history := make([]AzureDevOpsClientGetProjectFuncCall, len(f.history))
+	history := make([]AzureDevOpsClientSetPullRequestAutoCompleteFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// AzureDevOpsClientGetProjectFuncCall is an object that describes an
-// invocation of method GetProject on an instance of MockAzureDevOpsClient.
-type AzureDevOpsClientGetProjectFuncCall struct {
+// AzureDevOpsClientSetPullRequestAutoCompleteFuncCall is an object that
+// describes an invocation of method SetPullRequestAutoComplete on an
+// instance of MockAzureDevOpsClient.
+type AzureDevOpsClientSetPullRequestAutoCompleteFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 string
+	Arg1 azuredevops.PullRequestCommonArgs
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 string
+	Arg2 azuredevops.PullRequestAutoCompleteInput
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 azuredevops.Project
+	Result0 azuredevops.PullRequest
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -7284,47 +16384,47 @@ type AzureDevOpsClientGetProjectFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c AzureDevOpsClientGetProjectFuncCall) Args() []interface{} {
+func (c AzureDevOpsClientSetPullRequestAutoCompleteFuncCall) Args() []interface{} {
 	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c AzureDevOpsClientGetProjectFuncCall) Results() []interface{} {
+func (c AzureDevOpsClientSetPullRequestAutoCompleteFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// AzureDevOpsClientGetPullRequestFunc describes the behavior when the
-// GetPullRequest method of the parent MockAzureDevOpsClient instance is
-// invoked.
-type AzureDevOpsClientGetPullRequestFunc struct {
-	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)
-	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)
-	history     []AzureDevOpsClientGetPullRequestFuncCall
+// AzureDevOpsClientSetPullRequestDraftFunc describes the behavior when the
+// SetPullRequestDraft method of the parent MockAzureDevOpsClient instance
+// is invoked.
+type AzureDevOpsClientSetPullRequestDraftFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs, bool) (azuredevops.PullRequest, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs, bool) (azuredevops.PullRequest, error)
+	history     []AzureDevOpsClientSetPullRequestDraftFuncCall
 	mutex       sync.Mutex
 }
 
-// GetPullRequest delegates to the next hook function in the queue and
+// SetPullRequestDraft delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockAzureDevOpsClient) GetPullRequest(v0 context.Context, v1 azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
-	r0, r1 := m.GetPullRequestFunc.nextHook()(v0, v1)
-	m.GetPullRequestFunc.appendCall(AzureDevOpsClientGetPullRequestFuncCall{v0, v1, r0, r1})
+func (m *MockAzureDevOpsClient) SetPullRequestDraft(v0 context.Context, v1 azuredevops.PullRequestCommonArgs, v2 bool) (azuredevops.PullRequest, error) {
+	r0, r1 := m.SetPullRequestDraftFunc.nextHook()(v0, v1, v2)
+	m.SetPullRequestDraftFunc.appendCall(AzureDevOpsClientSetPullRequestDraftFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetPullRequest
+// SetDefaultHook sets function that is called when the SetPullRequestDraft
 // method of the parent MockAzureDevOpsClient instance is invoked and the
 // hook queue is empty.
-func (f *AzureDevOpsClientGetPullRequestFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)) {
+func (f *AzureDevOpsClientSetPullRequestDraftFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, bool) (azuredevops.PullRequest, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetPullRequest method of the parent MockAzureDevOpsClient instance
+// SetPullRequestDraft method of the parent MockAzureDevOpsClient instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *AzureDevOpsClientGetPullRequestFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error)) {
+func (f *AzureDevOpsClientSetPullRequestDraftFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, bool) (azuredevops.PullRequest, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -7332,20 +16432,20 @@ func (f *AzureDevOpsClientGetPullRequestFunc) PushHook(hook func(context.Context
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *AzureDevOpsClientGetPullRequestFunc) SetDefaultReturn(r0 azuredevops.PullRequest, r1 error) {
-	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+func (f *AzureDevOpsClientSetPullRequestDraftFunc) SetDefaultReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs, bool) (azuredevops.PullRequest, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *AzureDevOpsClientGetPullRequestFunc) PushReturn(r0 azuredevops.PullRequest, r1 error) {
-	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+func (f *AzureDevOpsClientSetPullRequestDraftFunc) PushReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs, bool) (azuredevops.PullRequest, error) {
 		return r0, r1
 	})
 }
 
-func (f *AzureDevOpsClientGetPullRequestFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs) (azuredevops.PullRequest, error) {
+func (f *AzureDevOpsClientSetPullRequestDraftFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs, bool) (azuredevops.PullRequest, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -7358,33 +16458,37 @@ func (f *AzureDevOpsClientGetPullRequestFunc) nextHook() func(context.Context, a
 	return hook
 }
 
-func (f *AzureDevOpsClientGetPullRequestFunc) appendCall(r0 AzureDevOpsClientGetPullRequestFuncCall) {
+func (f *AzureDevOpsClientSetPullRequestDraftFunc) appendCall(r0 AzureDevOpsClientSetPullRequestDraftFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of AzureDevOpsClientGetPullRequestFuncCall
-// objects describing the invocations of this function.
-func (f *AzureDevOpsClientGetPullRequestFunc) History() []AzureDevOpsClientGetPullRequestFuncCall {
+// History returns a sequence of
+// AzureDevOpsClientSetPullRequestDraftFuncCall objects describing the
+// invocations of this function.
+func (f *AzureDevOpsClientSetPullRequestDraftFunc) History() []AzureDevOpsClientSetPullRequestDraftFuncCall {
 	f.mutex.Lock()
-	history := make([]AzureDevOpsClientGetPullRequestFuncCall, len(f.history))
+	history := make([]AzureDevOpsClientSetPullRequestDraftFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// AzureDevOpsClientGetPullRequestFuncCall is an object that describes an
-// invocation of method GetPullRequest on an instance of
+// AzureDevOpsClientSetPullRequestDraftFuncCall is an object that describes
+// an invocation of method SetPullRequestDraft on an instance of
 // MockAzureDevOpsClient.
-type AzureDevOpsClientGetPullRequestFuncCall struct {
+type AzureDevOpsClientSetPullRequestDraftFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 azuredevops.PullRequestCommonArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 bool
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
 	Result0 azuredevops.PullRequest
@@ -7395,47 +16499,47 @@ type AzureDevOpsClientGetPullRequestFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c AzureDevOpsClientGetPullRequestFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c AzureDevOpsClientSetPullRequestDraftFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c AzureDevOpsClientGetPullRequestFuncCall) Results() []interface{} {
+func (c AzureDevOpsClientSetPullRequestDraftFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// AzureDevOpsClientGetPullRequestStatusesFunc describes the behavior when
-// the GetPullRequestStatuses method of the parent MockAzureDevOpsClient
+// AzureDevOpsClientSetPullRequestPropertiesFunc describes the behavior when
+// the SetPullRequestProperties method of the parent MockAzureDevOpsClient
 // instance is invoked.
-type AzureDevOpsClientGetPullRequestStatusesFunc struct {
-	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error)
-	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error)
-	history     []AzureDevOpsClientGetPullRequestStatusesFuncCall
+type AzureDevOpsClientSetPullRequestPropertiesFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs, []azuredevops.JSONPatchOperation) (map[string]azuredevops.PropertyValue, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs, []azuredevops.JSONPatchOperation) (map[string]azuredevops.PropertyValue, error)
+	history     []AzureDevOpsClientSetPullRequestPropertiesFuncCall
 	mutex       sync.Mutex
 }
 
-// GetPullRequestStatuses delegates to the next hook function in the queue
+// SetPullRequestProperties delegates to the next hook function in the queue
 // and stores the parameter and result values of this invocation.
-func (m *MockAzureDevOpsClient) GetPullRequestStatuses(v0 context.Context, v1 azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error) {
-	r0, r1 := m.GetPullRequestStatusesFunc.nextHook()(v0, v1)
-	m.GetPullRequestStatusesFunc.appendCall(AzureDevOpsClientGetPullRequestStatusesFuncCall{v0, v1, r0, r1})
+func (m *MockAzureDevOpsClient) SetPullRequestProperties(v0 context.Context, v1 azuredevops.PullRequestCommonArgs, v2 []azuredevops.JSONPatchOperation) (map[string]azuredevops.PropertyValue, error) {
+	r0, r1 := m.SetPullRequestPropertiesFunc.nextHook()(v0, v1, v2)
+	m.SetPullRequestPropertiesFunc.appendCall(AzureDevOpsClientSetPullRequestPropertiesFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
 // SetDefaultHook sets function that is called when the
-// GetPullRequestStatuses method of the parent MockAzureDevOpsClient
+// SetPullRequestProperties method of the parent MockAzureDevOpsClient
 // instance is invoked and the hook queue is empty.
-func (f *AzureDevOpsClientGetPullRequestStatusesFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error)) {
+func (f *AzureDevOpsClientSetPullRequestPropertiesFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, []azuredevops.JSONPatchOperation) (map[string]azuredevops.PropertyValue, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetPullRequestStatuses method of the parent MockAzureDevOpsClient
+// SetPullRequestProperties method of the parent MockAzureDevOpsClient
 // instance invokes the hook at the front of the queue and discards it.
 // After the queue is empty, the default hook function is invoked for any
 // future action.
-func (f *AzureDevOpsClientGetPullRequestStatusesFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error)) {
+func (f *AzureDevOpsClientSetPullRequestPropertiesFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, []azuredevops.JSONPatchOperation) (map[string]azuredevops.PropertyValue, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -7443,20 +16547,20 @@ func (f *AzureDevOpsClientGetPullRequestStatusesFunc) PushHook(hook func(context
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *AzureDevOpsClientGetPullRequestStatusesFunc) SetDefaultReturn(r0 []azuredevops.PullRequestBuildStatus, r1 error) {
-	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error) {
+func (f *AzureDevOpsClientSetPullRequestPropertiesFunc) SetDefaultReturn(r0 map[string]azuredevops.PropertyValue, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs, []azuredevops.JSONPatchOperation) (map[string]azuredevops.PropertyValue, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *AzureDevOpsClientGetPullRequestStatusesFunc) PushReturn(r0 []azuredevops.PullRequestBuildStatus, r1 error) {
-	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error) {
+func (f *AzureDevOpsClientSetPullRequestPropertiesFunc) PushReturn(r0 map[string]azuredevops.PropertyValue, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs, []azuredevops.JSONPatchOperation) (map[string]azuredevops.PropertyValue, error) {
 		return r0, r1
 	})
 }
 
-func (f *AzureDevOpsClientGetPullRequestStatusesFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs) ([]azuredevops.PullRequestBuildStatus, error) {
+func (f *AzureDevOpsClientSetPullRequestPropertiesFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs, []azuredevops.JSONPatchOperation) (map[string]azuredevops.PropertyValue, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -7469,37 +16573,40 @@ func (f *AzureDevOpsClientGetPullRequestStatusesFunc) nextHook() func(context.Co
 	return hook
 }
 
-func (f *AzureDevOpsClientGetPullRequestStatusesFunc) appendCall(r0 AzureDevOpsClientGetPullRequestStatusesFuncCall) {
+func (f *AzureDevOpsClientSetPullRequestPropertiesFunc) appendCall(r0 AzureDevOpsClientSetPullRequestPropertiesFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// AzureDevOpsClientGetPullRequestStatusesFuncCall objects describing the
+// AzureDevOpsClientSetPullRequestPropertiesFuncCall objects describing the
 // invocations of this function.
-func (f *AzureDevOpsClientGetPullRequestStatusesFunc) History() []AzureDevOpsClientGetPullRequestStatusesFuncCall {
+func (f *AzureDevOpsClientSetPullRequestPropertiesFunc) History() []AzureDevOpsClientSetPullRequestPropertiesFuncCall {
 	f.mutex.Lock()
-	history := make([]AzureDevOpsClientGetPullRequestStatusesFuncCall, len(f.history))
+	history := make([]AzureDevOpsClientSetPullRequestPropertiesFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
-
-// AzureDevOpsClientGetPullRequestStatusesFuncCall is an object that
-// describes an invocation of method GetPullRequestStatuses on an instance
+
+// AzureDevOpsClientSetPullRequestPropertiesFuncCall is an object that
+// describes an invocation of method SetPullRequestProperties on an instance
 // of MockAzureDevOpsClient.
-type AzureDevOpsClientGetPullRequestStatusesFuncCall struct {
+type AzureDevOpsClientSetPullRequestPropertiesFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
 	Arg1 azuredevops.PullRequestCommonArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []azuredevops.JSONPatchOperation
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []azuredevops.PullRequestBuildStatus
+	Result0 map[string]azuredevops.PropertyValue
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -7507,45 +16614,47 @@ type AzureDevOpsClientGetPullRequestStatusesFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c AzureDevOpsClientGetPullRequestStatusesFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c AzureDevOpsClientSetPullRequestPropertiesFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c AzureDevOpsClientGetPullRequestStatusesFuncCall) Results() []interface{} {
+func (c AzureDevOpsClientSetPullRequestPropertiesFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// AzureDevOpsClientGetRepoFunc describes the behavior when the GetRepo
-// method of the parent MockAzureDevOpsClient instance is invoked.
-type AzureDevOpsClientGetRepoFunc struct {
-	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error)
-	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error)
-	history     []AzureDevOpsClientGetRepoFuncCall
+// AzureDevOpsClientSetStrictDecodeFunc describes the behavior when the
+// SetStrictDecode method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientSetStrictDecodeFunc struct {
+	defaultHook func(bool)
+	hooks       []func(bool)
+	history     []AzureDevOpsClientSetStrictDecodeFuncCall
 	mutex       sync.Mutex
 }
 
-// GetRepo delegates to the next hook function in the queue and stores the
-// parameter and result values of this invocation.
-func (m *MockAzureDevOpsClient) GetRepo(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error) {
-	r0, r1 := m.GetRepoFunc.nextHook()(v0, v1)
-	m.GetRepoFunc.appendCall(AzureDevOpsClientGetRepoFuncCall{v0, v1, r0, r1})
-	return r0, r1
+// SetStrictDecode delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) SetStrictDecode(v0 bool) {
+	m.SetStrictDecodeFunc.nextHook()(v0)
+	m.SetStrictDecodeFunc.appendCall(AzureDevOpsClientSetStrictDecodeFuncCall{v0})
+	return
 }
 
-// SetDefaultHook sets function that is called when the GetRepo method of
-// the parent MockAzureDevOpsClient instance is invoked and the hook queue
-// is empty.
-func (f *AzureDevOpsClientGetRepoFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error)) {
+// SetDefaultHook sets function that is called when the SetStrictDecode
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientSetStrictDecodeFunc) SetDefaultHook(hook func(bool)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetRepo method of the parent MockAzureDevOpsClient instance invokes the
-// hook at the front of the queue and discards it. After the queue is empty,
-// the default hook function is invoked for any future action.
-func (f *AzureDevOpsClientGetRepoFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error)) {
+// SetStrictDecode method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientSetStrictDecodeFunc) PushHook(hook func(bool)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -7553,20 +16662,20 @@ func (f *AzureDevOpsClientGetRepoFunc) PushHook(hook func(context.Context, azure
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *AzureDevOpsClientGetRepoFunc) SetDefaultReturn(r0 azuredevops.Repository, r1 error) {
-	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error) {
-		return r0, r1
+func (f *AzureDevOpsClientSetStrictDecodeFunc) SetDefaultReturn() {
+	f.SetDefaultHook(func(bool) {
+		return
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *AzureDevOpsClientGetRepoFunc) PushReturn(r0 azuredevops.Repository, r1 error) {
-	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error) {
-		return r0, r1
+func (f *AzureDevOpsClientSetStrictDecodeFunc) PushReturn() {
+	f.PushHook(func(bool) {
+		return
 	})
 }
 
-func (f *AzureDevOpsClientGetRepoFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs) (azuredevops.Repository, error) {
+func (f *AzureDevOpsClientSetStrictDecodeFunc) nextHook() func(bool) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -7579,83 +16688,75 @@ func (f *AzureDevOpsClientGetRepoFunc) nextHook() func(context.Context, azuredev
 	return hook
 }
 
-func (f *AzureDevOpsClientGetRepoFunc) appendCall(r0 AzureDevOpsClientGetRepoFuncCall) {
+func (f *AzureDevOpsClientSetStrictDecodeFunc) appendCall(r0 AzureDevOpsClientSetStrictDecodeFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of AzureDevOpsClientGetRepoFuncCall objects
-// describing the invocations of this function.
-func (f *AzureDevOpsClientGetRepoFunc) History() []AzureDevOpsClientGetRepoFuncCall {
+// History returns a sequence of AzureDevOpsClientSetStrictDecodeFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientSetStrictDecodeFunc) History() []AzureDevOpsClientSetStrictDecodeFuncCall {
 	f.mutex.Lock()
-	history := make([]AzureDevOpsClientGetRepoFuncCall, len(f.history))
+	history := make([]AzureDevOpsClientSetStrictDecodeFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// AzureDevOpsClientGetRepoFuncCall is an object that describes an
-// invocation of method GetRepo on an instance of MockAzureDevOpsClient.
-type AzureDevOpsClientGetRepoFuncCall struct {
+// AzureDevOpsClientSetStrictDecodeFuncCall is an object that describes an
+// invocation of method SetStrictDecode on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientSetStrictDecodeFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 azuredevops.OrgProjectRepoArgs
-	// Result0 is the value of the 1st result returned from this method
-	// invocation.
-	Result0 azuredevops.Repository
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Arg0 bool
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c AzureDevOpsClientGetRepoFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c AzureDevOpsClientSetStrictDecodeFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c AzureDevOpsClientGetRepoFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c AzureDevOpsClientSetStrictDecodeFuncCall) Results() []interface{} {
+	return []interface{}{}
 }
 
-// AzureDevOpsClientGetRepositoryBranchFunc describes the behavior when the
-// GetRepositoryBranch method of the parent MockAzureDevOpsClient instance
+// AzureDevOpsClientSetWaitForRateLimitFunc describes the behavior when the
+// SetWaitForRateLimit method of the parent MockAzureDevOpsClient instance
 // is invoked.
-type AzureDevOpsClientGetRepositoryBranchFunc struct {
-	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, string) (azuredevops.Ref, error)
-	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, string) (azuredevops.Ref, error)
-	history     []AzureDevOpsClientGetRepositoryBranchFuncCall
+type AzureDevOpsClientSetWaitForRateLimitFunc struct {
+	defaultHook func(bool)
+	hooks       []func(bool)
+	history     []AzureDevOpsClientSetWaitForRateLimitFuncCall
 	mutex       sync.Mutex
 }
 
-// GetRepositoryBranch delegates to the next hook function in the queue and
+// SetWaitForRateLimit delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockAzureDevOpsClient) GetRepositoryBranch(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 string) (azuredevops.Ref, error) {
-	r0, r1 := m.GetRepositoryBranchFunc.nextHook()(v0, v1, v2)
-	m.GetRepositoryBranchFunc.appendCall(AzureDevOpsClientGetRepositoryBranchFuncCall{v0, v1, v2, r0, r1})
-	return r0, r1
+func (m *MockAzureDevOpsClient) SetWaitForRateLimit(v0 bool) {
+	m.SetWaitForRateLimitFunc.nextHook()(v0)
+	m.SetWaitForRateLimitFunc.appendCall(AzureDevOpsClientSetWaitForRateLimitFuncCall{v0})
+	return
 }
 
-// SetDefaultHook sets function that is called when the GetRepositoryBranch
+// SetDefaultHook sets function that is called when the SetWaitForRateLimit
 // method of the parent MockAzureDevOpsClient instance is invoked and the
 // hook queue is empty.
-func (f *AzureDevOpsClientGetRepositoryBranchFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, string) (azuredevops.Ref, error)) {
+func (f *AzureDevOpsClientSetWaitForRateLimitFunc) SetDefaultHook(hook func(bool)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetRepositoryBranch method of the parent MockAzureDevOpsClient instance
+// SetWaitForRateLimit method of the parent MockAzureDevOpsClient instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *AzureDevOpsClientGetRepositoryBranchFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, string) (azuredevops.Ref, error)) {
+func (f *AzureDevOpsClientSetWaitForRateLimitFunc) PushHook(hook func(bool)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -7663,20 +16764,20 @@ func (f *AzureDevOpsClientGetRepositoryBranchFunc) PushHook(hook func(context.Co
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *AzureDevOpsClientGetRepositoryBranchFunc) SetDefaultReturn(r0 azuredevops.Ref, r1 error) {
-	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, string) (azuredevops.Ref, error) {
-		return r0, r1
+func (f *AzureDevOpsClientSetWaitForRateLimitFunc) SetDefaultReturn() {
+	f.SetDefaultHook(func(bool) {
+		return
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *AzureDevOpsClientGetRepositoryBranchFunc) PushReturn(r0 azuredevops.Ref, r1 error) {
-	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, string) (azuredevops.Ref, error) {
-		return r0, r1
+func (f *AzureDevOpsClientSetWaitForRateLimitFunc) PushReturn() {
+	f.PushHook(func(bool) {
+		return
 	})
 }
 
-func (f *AzureDevOpsClientGetRepositoryBranchFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, string) (azuredevops.Ref, error) {
+func (f *AzureDevOpsClientSetWaitForRateLimitFunc) nextHook() func(bool) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -7689,86 +16790,74 @@ func (f *AzureDevOpsClientGetRepositoryBranchFunc) nextHook() func(context.Conte
 	return hook
 }
 
-func (f *AzureDevOpsClientGetRepositoryBranchFunc) appendCall(r0 AzureDevOpsClientGetRepositoryBranchFuncCall) {
+func (f *AzureDevOpsClientSetWaitForRateLimitFunc) appendCall(r0 AzureDevOpsClientSetWaitForRateLimitFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
 // History returns a sequence of
-// AzureDevOpsClientGetRepositoryBranchFuncCall objects describing the
+// AzureDevOpsClientSetWaitForRateLimitFuncCall objects describing the
 // invocations of this function.
-func (f *AzureDevOpsClientGetRepositoryBranchFunc) History() []AzureDevOpsClientGetRepositoryBranchFuncCall {
+func (f *AzureDevOpsClientSetWaitForRateLimitFunc) History() []AzureDevOpsClientSetWaitForRateLimitFuncCall {
 	f.mutex.Lock()
-	history := make([]AzureDevOpsClientGetRepositoryBranchFuncCall, len(f.history))
+	history := make([]AzureDevOpsClientSetWaitForRateLimitFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// AzureDevOpsClientGetRepositoryBranchFuncCall is an object that describes
-// an invocation of method GetRepositoryBranch on an instance of
+// AzureDevOpsClientSetWaitForRateLimitFuncCall is an object that describes
+// an invocation of method SetWaitForRateLimit on an instance of
 // MockAzureDevOpsClient.
-type AzureDevOpsClientGetRepositoryBranchFuncCall struct {
+type AzureDevOpsClientSetWaitForRateLimitFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 context.Context
-	// Arg1 is the value of the 2nd argument passed to this method
-	// invocation.
-	Arg1 azuredevops.OrgProjectRepoArgs
-	// Arg2 is the value of the 3rd argument passed to this method
-	// invocation.
-	Arg2 string
-	// Result0 is the value of the 1st result returned from this method
-	// invocation.
-	Result0 azuredevops.Ref
-	// Result1 is the value of the 2nd result returned from this method
-	// invocation.
-	Result1 error
+	Arg0 bool
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c AzureDevOpsClientGetRepositoryBranchFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c AzureDevOpsClientSetWaitForRateLimitFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c AzureDevOpsClientGetRepositoryBranchFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0, c.Result1}
+func (c AzureDevOpsClientSetWaitForRateLimitFuncCall) Results() []interface{} {
+	return []interface{}{}
 }
 
-// AzureDevOpsClientGetURLFunc describes the behavior when the GetURL method
-// of the parent MockAzureDevOpsClient instance is invoked.
-type AzureDevOpsClientGetURLFunc struct {
-	defaultHook func() *url.URL
-	hooks       []func() *url.URL
-	history     []AzureDevOpsClientGetURLFuncCall
+// AzureDevOpsClientStatItemFunc describes the behavior when the StatItem
+// method of the parent MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientStatItemFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, string, *azuredevops.GitVersionDescriptor) (azuredevops.Item, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, string, *azuredevops.GitVersionDescriptor) (azuredevops.Item, error)
+	history     []AzureDevOpsClientStatItemFuncCall
 	mutex       sync.Mutex
 }
 
-// GetURL delegates to the next hook function in the queue and stores the
+// StatItem delegates to the next hook function in the queue and stores the
 // parameter and result values of this invocation.
-func (m *MockAzureDevOpsClient) GetURL() *url.URL {
-	r0 := m.GetURLFunc.nextHook()()
-	m.GetURLFunc.appendCall(AzureDevOpsClientGetURLFuncCall{r0})
-	return r0
+func (m *MockAzureDevOpsClient) StatItem(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 string, v3 *azuredevops.GitVersionDescriptor) (azuredevops.Item, error) {
+	r0, r1 := m.StatItemFunc.nextHook()(v0, v1, v2, v3)
+	m.StatItemFunc.appendCall(AzureDevOpsClientStatItemFuncCall{v0, v1, v2, v3, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the GetURL method of the
-// parent MockAzureDevOpsClient instance is invoked and the hook queue is
-// empty.
-func (f *AzureDevOpsClientGetURLFunc) SetDefaultHook(hook func() *url.URL) {
+// SetDefaultHook sets function that is called when the StatItem method of
+// the parent MockAzureDevOpsClient instance is invoked and the hook queue
+// is empty.
+func (f *AzureDevOpsClientStatItemFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, string, *azuredevops.GitVersionDescriptor) (azuredevops.Item, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// GetURL method of the parent MockAzureDevOpsClient instance invokes the
+// StatItem method of the parent MockAzureDevOpsClient instance invokes the
 // hook at the front of the queue and discards it. After the queue is empty,
 // the default hook function is invoked for any future action.
-func (f *AzureDevOpsClientGetURLFunc) PushHook(hook func() *url.URL) {
+func (f *AzureDevOpsClientStatItemFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, string, *azuredevops.GitVersionDescriptor) (azuredevops.Item, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -7776,20 +16865,20 @@ func (f *AzureDevOpsClientGetURLFunc) PushHook(hook func() *url.URL) {
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *AzureDevOpsClientGetURLFunc) SetDefaultReturn(r0 *url.URL) {
-	f.SetDefaultHook(func() *url.URL {
-		return r0
+func (f *AzureDevOpsClientStatItemFunc) SetDefaultReturn(r0 azuredevops.Item, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, string, *azuredevops.GitVersionDescriptor) (azuredevops.Item, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *AzureDevOpsClientGetURLFunc) PushReturn(r0 *url.URL) {
-	f.PushHook(func() *url.URL {
-		return r0
+func (f *AzureDevOpsClientStatItemFunc) PushReturn(r0 azuredevops.Item, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, string, *azuredevops.GitVersionDescriptor) (azuredevops.Item, error) {
+		return r0, r1
 	})
 }
 
-func (f *AzureDevOpsClientGetURLFunc) nextHook() func() *url.URL {
+func (f *AzureDevOpsClientStatItemFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, string, *azuredevops.GitVersionDescriptor) (azuredevops.Item, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -7802,74 +16891,88 @@ func (f *AzureDevOpsClientGetURLFunc) nextHook() func() *url.URL {
 	return hook
 }
 
-func (f *AzureDevOpsClientGetURLFunc) appendCall(r0 AzureDevOpsClientGetURLFuncCall) {
+func (f *AzureDevOpsClientStatItemFunc) appendCall(r0 AzureDevOpsClientStatItemFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of AzureDevOpsClientGetURLFuncCall objects
+// History returns a sequence of AzureDevOpsClientStatItemFuncCall objects
 // describing the invocations of this function.
-func (f *AzureDevOpsClientGetURLFunc) History() []AzureDevOpsClientGetURLFuncCall {
+func (f *AzureDevOpsClientStatItemFunc) History() []AzureDevOpsClientStatItemFuncCall {
 	f.mutex.Lock()
-	history := make([]AzureDevOpsClientGetURLFuncCall, len(f.history))
+	history := make([]AzureDevOpsClientStatItemFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// AzureDevOpsClientGetURLFuncCall is an object that describes an invocation
-// of method GetURL on an instance of MockAzureDevOpsClient.
-type AzureDevOpsClientGetURLFuncCall struct {
+// AzureDevOpsClientStatItemFuncCall is an object that describes an
+// invocation of method StatItem on an instance of MockAzureDevOpsClient.
+type AzureDevOpsClientStatItemFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 *azuredevops.GitVersionDescriptor
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 *url.URL
+	Result0 azuredevops.Item
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c AzureDevOpsClientGetURLFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c AzureDevOpsClientStatItemFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c AzureDevOpsClientGetURLFuncCall) Results() []interface{} {
-	return []interface{}{c.Result0}
+func (c AzureDevOpsClientStatItemFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// AzureDevOpsClientIsAzureDevOpsServicesFunc describes the behavior when
-// the IsAzureDevOpsServices method of the parent MockAzureDevOpsClient
-// instance is invoked.
-type AzureDevOpsClientIsAzureDevOpsServicesFunc struct {
-	defaultHook func() bool
-	hooks       []func() bool
-	history     []AzureDevOpsClientIsAzureDevOpsServicesFuncCall
+// AzureDevOpsClientUnlikeCommentFunc describes the behavior when the
+// UnlikeComment method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientUnlikeCommentFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommentArgs) error
+	hooks       []func(context.Context, azuredevops.PullRequestCommentArgs) error
+	history     []AzureDevOpsClientUnlikeCommentFuncCall
 	mutex       sync.Mutex
 }
 
-// IsAzureDevOpsServices delegates to the next hook function in the queue
-// and stores the parameter and result values of this invocation.
-func (m *MockAzureDevOpsClient) IsAzureDevOpsServices() bool {
-	r0 := m.IsAzureDevOpsServicesFunc.nextHook()()
-	m.IsAzureDevOpsServicesFunc.appendCall(AzureDevOpsClientIsAzureDevOpsServicesFuncCall{r0})
+// UnlikeComment delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) UnlikeComment(v0 context.Context, v1 azuredevops.PullRequestCommentArgs) error {
+	r0 := m.UnlikeCommentFunc.nextHook()(v0, v1)
+	m.UnlikeCommentFunc.appendCall(AzureDevOpsClientUnlikeCommentFuncCall{v0, v1, r0})
 	return r0
 }
 
-// SetDefaultHook sets function that is called when the
-// IsAzureDevOpsServices method of the parent MockAzureDevOpsClient instance
-// is invoked and the hook queue is empty.
-func (f *AzureDevOpsClientIsAzureDevOpsServicesFunc) SetDefaultHook(hook func() bool) {
+// SetDefaultHook sets function that is called when the UnlikeComment method
+// of the parent MockAzureDevOpsClient instance is invoked and the hook
+// queue is empty.
+func (f *AzureDevOpsClientUnlikeCommentFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommentArgs) error) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// IsAzureDevOpsServices method of the parent MockAzureDevOpsClient instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *AzureDevOpsClientIsAzureDevOpsServicesFunc) PushHook(hook func() bool) {
+// UnlikeComment method of the parent MockAzureDevOpsClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientUnlikeCommentFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommentArgs) error) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -7877,20 +16980,20 @@ func (f *AzureDevOpsClientIsAzureDevOpsServicesFunc) PushHook(hook func() bool)
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *AzureDevOpsClientIsAzureDevOpsServicesFunc) SetDefaultReturn(r0 bool) {
-	f.SetDefaultHook(func() bool {
+func (f *AzureDevOpsClientUnlikeCommentFunc) SetDefaultReturn(r0 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommentArgs) error {
 		return r0
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *AzureDevOpsClientIsAzureDevOpsServicesFunc) PushReturn(r0 bool) {
-	f.PushHook(func() bool {
+func (f *AzureDevOpsClientUnlikeCommentFunc) PushReturn(r0 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommentArgs) error {
 		return r0
 	})
 }
 
-func (f *AzureDevOpsClientIsAzureDevOpsServicesFunc) nextHook() func() bool {
+func (f *AzureDevOpsClientUnlikeCommentFunc) nextHook() func(context.Context, azuredevops.PullRequestCommentArgs) error {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -7903,76 +17006,81 @@ func (f *AzureDevOpsClientIsAzureDevOpsServicesFunc) nextHook() func() bool {
 	return hook
 }
 
-func (f *AzureDevOpsClientIsAzureDevOpsServicesFunc) appendCall(r0 AzureDevOpsClientIsAzureDevOpsServicesFuncCall) {
+func (f *AzureDevOpsClientUnlikeCommentFunc) appendCall(r0 AzureDevOpsClientUnlikeCommentFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// AzureDevOpsClientIsAzureDevOpsServicesFuncCall objects describing the
-// invocations of this function.
-func (f *AzureDevOpsClientIsAzureDevOpsServicesFunc) History() []AzureDevOpsClientIsAzureDevOpsServicesFuncCall {
+// History returns a sequence of AzureDevOpsClientUnlikeCommentFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientUnlikeCommentFunc) History() []AzureDevOpsClientUnlikeCommentFuncCall {
 	f.mutex.Lock()
-	history := make([]AzureDevOpsClientIsAzureDevOpsServicesFuncCall, len(f.history))
+	history := make([]AzureDevOpsClientUnlikeCommentFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// AzureDevOpsClientIsAzureDevOpsServicesFuncCall is an object that
-// describes an invocation of method IsAzureDevOpsServices on an instance of
+// AzureDevOpsClientUnlikeCommentFuncCall is an object that describes an
+// invocation of method UnlikeComment on an instance of
 // MockAzureDevOpsClient.
-type AzureDevOpsClientIsAzureDevOpsServicesFuncCall struct {
+type AzureDevOpsClientUnlikeCommentFuncCall struct {
+	// Arg0 is the value of the 1st argument passed to this method
+	// invocation.
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.PullRequestCommentArgs
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 bool
+	Result0 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c AzureDevOpsClientIsAzureDevOpsServicesFuncCall) Args() []interface{} {
-	return []interface{}{}
+func (c AzureDevOpsClientUnlikeCommentFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c AzureDevOpsClientIsAzureDevOpsServicesFuncCall) Results() []interface{} {
+func (c AzureDevOpsClientUnlikeCommentFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0}
 }
 
-// AzureDevOpsClientListAuthorizedUserOrganizationsFunc describes the
-// behavior when the ListAuthorizedUserOrganizations method of the parent
-// MockAzureDevOpsClient instance is invoked.
-type AzureDevOpsClientListAuthorizedUserOrganizationsFunc struct {
-	defaultHook func(context.Context, azuredevops.Profile) ([]azuredevops.Org, error)
-	hooks       []func(context.Context, azuredevops.Profile) ([]azuredevops.Org, error)
-	history     []AzureDevOpsClientListAuthorizedUserOrganizationsFuncCall
+// AzureDevOpsClientUpdatePullRequestFunc describes the behavior when the
+// UpdatePullRequest method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientUpdatePullRequestFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error)
+	history     []AzureDevOpsClientUpdatePullRequestFuncCall
 	mutex       sync.Mutex
 }
 
-// ListAuthorizedUserOrganizations delegates to the next hook function in
-// the queue and stores the parameter and result values of this invocation.
-func (m *MockAzureDevOpsClient) ListAuthorizedUserOrganizations(v0 context.Context, v1 azuredevops.Profile) ([]azuredevops.Org, error) {
-	r0, r1 := m.ListAuthorizedUserOrganizationsFunc.nextHook()(v0, v1)
-	m.ListAuthorizedUserOrganizationsFunc.appendCall(AzureDevOpsClientListAuthorizedUserOrganizationsFuncCall{v0, v1, r0, r1})
+// UpdatePullRequest delegates to the next hook function in the queue and
+// stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) UpdatePullRequest(v0 context.Context, v1 azuredevops.PullRequestCommonArgs, v2 azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error) {
+	r0, r1 := m.UpdatePullRequestFunc.nextHook()(v0, v1, v2)
+	m.UpdatePullRequestFunc.appendCall(AzureDevOpsClientUpdatePullRequestFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// ListAuthorizedUserOrganizations method of the parent
-// MockAzureDevOpsClient instance is invoked and the hook queue is empty.
-func (f *AzureDevOpsClientListAuthorizedUserOrganizationsFunc) SetDefaultHook(hook func(context.Context, azuredevops.Profile) ([]azuredevops.Org, error)) {
+// SetDefaultHook sets function that is called when the UpdatePullRequest
+// method of the parent MockAzureDevOpsClient instance is invoked and the
+// hook queue is empty.
+func (f *AzureDevOpsClientUpdatePullRequestFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListAuthorizedUserOrganizations method of the parent
-// MockAzureDevOpsClient instance invokes the hook at the front of the queue
-// and discards it. After the queue is empty, the default hook function is
-// invoked for any future action.
-func (f *AzureDevOpsClientListAuthorizedUserOrganizationsFunc) PushHook(hook func(context.Context, azuredevops.Profile) ([]azuredevops.Org, error)) {
+// UpdatePullRequest method of the parent MockAzureDevOpsClient instance
+// invokes the hook at the front of the queue and discards it. After the
+// queue is empty, the default hook function is invoked for any future
+// action.
+func (f *AzureDevOpsClientUpdatePullRequestFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -7980,20 +17088,20 @@ func (f *AzureDevOpsClientListAuthorizedUserOrganizationsFunc) PushHook(hook fun
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *AzureDevOpsClientListAuthorizedUserOrganizationsFunc) SetDefaultReturn(r0 []azuredevops.Org, r1 error) {
-	f.SetDefaultHook(func(context.Context, azuredevops.Profile) ([]azuredevops.Org, error) {
+func (f *AzureDevOpsClientUpdatePullRequestFunc) SetDefaultReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *AzureDevOpsClientListAuthorizedUserOrganizationsFunc) PushReturn(r0 []azuredevops.Org, r1 error) {
-	f.PushHook(func(context.Context, azuredevops.Profile) ([]azuredevops.Org, error) {
+func (f *AzureDevOpsClientUpdatePullRequestFunc) PushReturn(r0 azuredevops.PullRequest, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error) {
 		return r0, r1
 	})
 }
 
-func (f *AzureDevOpsClientListAuthorizedUserOrganizationsFunc) nextHook() func(context.Context, azuredevops.Profile) ([]azuredevops.Org, error) {
+func (f *AzureDevOpsClientUpdatePullRequestFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -8006,37 +17114,39 @@ func (f *AzureDevOpsClientListAuthorizedUserOrganizationsFunc) nextHook() func(c
 	return hook
 }
 
-func (f *AzureDevOpsClientListAuthorizedUserOrganizationsFunc) appendCall(r0 AzureDevOpsClientListAuthorizedUserOrganizationsFuncCall) {
+func (f *AzureDevOpsClientUpdatePullRequestFunc) appendCall(r0 AzureDevOpsClientUpdatePullRequestFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// AzureDevOpsClientListAuthorizedUserOrganizationsFuncCall objects
-// describing the invocations of this function.
-func (f *AzureDevOpsClientListAuthorizedUserOrganizationsFunc) History() []AzureDevOpsClientListAuthorizedUserOrganizationsFuncCall {
+// History returns a sequence of AzureDevOpsClientUpdatePullRequestFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientUpdatePullRequestFunc) History() []AzureDevOpsClientUpdatePullRequestFuncCall {
 	f.mutex.Lock()
-	history := make([]AzureDevOpsClientListAuthorizedUserOrganizationsFuncCall, len(f.history))
+	history := make([]AzureDevOpsClientUpdatePullRequestFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// AzureDevOpsClientListAuthorizedUserOrganizationsFuncCall is an object
-// that describes an invocation of method ListAuthorizedUserOrganizations on
-// an instance of MockAzureDevOpsClient.
-type AzureDevOpsClientListAuthorizedUserOrganizationsFuncCall struct {
+// AzureDevOpsClientUpdatePullRequestFuncCall is an object that describes an
+// invocation of method UpdatePullRequest on an instance of
+// MockAzureDevOpsClient.
+type AzureDevOpsClientUpdatePullRequestFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 azuredevops.Profile
+	Arg1 azuredevops.PullRequestCommonArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 azuredevops.PullRequestUpdateInput
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []azuredevops.Org
+	Result0 azuredevops.PullRequest
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -8044,47 +17154,46 @@ type AzureDevOpsClientListAuthorizedUserOrganizationsFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c AzureDevOpsClientListAuthorizedUserOrganizationsFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c AzureDevOpsClientUpdatePullRequestFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c AzureDevOpsClientListAuthorizedUserOrganizationsFuncCall) Results() []interface{} {
+func (c AzureDevOpsClientUpdatePullRequestFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// AzureDevOpsClientListRepositoriesByProjectOrOrgFunc describes the
-// behavior when the ListRepositoriesByProjectOrOrg method of the parent
-// MockAzureDevOpsClient instance is invoked.
-type AzureDevOpsClientListRepositoriesByProjectOrOrgFunc struct {
-	defaultHook func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error)
-	hooks       []func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error)
-	history     []AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall
+// AzureDevOpsClientUpdateRefsFunc describes the behavior when the
+// UpdateRefs method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientUpdateRefsFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, []azuredevops.RefUpdate) ([]azuredevops.RefUpdateResult, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, []azuredevops.RefUpdate) ([]azuredevops.RefUpdateResult, error)
+	history     []AzureDevOpsClientUpdateRefsFuncCall
 	mutex       sync.Mutex
 }
 
-// ListRepositoriesByProjectOrOrg delegates to the next hook function in the
-// queue and stores the parameter and result values of this invocation.
-func (m *MockAzureDevOpsClient) ListRepositoriesByProjectOrOrg(v0 context.Context, v1 azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error) {
-	r0, r1 := m.ListRepositoriesByProjectOrOrgFunc.nextHook()(v0, v1)
-	m.ListRepositoriesByProjectOrOrgFunc.appendCall(AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall{v0, v1, r0, r1})
+// UpdateRefs delegates to the next hook function in the queue and stores
+// the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) UpdateRefs(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 []azuredevops.RefUpdate) ([]azuredevops.RefUpdateResult, error) {
+	r0, r1 := m.UpdateRefsFunc.nextHook()(v0, v1, v2)
+	m.UpdateRefsFunc.appendCall(AzureDevOpsClientUpdateRefsFuncCall{v0, v1, v2, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the
-// ListRepositoriesByProjectOrOrg method of the parent MockAzureDevOpsClient
-// instance is invoked and the hook queue is empty.
-func (f *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc) SetDefaultHook(hook func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error)) {
+// SetDefaultHook sets function that is called when the UpdateRefs method of
+// the parent MockAzureDevOpsClient instance is invoked and the hook queue
+// is empty.
+func (f *AzureDevOpsClientUpdateRefsFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, []azuredevops.RefUpdate) ([]azuredevops.RefUpdateResult, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// ListRepositoriesByProjectOrOrg method of the parent MockAzureDevOpsClient
-// instance invokes the hook at the front of the queue and discards it.
-// After the queue is empty, the default hook function is invoked for any
-// future action.
-func (f *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc) PushHook(hook func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error)) {
+// UpdateRefs method of the parent MockAzureDevOpsClient instance invokes
+// the hook at the front of the queue and discards it. After the queue is
+// empty, the default hook function is invoked for any future action.
+func (f *AzureDevOpsClientUpdateRefsFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, []azuredevops.RefUpdate) ([]azuredevops.RefUpdateResult, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -8092,20 +17201,20 @@ func (f *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc) PushHook(hook func
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc) SetDefaultReturn(r0 []azuredevops.Repository, r1 error) {
-	f.SetDefaultHook(func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error) {
+func (f *AzureDevOpsClientUpdateRefsFunc) SetDefaultReturn(r0 []azuredevops.RefUpdateResult, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, []azuredevops.RefUpdate) ([]azuredevops.RefUpdateResult, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc) PushReturn(r0 []azuredevops.Repository, r1 error) {
-	f.PushHook(func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error) {
+func (f *AzureDevOpsClientUpdateRefsFunc) PushReturn(r0 []azuredevops.RefUpdateResult, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, []azuredevops.RefUpdate) ([]azuredevops.RefUpdateResult, error) {
 		return r0, r1
 	})
 }
 
-func (f *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc) nextHook() func(context.Context, azuredevops.ListRepositoriesByProjectOrOrgArgs) ([]azuredevops.Repository, error) {
+func (f *AzureDevOpsClientUpdateRefsFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, []azuredevops.RefUpdate) ([]azuredevops.RefUpdateResult, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -8118,37 +17227,38 @@ func (f *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc) nextHook() func(co
 	return hook
 }
 
-func (f *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc) appendCall(r0 AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall) {
+func (f *AzureDevOpsClientUpdateRefsFunc) appendCall(r0 AzureDevOpsClientUpdateRefsFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall objects
+// History returns a sequence of AzureDevOpsClientUpdateRefsFuncCall objects
 // describing the invocations of this function.
-func (f *AzureDevOpsClientListRepositoriesByProjectOrOrgFunc) History() []AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall {
+func (f *AzureDevOpsClientUpdateRefsFunc) History() []AzureDevOpsClientUpdateRefsFuncCall {
 	f.mutex.Lock()
-	history := make([]AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall, len(f.history))
+	history := make([]AzureDevOpsClientUpdateRefsFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall is an object that
-// describes an invocation of method ListRepositoriesByProjectOrOrg on an
-// instance of MockAzureDevOpsClient.
-type AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall struct {
+// AzureDevOpsClientUpdateRefsFuncCall is an object that describes an
+// invocation of method UpdateRefs on an instance of MockAzureDevOpsClient.
+type AzureDevOpsClientUpdateRefsFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
 	// Arg1 is the value of the 2nd argument passed to this method
 	// invocation.
-	Arg1 azuredevops.ListRepositoriesByProjectOrOrgArgs
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 []azuredevops.RefUpdate
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 []azuredevops.Repository
+	Result0 []azuredevops.RefUpdateResult
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -8156,47 +17266,47 @@ type AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1}
+func (c AzureDevOpsClientUpdateRefsFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c AzureDevOpsClientListRepositoriesByProjectOrOrgFuncCall) Results() []interface{} {
+func (c AzureDevOpsClientUpdateRefsFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
-// AzureDevOpsClientSetWaitForRateLimitFunc describes the behavior when the
-// SetWaitForRateLimit method of the parent MockAzureDevOpsClient instance
-// is invoked.
-type AzureDevOpsClientSetWaitForRateLimitFunc struct {
-	defaultHook func(bool)
-	hooks       []func(bool)
-	history     []AzureDevOpsClientSetWaitForRateLimitFuncCall
+// AzureDevOpsClientUpdateRepositoryFunc describes the behavior when the
+// UpdateRepository method of the parent MockAzureDevOpsClient instance is
+// invoked.
+type AzureDevOpsClientUpdateRepositoryFunc struct {
+	defaultHook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.UpdateRepositoryInput) (azuredevops.Repository, error)
+	hooks       []func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.UpdateRepositoryInput) (azuredevops.Repository, error)
+	history     []AzureDevOpsClientUpdateRepositoryFuncCall
 	mutex       sync.Mutex
 }
 
-// SetWaitForRateLimit delegates to the next hook function in the queue and
+// UpdateRepository delegates to the next hook function in the queue and
 // stores the parameter and result values of this invocation.
-func (m *MockAzureDevOpsClient) SetWaitForRateLimit(v0 bool) {
-	m.SetWaitForRateLimitFunc.nextHook()(v0)
-	m.SetWaitForRateLimitFunc.appendCall(AzureDevOpsClientSetWaitForRateLimitFuncCall{v0})
-	return
+func (m *MockAzureDevOpsClient) UpdateRepository(v0 context.Context, v1 azuredevops.OrgProjectRepoArgs, v2 azuredevops.UpdateRepositoryInput) (azuredevops.Repository, error) {
+	r0, r1 := m.UpdateRepositoryFunc.nextHook()(v0, v1, v2)
+	m.UpdateRepositoryFunc.appendCall(AzureDevOpsClientUpdateRepositoryFuncCall{v0, v1, v2, r0, r1})
+	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the SetWaitForRateLimit
+// SetDefaultHook sets function that is called when the UpdateRepository
 // method of the parent MockAzureDevOpsClient instance is invoked and the
 // hook queue is empty.
-func (f *AzureDevOpsClientSetWaitForRateLimitFunc) SetDefaultHook(hook func(bool)) {
+func (f *AzureDevOpsClientUpdateRepositoryFunc) SetDefaultHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.UpdateRepositoryInput) (azuredevops.Repository, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// SetWaitForRateLimit method of the parent MockAzureDevOpsClient instance
+// UpdateRepository method of the parent MockAzureDevOpsClient instance
 // invokes the hook at the front of the queue and discards it. After the
 // queue is empty, the default hook function is invoked for any future
 // action.
-func (f *AzureDevOpsClientSetWaitForRateLimitFunc) PushHook(hook func(bool)) {
+func (f *AzureDevOpsClientUpdateRepositoryFunc) PushHook(hook func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.UpdateRepositoryInput) (azuredevops.Repository, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -8204,20 +17314,20 @@ func (f *AzureDevOpsClientSetWaitForRateLimitFunc) PushHook(hook func(bool)) {
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *AzureDevOpsClientSetWaitForRateLimitFunc) SetDefaultReturn() {
-	f.SetDefaultHook(func(bool) {
-		return
+func (f *AzureDevOpsClientUpdateRepositoryFunc) SetDefaultReturn(r0 azuredevops.Repository, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.UpdateRepositoryInput) (azuredevops.Repository, error) {
+		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *AzureDevOpsClientSetWaitForRateLimitFunc) PushReturn() {
-	f.PushHook(func(bool) {
-		return
+func (f *AzureDevOpsClientUpdateRepositoryFunc) PushReturn(r0 azuredevops.Repository, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.UpdateRepositoryInput) (azuredevops.Repository, error) {
+		return r0, r1
 	})
 }
 
-func (f *AzureDevOpsClientSetWaitForRateLimitFunc) nextHook() func(bool) {
+func (f *AzureDevOpsClientUpdateRepositoryFunc) nextHook() func(context.Context, azuredevops.OrgProjectRepoArgs, azuredevops.UpdateRepositoryInput) (azuredevops.Repository, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -8230,76 +17340,87 @@ func (f *AzureDevOpsClientSetWaitForRateLimitFunc) nextHook() func(bool) {
 	return hook
 }
 
-func (f *AzureDevOpsClientSetWaitForRateLimitFunc) appendCall(r0 AzureDevOpsClientSetWaitForRateLimitFuncCall) {
+func (f *AzureDevOpsClientUpdateRepositoryFunc) appendCall(r0 AzureDevOpsClientUpdateRepositoryFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of
-// AzureDevOpsClientSetWaitForRateLimitFuncCall objects describing the
-// invocations of this function.
-func (f *AzureDevOpsClientSetWaitForRateLimitFunc) History() []AzureDevOpsClientSetWaitForRateLimitFuncCall {
+// History returns a sequence of AzureDevOpsClientUpdateRepositoryFuncCall
+// objects describing the invocations of this function.
+func (f *AzureDevOpsClientUpdateRepositoryFunc) History() []AzureDevOpsClientUpdateRepositoryFuncCall {
 	f.mutex.Lock()
-	history := make([]AzureDevOpsClientSetWaitForRateLimitFuncCall, len(f.history))
+	history := make([]AzureDevOpsClientUpdateRepositoryFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// AzureDevOpsClientSetWaitForRateLimitFuncCall is an object that describes
-// an invocation of method SetWaitForRateLimit on an instance of
+// AzureDevOpsClientUpdateRepositoryFuncCall is an object that describes an
+// invocation of method UpdateRepository on an instance of
 // MockAzureDevOpsClient.
-type AzureDevOpsClientSetWaitForRateLimitFuncCall struct {
+type AzureDevOpsClientUpdateRepositoryFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
-	Arg0 bool
+	Arg0 context.Context
+	// Arg1 is the value of the 2nd argument passed to this method
+	// invocation.
+	Arg1 azuredevops.OrgProjectRepoArgs
+	// Arg2 is the value of the 3rd argument passed to this method
+	// invocation.
+	Arg2 azuredevops.UpdateRepositoryInput
+	// Result0 is the value of the 1st result returned from this method
+	// invocation.
+	Result0 azuredevops.Repository
+	// Result1 is the value of the 2nd result returned from this method
+	// invocation.
+	Result1 error
 }
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c AzureDevOpsClientSetWaitForRateLimitFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0}
+func (c AzureDevOpsClientUpdateRepositoryFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c AzureDevOpsClientSetWaitForRateLimitFuncCall) Results() []interface{} {
-	return []interface{}{}
+func (c AzureDevOpsClientUpdateRepositoryFuncCall) Results() []interface{} {
+	return []interface{}{c.Result0, c.Result1}
 }
 
-// AzureDevOpsClientUpdatePullRequestFunc describes the behavior when the
-// UpdatePullRequest method of the parent MockAzureDevOpsClient instance is
-// invoked.
-type AzureDevOpsClientUpdatePullRequestFunc struct {
-	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error)
-	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error)
-	history     []AzureDevOpsClientUpdatePullRequestFuncCall
+// AzureDevOpsClientUploadPullRequestAttachmentFunc describes the behavior
+// when the UploadPullRequestAttachment method of the parent
+// MockAzureDevOpsClient instance is invoked.
+type AzureDevOpsClientUploadPullRequestAttachmentFunc struct {
+	defaultHook func(context.Context, azuredevops.PullRequestCommonArgs, string, io.Reader) (azuredevops.Attachment, error)
+	hooks       []func(context.Context, azuredevops.PullRequestCommonArgs, string, io.Reader) (azuredevops.Attachment, error)
+	history     []AzureDevOpsClientUploadPullRequestAttachmentFuncCall
 	mutex       sync.Mutex
 }
 
-// UpdatePullRequest delegates to the next hook function in the queue and
-// stores the parameter and result values of this invocation.
-func (m *MockAzureDevOpsClient) UpdatePullRequest(v0 context.Context, v1 azuredevops.PullRequestCommonArgs, v2 azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error) {
-	r0, r1 := m.UpdatePullRequestFunc.nextHook()(v0, v1, v2)
-	m.UpdatePullRequestFunc.appendCall(AzureDevOpsClientUpdatePullRequestFuncCall{v0, v1, v2, r0, r1})
+// UploadPullRequestAttachment delegates to the next hook function in the
+// queue and stores the parameter and result values of this invocation.
+func (m *MockAzureDevOpsClient) UploadPullRequestAttachment(v0 context.Context, v1 azuredevops.PullRequestCommonArgs, v2 string, v3 io.Reader) (azuredevops.Attachment, error) {
+	r0, r1 := m.UploadPullRequestAttachmentFunc.nextHook()(v0, v1, v2, v3)
+	m.UploadPullRequestAttachmentFunc.appendCall(AzureDevOpsClientUploadPullRequestAttachmentFuncCall{v0, v1, v2, v3, r0, r1})
 	return r0, r1
 }
 
-// SetDefaultHook sets function that is called when the UpdatePullRequest
-// method of the parent MockAzureDevOpsClient instance is invoked and the
-// hook queue is empty.
-func (f *AzureDevOpsClientUpdatePullRequestFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error)) {
+// SetDefaultHook sets function that is called when the
+// UploadPullRequestAttachment method of the parent MockAzureDevOpsClient
+// instance is invoked and the hook queue is empty.
+func (f *AzureDevOpsClientUploadPullRequestAttachmentFunc) SetDefaultHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, string, io.Reader) (azuredevops.Attachment, error)) {
 	f.defaultHook = hook
 }
 
 // PushHook adds a function to the end of hook queue. Each invocation of the
-// UpdatePullRequest method of the parent MockAzureDevOpsClient instance
-// invokes the hook at the front of the queue and discards it. After the
-// queue is empty, the default hook function is invoked for any future
-// action.
-func (f *AzureDevOpsClientUpdatePullRequestFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error)) {
+// UploadPullRequestAttachment method of the parent MockAzureDevOpsClient
+// instance invokes the hook at the front of the queue and discards it.
+// After the queue is empty, the default hook function is invoked for any
+// future action.
+func (f *AzureDevOpsClientUploadPullRequestAttachmentFunc) PushHook(hook func(context.Context, azuredevops.PullRequestCommonArgs, string, io.Reader) (azuredevops.Attachment, error)) {
 	f.mutex.Lock()
 	f.hooks = append(f.hooks, hook)
 	f.mutex.Unlock()
@@ -8307,20 +17428,20 @@ func (f *AzureDevOpsClientUpdatePullRequestFunc) PushHook(hook func(context.Cont
 
 // SetDefaultReturn calls SetDefaultHook with a function that returns the
 // given values.
-func (f *AzureDevOpsClientUpdatePullRequestFunc) SetDefaultReturn(r0 azuredevops.PullRequest, r1 error) {
-	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error) {
+func (f *AzureDevOpsClientUploadPullRequestAttachmentFunc) SetDefaultReturn(r0 azuredevops.Attachment, r1 error) {
+	f.SetDefaultHook(func(context.Context, azuredevops.PullRequestCommonArgs, string, io.Reader) (azuredevops.Attachment, error) {
 		return r0, r1
 	})
 }
 
 // PushReturn calls PushHook with a function that returns the given values.
-func (f *AzureDevOpsClientUpdatePullRequestFunc) PushReturn(r0 azuredevops.PullRequest, r1 error) {
-	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error) {
+func (f *AzureDevOpsClientUploadPullRequestAttachmentFunc) PushReturn(r0 azuredevops.Attachment, r1 error) {
+	f.PushHook(func(context.Context, azuredevops.PullRequestCommonArgs, string, io.Reader) (azuredevops.Attachment, error) {
 		return r0, r1
 	})
 }
 
-func (f *AzureDevOpsClientUpdatePullRequestFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs, azuredevops.PullRequestUpdateInput) (azuredevops.PullRequest, error) {
+func (f *AzureDevOpsClientUploadPullRequestAttachmentFunc) nextHook() func(context.Context, azuredevops.PullRequestCommonArgs, string, io.Reader) (azuredevops.Attachment, error) {
 	f.mutex.Lock()
 	defer f.mutex.Unlock()
 
@@ -8333,27 +17454,28 @@ func (f *AzureDevOpsClientUpdatePullRequestFunc) nextHook() func(context.Context
 	return hook
 }
 
-func (f *AzureDevOpsClientUpdatePullRequestFunc) appendCall(r0 AzureDevOpsClientUpdatePullRequestFuncCall) {
+func (f *AzureDevOpsClientUploadPullRequestAttachmentFunc) appendCall(r0 AzureDevOpsClientUploadPullRequestAttachmentFuncCall) {
 	f.mutex.Lock()
 	f.history = append(f.history, r0)
 	f.mutex.Unlock()
 }
 
-// History returns a sequence of AzureDevOpsClientUpdatePullRequestFuncCall
-// objects describing the invocations of this function.
-func (f *AzureDevOpsClientUpdatePullRequestFunc) History() []AzureDevOpsClientUpdatePullRequestFuncCall {
+// History returns a sequence of
+// AzureDevOpsClientUploadPullRequestAttachmentFuncCall objects describing
+// the invocations of this function.
+func (f *AzureDevOpsClientUploadPullRequestAttachmentFunc) History() []AzureDevOpsClientUploadPullRequestAttachmentFuncCall {
 	f.mutex.Lock()
-	history := make([]AzureDevOpsClientUpdatePullRequestFuncCall, len(f.history))
+	history := make([]AzureDevOpsClientUploadPullRequestAttachmentFuncCall, len(f.history))
 	copy(history, f.history)
 	f.mutex.Unlock()
 
 	return history
 }
 
-// AzureDevOpsClientUpdatePullRequestFuncCall is an object that describes an
-// invocation of method UpdatePullRequest on an instance of
-// MockAzureDevOpsClient.
-type AzureDevOpsClientUpdatePullRequestFuncCall struct {
+// AzureDevOpsClientUploadPullRequestAttachmentFuncCall is an object that
+// describes an invocation of method UploadPullRequestAttachment on an
+// instance of MockAzureDevOpsClient.
+type AzureDevOpsClientUploadPullRequestAttachmentFuncCall struct {
 	// Arg0 is the value of the 1st argument passed to this method
 	// invocation.
 	Arg0 context.Context
@@ -8362,10 +17484,13 @@ type AzureDevOpsClientUpdatePullRequestFuncCall struct {
 	Arg1 azuredevops.PullRequestCommonArgs
 	// Arg2 is the value of the 3rd argument passed to this method
 	// invocation.
-	Arg2 azuredevops.PullRequestUpdateInput
+	Arg2 string
+	// Arg3 is the value of the 4th argument passed to this method
+	// invocation.
+	Arg3 io.Reader
 	// Result0 is the value of the 1st result returned from this method
 	// invocation.
-	Result0 azuredevops.PullRequest
+	Result0 azuredevops.Attachment
 	// Result1 is the value of the 2nd result returned from this method
 	// invocation.
 	Result1 error
@@ -8373,13 +17498,13 @@ type AzureDevOpsClientUpdatePullRequestFuncCall struct {
 
 // Args returns an interface slice containing the arguments of this
 // invocation.
-func (c AzureDevOpsClientUpdatePullRequestFuncCall) Args() []interface{} {
-	return []interface{}{c.Arg0, c.Arg1, c.Arg2}
+func (c AzureDevOpsClientUploadPullRequestAttachmentFuncCall) Args() []interface{} {
+	return []interface{}{c.Arg0, c.Arg1, c.Arg2, c.Arg3}
 }
 
 // Results returns an interface slice containing the results of this
 // invocation.
-func (c AzureDevOpsClientUpdatePullRequestFuncCall) Results() []interface{} {
+func (c AzureDevOpsClientUploadPullRequestAttachmentFuncCall) Results() []interface{} {
 	return []interface{}{c.Result0, c.Result1}
 }
 
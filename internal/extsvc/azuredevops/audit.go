@@ -0,0 +1,61 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// QueryAuditLog returns all audit log entries of an organization in the given
+// time range, following continuation tokens until all entries were fetched.
+//
+// The audit log is only available on Azure DevOps Services and returns the
+// continuation token in the continuationToken property of the body. With a
+// context created by WithPartialResults, the entries fetched so far are
+// returned along with ErrRateLimited.
+func (c *client) QueryAuditLog(ctx context.Context, input QueryAuditLogInput) ([]AuditLogEntry, error) {
+	queryParams := make(url.Values)
+	setAPIVersion(queryParams, auditLogAPIVersion)
+	if !input.StartTime.IsZero() {
+		queryParams.Set("startTime", input.StartTime.UTC().Format(time.RFC3339))
+	}
+	if !input.EndTime.IsZero() {
+		queryParams.Set("endTime", input.EndTime.UTC().Format(time.RFC3339))
+	}
+	if input.BatchSize > 0 {
+		queryParams.Set("batchSize", strconv.Itoa(input.BatchSize))
+	}
+
+	reqURL := c.resolveHost(ctx, serviceAudit, fmt.Sprintf("%s/_apis/audit/auditlog", input.Org))
+
+	var entries []AuditLogEntry
+	for {
+		reqURL.RawQuery = queryParams.Encode()
+		req, err := http.NewRequest("GET", reqURL.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var result AuditLogQueryResult
+		continuationToken, err := c.doPaginated(ctx, req, "", "continuationToken", &result)
+		if err != nil {
+			if errors.Is(err, ErrRateLimited) {
+				return entries, err
+			}
+			return nil, err
+		}
+		entries = append(entries, result.DecoratedAuditLogEntries...)
+
+		if !result.HasMore || continuationToken == "" {
+			break
+		}
+		queryParams.Set("continuationToken", continuationToken)
+	}
+
+	return entries, nil
+}
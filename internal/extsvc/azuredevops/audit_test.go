@@ -0,0 +1,47 @@
+package azuredevops
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_QueryAuditLog(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/org/_apis/audit/auditlog", r.URL.Path)
+		assert.Equal(t, auditLogAPIVersion, r.URL.Query().Get("api-version"))
+		assert.Equal(t, "2023-01-01T00:00:00Z", r.URL.Query().Get("startTime"))
+
+		result := AuditLogQueryResult{}
+		switch r.URL.Query().Get("continuationToken") {
+		case "":
+			result.DecoratedAuditLogEntries = []AuditLogEntry{{ID: "1", ActionID: "Git.RepositoryCreated"}}
+			result.ContinuationToken = "next"
+			result.HasMore = true
+		case "next":
+			result.DecoratedAuditLogEntries = []AuditLogEntry{{ID: "2", ActionID: "Git.RepositoryDeleted"}}
+		}
+		json.NewEncoder(w).Encode(result)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	entries, err := cli.QueryAuditLog(context.Background(), QueryAuditLogInput{
+		Org:       "org",
+		StartTime: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []AuditLogEntry{
+		{ID: "1", ActionID: "Git.RepositoryCreated"},
+		{ID: "2", ActionID: "Git.RepositoryDeleted"},
+	}, entries)
+}
@@ -0,0 +1,115 @@
+package azuredevops
+
+import (
+	"sync"
+	"time"
+)
+
+// ttlCache is a minimal in-memory cache whose entries expire after a TTL. If
+// maxEntries is greater than zero, the entry closest to expiry is evicted when
+// the cache is full. It is safe for concurrent use.
+type ttlCache[K comparable, V any] struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	entries    map[K]ttlCacheEntry[V]
+
+	// now is replaced in tests.
+	now func() time.Time
+}
+
+type ttlCacheEntry[V any] struct {
+	value   V
+	expires time.Time
+}
+
+func newTTLCache[K comparable, V any](ttl time.Duration, maxEntries int) *ttlCache[K, V] {
+	return &ttlCache[K, V]{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		entries:    make(map[K]ttlCacheEntry[V]),
+		now:        time.Now,
+	}
+}
+
+func (c *ttlCache[K, V]) Get(key K) (v V, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return v, false
+	}
+	if !c.now().Before(e.expires) {
+		delete(c.entries, key)
+		return v, false
+	}
+	return e.value, true
+}
+
+func (c *ttlCache[K, V]) Set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.ttl <= 0 {
+		return
+	}
+
+	now := c.now()
+	if _, ok := c.entries[key]; !ok && c.maxEntries > 0 && len(c.entries) >= c.maxEntries {
+		c.evictLocked(now)
+	}
+	c.entries[key] = ttlCacheEntry[V]{value: value, expires: now.Add(c.ttl)}
+}
+
+func (c *ttlCache[K, V]) Delete(key K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, key)
+}
+
+// DeleteFunc deletes all entries for which del returns true.
+func (c *ttlCache[K, V]) DeleteFunc(del func(K, V) bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for k, e := range c.entries {
+		if del(k, e.value) {
+			delete(c.entries, k)
+		}
+	}
+}
+
+// SetTTL changes the TTL of entries added from now on. A TTL of zero or less
+// disables caching, and drops all existing entries.
+func (c *ttlCache[K, V]) SetTTL(ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.ttl = ttl
+	if ttl <= 0 {
+		c.entries = make(map[K]ttlCacheEntry[V])
+	}
+}
+
+// evictLocked removes all expired entries or, if there are none, the entry
+// closest to expiry.
+func (c *ttlCache[K, V]) evictLocked(now time.Time) {
+	var oldestKey K
+	var oldest time.Time
+	evicted := false
+	for k, e := range c.entries {
+		if !now.Before(e.expires) {
+			delete(c.entries, k)
+			evicted = true
+			continue
+		}
+		if oldest.IsZero() || e.expires.Before(oldest) {
+			oldestKey, oldest = k, e.expires
+		}
+	}
+	if !evicted && !oldest.IsZero() {
+		delete(c.entries, oldestKey)
+	}
+}
@@ -0,0 +1,52 @@
+package azuredevops
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTTLCache(t *testing.T) {
+	now := time.Now()
+	c := newTTLCache[string, int](time.Minute, 2)
+	c.now = func() time.Time { return now }
+
+	c.Set("a", 1)
+	v, ok := c.Get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, v)
+
+	now = now.Add(time.Minute)
+	_, ok = c.Get("a")
+	assert.False(t, ok, "entry expired")
+
+	c.Set("a", 1)
+	now = now.Add(time.Second)
+	c.Set("b", 2)
+	c.Set("c", 3)
+	_, ok = c.Get("a")
+	assert.False(t, ok, "oldest entry evicted")
+	_, ok = c.Get("b")
+	assert.True(t, ok)
+	_, ok = c.Get("c")
+	assert.True(t, ok)
+
+	c.Delete("b")
+	_, ok = c.Get("b")
+	assert.False(t, ok)
+
+	c.Set("b", 2)
+	c.DeleteFunc(func(k string, v int) bool { return v == 2 })
+	_, ok = c.Get("b")
+	assert.False(t, ok)
+	_, ok = c.Get("c")
+	assert.True(t, ok)
+
+	c.SetTTL(0)
+	_, ok = c.Get("c")
+	assert.False(t, ok, "disabling the cache drops entries")
+	c.Set("d", 4)
+	_, ok = c.Get("d")
+	assert.False(t, ok)
+}
@@ -0,0 +1,118 @@
+package azuredevops
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// CachingClient is a Client that memoizes metadata reads which rarely change,
+// but are fetched repeatedly during a sync: GetRepo, GetProject and
+// GetProjectID. Results are cached by their full set of arguments for a TTL.
+// Errors and all other methods, in particular all mutating ones, are passed
+// through to the wrapped client uncached.
+//
+// The cache is bound to the authenticator of the wrapped client: clients
+// returned by WithAuthenticator are not cached.
+type CachingClient struct {
+	Client
+
+	repos      *ttlCache[OrgProjectRepoArgs, Repository]
+	projects   *ttlCache[orgProjectKey, Project]
+	projectIDs *ttlCache[orgProjectKey, string]
+}
+
+type orgProjectKey struct {
+	org, project string
+}
+
+var _ Client = &CachingClient{}
+
+// NewCachingClient returns a CachingClient wrapping inner that caches results
+// for ttl. Each cached method holds at most maxEntries entries, or an
+// unbounded amount if maxEntries is zero.
+func NewCachingClient(inner Client, ttl time.Duration, maxEntries int) *CachingClient {
+	return &CachingClient{
+		Client:     inner,
+		repos:      newTTLCache[OrgProjectRepoArgs, Repository](ttl, maxEntries),
+		projects:   newTTLCache[orgProjectKey, Project](ttl, maxEntries),
+		projectIDs: newTTLCache[orgProjectKey, string](ttl, maxEntries),
+	}
+}
+
+func (c *CachingClient) GetRepo(ctx context.Context, args OrgProjectRepoArgs) (Repository, error) {
+	if repo, ok := c.repos.Get(args); ok {
+		return repo, nil
+	}
+
+	repo, err := c.Client.GetRepo(ctx, args)
+	if err != nil {
+		return Repository{}, err
+	}
+
+	c.repos.Set(args, repo)
+	return repo, nil
+}
+
+func (c *CachingClient) GetProject(ctx context.Context, org, project string) (Project, error) {
+	key := orgProjectKey{org: org, project: project}
+	if p, ok := c.projects.Get(key); ok {
+		return p, nil
+	}
+
+	p, err := c.Client.GetProject(ctx, org, project)
+	if err != nil {
+		return Project{}, err
+	}
+
+	c.projects.Set(key, p)
+	return p, nil
+}
+
+func (c *CachingClient) GetProjectID(ctx context.Context, org, projectName string) (string, error) {
+	key := orgProjectKey{org: org, project: projectName}
+	if id, ok := c.projectIDs.Get(key); ok {
+		return id, nil
+	}
+
+	id, err := c.Client.GetProjectID(ctx, org, projectName)
+	if err != nil {
+		return "", err
+	}
+
+	c.projectIDs.Set(key, id)
+	return id, nil
+}
+
+// UpdateRepository updates the repository and invalidates its cached metadata.
+// GetRepo results are invalidated under every key the repository is cached
+// under, i.e. its ID as well as its old name if it was renamed.
+func (c *CachingClient) UpdateRepository(ctx context.Context, args OrgProjectRepoArgs, input UpdateRepositoryInput) (Repository, error) {
+	cached, _ := c.repos.Get(args)
+	repo, err := c.Client.UpdateRepository(ctx, args, input)
+
+	c.InvalidateRepo(args)
+	c.repos.DeleteFunc(func(k OrgProjectRepoArgs, v Repository) bool {
+		if !strings.EqualFold(k.Org, args.Org) {
+			return false
+		}
+		return (strings.EqualFold(k.Project, args.Project) && strings.EqualFold(k.RepoNameOrID, args.RepoNameOrID)) ||
+			(cached.ID != "" && v.ID == cached.ID) ||
+			(repo.ID != "" && v.ID == repo.ID)
+	})
+	return repo, err
+}
+
+// InvalidateRepo drops the cached result of GetRepo for args, e.g. after
+// receiving a webhook event for the repository.
+func (c *CachingClient) InvalidateRepo(args OrgProjectRepoArgs) {
+	c.repos.Delete(args)
+}
+
+// InvalidateProject drops the cached results of GetProject and GetProjectID
+// for the given project.
+func (c *CachingClient) InvalidateProject(org, project string) {
+	key := orgProjectKey{org: org, project: project}
+	c.projects.Delete(key)
+	c.projectIDs.Delete(key)
+}
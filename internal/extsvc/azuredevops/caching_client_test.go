@@ -0,0 +1,109 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCachingClient(t *testing.T) {
+	requests := map[string]int{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.Method+" "+r.URL.Path]++
+		switch r.URL.Path {
+		case "/org/project/_apis/git/repositories/repo", "/org/project/_apis/git/repositories/other":
+			w.Write([]byte(`{"id": "repo-id", "defaultBranch": "refs/heads/main"}`))
+		case "/org/_apis/projects/project":
+			w.Write([]byte(`{"id": "project-id"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	inner, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+	cli := NewCachingClient(inner, time.Minute, 10)
+
+	ctx := context.Background()
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+
+	for i := 0; i < 3; i++ {
+		repo, err := cli.GetRepo(ctx, args)
+		require.NoError(t, err)
+		assert.Equal(t, "refs/heads/main", repo.DefaultBranch)
+
+		_, err = cli.GetProject(ctx, "org", "project")
+		require.NoError(t, err)
+
+		_, err = cli.GetRepo(ctx, OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "other"})
+		require.NoError(t, err)
+
+		_, err = cli.GetRepo(ctx, OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "missing"})
+		require.Error(t, err)
+	}
+
+	assert.Equal(t, 1, requests["GET /org/project/_apis/git/repositories/repo"])
+	assert.Equal(t, 1, requests["GET /org/project/_apis/git/repositories/other"])
+	assert.Equal(t, 1, requests["GET /org/_apis/projects/project"])
+	assert.Equal(t, 3, requests["GET /org/project/_apis/git/repositories/missing"], "errors are not cached")
+
+	cli.InvalidateRepo(args)
+	_, err = cli.GetRepo(ctx, args)
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests["GET /org/project/_apis/git/repositories/repo"])
+
+	// Mutations go through and invalidate the cache.
+	name := "renamed"
+	_, err = cli.UpdateRepository(ctx, args, UpdateRepositoryInput{Name: &name})
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests["PATCH /org/project/_apis/git/repositories/repo"])
+	_, err = cli.GetRepo(ctx, args)
+	require.NoError(t, err)
+	assert.Equal(t, 3, requests["GET /org/project/_apis/git/repositories/repo"])
+}
+
+func TestCachingClient_UpdateRepository(t *testing.T) {
+	name := "old"
+	requests := map[string]int{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests[r.Method+" "+r.URL.Path]++
+		if r.Method == "PATCH" {
+			name = "new"
+		}
+		fmt.Fprintf(w, `{"id": "repo-id", "name": %q}`, name)
+	}))
+	t.Cleanup(srv.Close)
+
+	inner, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+	cli := NewCachingClient(inner, time.Minute, 10)
+
+	ctx := context.Background()
+	byName := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "old"}
+	byID := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo-id"}
+	for _, args := range []OrgProjectRepoArgs{byName, byID} {
+		_, err := cli.GetRepo(ctx, args)
+		require.NoError(t, err)
+	}
+
+	// Renaming the repository by its ID invalidates it under its old name
+	// too.
+	newName := "new"
+	_, err = cli.UpdateRepository(ctx, byID, UpdateRepositoryInput{Name: &newName})
+	require.NoError(t, err)
+	for _, args := range []OrgProjectRepoArgs{byName, byID} {
+		repo, err := cli.GetRepo(ctx, args)
+		require.NoError(t, err)
+		assert.Equal(t, "new", repo.Name)
+	}
+	assert.Equal(t, 2, requests["GET /org/project/_apis/git/repositories/old"])
+	assert.Equal(t, 2, requests["GET /org/project/_apis/git/repositories/repo-id"])
+}
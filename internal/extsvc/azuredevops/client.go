@@ -3,11 +3,19 @@ package azuredevops
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
+	"mime"
+	"net"
 	"net/http"
 	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/goware/urlx"
 	"github.com/sourcegraph/log"
@@ -17,8 +25,10 @@ import (
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/oauthutil"
 	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/internal/xcontext"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 	"golang.org/x/oauth2"
+	"golang.org/x/sync/singleflight"
 )
 
 const (
@@ -26,31 +36,148 @@ const (
 	ClientAssertionType     = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
 	apiVersion              = "7.0"
 	continuationTokenHeader = "x-ms-continuationtoken"
+	// e2eIDHeader is set on every response to the ID correlating the request
+	// across Azure DevOps services.
+	e2eIDHeader = "x-vss-e2eid"
+)
+
+// Some endpoints only exist under a preview API version, regardless of the
+// base version the client is configured with (see Client.SetAPIVersion).
+// Methods calling them pin the version with setAPIVersion.
+const (
+	// auditLogAPIVersion is required by _apis/audit/auditlog.
+	auditLogAPIVersion = "7.0-preview.1"
+	// mergeBasesAPIVersion is required by _apis/git/repositories/{repo}/commits/{commit}/mergebases.
+	mergeBasesAPIVersion = "7.0-preview.1"
+	// pullRequestPropertiesAPIVersion is required by _apis/git/repositories/{repo}/pullrequests/{id}/properties.
+	pullRequestPropertiesAPIVersion = "7.0-preview.1"
+	// pullRequestAttachmentsAPIVersion is required by _apis/git/repositories/{repo}/pullrequests/{id}/attachments.
+	pullRequestAttachmentsAPIVersion = "7.0-preview.1"
+	// resourceAreasAPIVersion is required by _apis/resourceAreas.
+	resourceAreasAPIVersion = "7.0-preview.1"
+	// policyEvaluationsAPIVersion is required by _apis/policy/evaluations.
+	policyEvaluationsAPIVersion = "7.0-preview.1"
+)
+
+// Azure DevOps services that are served from their own host on Azure DevOps
+// Services, e.g. https://vssps.dev.azure.com. The hosts are discovered through
+// the resource areas of the instance, these are the subdomains used if that
+// fails. See resolveHost.
+const (
+	serviceVSSPS     = "vssps"
+	serviceAlmSearch = "almsearch"
+	serviceVSAEX     = "vsaex"
+	serviceAudit     = "auditservice"
 )
 
 // Client used to access an AzureDevOps code host via the REST API.
+//
+// A Client is safe for concurrent use by multiple goroutines, including with an
+// authenticator that refreshes itself. The Set* methods configure the client
+// and must not be called concurrently with requests, i.e. they should be called
+// before the client is shared.
 type Client interface {
 	WithAuthenticator(a auth.Authenticator) (Client, error)
 	Authenticator() auth.Authenticator
 	GetURL() *url.URL
 	IsAzureDevOpsServices() bool
 	AbandonPullRequest(ctx context.Context, args PullRequestCommonArgs) (PullRequest, error)
+	ReactivatePullRequest(ctx context.Context, args PullRequestCommonArgs) (PullRequest, error)
 	CreatePullRequest(ctx context.Context, args OrgProjectRepoArgs, input CreatePullRequestInput) (PullRequest, error)
-	GetPullRequest(ctx context.Context, args PullRequestCommonArgs) (PullRequest, error)
+	GetPullRequest(ctx context.Context, args PullRequestCommonArgs, opts GetPullRequestOptions) (PullRequest, error)
+	GetPullRequestsBatch(ctx context.Context, args OrgProjectRepoArgs, pullRequestIDs []string, opts GetPullRequestsBatchOptions) (GetPullRequestsBatchResult, error)
+	ListPullRequests(ctx context.Context, args OrgProjectRepoArgs, criteria PullRequestSearchCriteria) ([]PullRequest, error)
+	GetPullRequestByRefs(ctx context.Context, args OrgProjectRepoArgs, sourceRef, targetRef string) (PullRequest, error)
 	GetPullRequestStatuses(ctx context.Context, args PullRequestCommonArgs) ([]PullRequestBuildStatus, error)
 	UpdatePullRequest(ctx context.Context, args PullRequestCommonArgs, input PullRequestUpdateInput) (PullRequest, error)
+	SetPullRequestDraft(ctx context.Context, args PullRequestCommonArgs, draft bool) (PullRequest, error)
+	SetPullRequestAutoComplete(ctx context.Context, args PullRequestCommonArgs, input PullRequestAutoCompleteInput) (PullRequest, error)
 	CreatePullRequestCommentThread(ctx context.Context, args PullRequestCommonArgs, input PullRequestCommentInput) (PullRequestCommentResponse, error)
+	ListPullRequestReviewers(ctx context.Context, args PullRequestCommonArgs, opts ListPullRequestReviewersOptions) ([]Reviewer, error)
+	PullRequestApprovalState(ctx context.Context, args PullRequestCommonArgs) (ApprovalState, error)
+	RemovePullRequestReviewer(ctx context.Context, args PullRequestCommonArgs, reviewerID string) error
+	UploadPullRequestAttachment(ctx context.Context, args PullRequestCommonArgs, fileName string, content io.Reader) (Attachment, error)
+	GetPullRequestProperties(ctx context.Context, args PullRequestCommonArgs) (map[string]PropertyValue, error)
+	SetPullRequestProperties(ctx context.Context, args PullRequestCommonArgs, ops []JSONPatchOperation) (map[string]PropertyValue, error)
+	ListPullRequestThreads(ctx context.Context, args PullRequestCommonArgs, opts ListPullRequestThreadsOptions) ([]PullRequestCommentResponse, error)
+	ListPullRequestThreadsUpdatedSince(ctx context.Context, args PullRequestCommonArgs, since time.Time, opts ListPullRequestThreadsOptions) (threads []PullRequestCommentResponse, cursor time.Time, err error)
+	ListPullRequestInlineComments(ctx context.Context, args PullRequestCommonArgs) ([]InlineComment, error)
+	ListPullRequestCommentsUpdatedSince(ctx context.Context, args PullRequestCommonArgs, since time.Time) (comments []InlineComment, cursor time.Time, err error)
+	ListCommentLikes(ctx context.Context, args PullRequestCommentArgs) ([]CreatorInfo, error)
+	LikeComment(ctx context.Context, args PullRequestCommentArgs) error
+	UnlikeComment(ctx context.Context, args PullRequestCommentArgs) error
 	CompletePullRequest(ctx context.Context, args PullRequestCommonArgs, input PullRequestCompleteInput) (PullRequest, error)
+	GetItem(ctx context.Context, args OrgProjectRepoArgs, path string, version *GitVersionDescriptor) (Item, error)
+	StatItem(ctx context.Context, args OrgProjectRepoArgs, path string, version *GitVersionDescriptor) (Item, error)
+	ListItems(ctx context.Context, args OrgProjectRepoArgs, opts ListItemsOptions) ([]Item, error)
+	GetReadme(ctx context.Context, args OrgProjectRepoArgs) ([]byte, string, error)
+	DownloadRepositoryZip(ctx context.Context, args OrgProjectRepoArgs, version *GitVersionDescriptor) (io.ReadCloser, error)
+	GetCommit(ctx context.Context, args OrgProjectRepoArgs, commitID string) (Commit, error)
+	GetCommitsBatch(ctx context.Context, args OrgProjectRepoArgs, shas []string) ([]Commit, error)
+	GetMergeBase(ctx context.Context, args OrgProjectRepoArgs, commitA, commitB string) (string, error)
+	GetMergeBases(ctx context.Context, args OrgProjectRepoArgs, commitA, commitB string) ([]string, error)
+	ListCommits(ctx context.Context, args OrgProjectRepoArgs, criteria ListCommitsCriteria) ([]Commit, error)
+	ListCommitsByProject(ctx context.Context, org, project string, opts ListCommitsByProjectOptions) ([]ProjectCommit, error)
+	QueryCommitsBatch(ctx context.Context, args OrgProjectRepoArgs, criteria QueryCommitsCriteria) ([]Commit, error)
+	GetPushChanges(ctx context.Context, args OrgProjectRepoArgs, pushID int) ([]Change, error)
 	GetRepo(ctx context.Context, args OrgProjectRepoArgs) (Repository, error)
+	GetRepositorySize(ctx context.Context, args OrgProjectRepoArgs) (size int64, exact bool, err error)
+	FindRepositoryByName(ctx context.Context, org, repoName string) (Repository, error)
+	ListRepositoriesPage(ctx context.Context, args ListRepositoriesByProjectOrOrgArgs) (RepositoriesPage, error)
+	// Deprecated: Use ListRepositoriesPage.
 	ListRepositoriesByProjectOrOrg(ctx context.Context, args ListRepositoriesByProjectOrOrgArgs) ([]Repository, error)
+	ListRepositoriesByProjectsOrOrgs(ctx context.Context, projectsOrOrgs []string) (ListRepositoriesResult, error)
+	DiffRepositories(ctx context.Context, args ListRepositoriesByProjectOrOrgArgs, knownRepoIDs []string) (added, removed []string, err error)
+	UpdateRepository(ctx context.Context, args OrgProjectRepoArgs, input UpdateRepositoryInput) (Repository, error)
 	ForkRepository(ctx context.Context, org string, input ForkRepositoryInput) (Repository, error)
+	DeleteBranch(ctx context.Context, args OrgProjectRepoArgs, branchName string) error
+	UpdateRefs(ctx context.Context, args OrgProjectRepoArgs, updates []RefUpdate) ([]RefUpdateResult, error)
+	ListRefs(ctx context.Context, args OrgProjectRepoArgs, opts ListRefsOptions) ([]Ref, error)
+	ListBranchesWithStats(ctx context.Context, args OrgProjectRepoArgs, opts ListBranchesWithStatsOptions) ([]BranchStat, error)
 	GetRepositoryBranch(ctx context.Context, args OrgProjectRepoArgs, branchName string) (Ref, error)
+	ListBranchPolicies(ctx context.Context, args OrgProjectRepoArgs, refName string) ([]PolicyConfiguration, error)
+	ListDefaultReviewers(ctx context.Context, args OrgProjectRepoArgs) ([]RequiredReviewer, error)
+	ListPolicyEvaluations(ctx context.Context, args PullRequestCommonArgs) ([]PolicyEvaluation, error)
+	CreateOrUpdateRequiredReviewersPolicy(ctx context.Context, org, project string, input RequiredReviewersPolicyInput) (PolicyConfiguration, error)
+	ListPipelines(ctx context.Context, org, project string, opts ListPipelinesOptions) ([]Pipeline, error)
+	RunPipeline(ctx context.Context, org, project string, input RunPipelineInput) (PipelineRun, error)
+	GetPipelineRun(ctx context.Context, org, project string, pipelineID, runID int) (PipelineRun, error)
+	ListBuildDefinitions(ctx context.Context, org, project string, opts ListBuildDefinitionsOptions) ([]BuildDefinition, error)
+	ListBuildArtifacts(ctx context.Context, org, project string, buildID int) ([]BuildArtifact, error)
+	DownloadBuildArtifact(ctx context.Context, artifact BuildArtifact) (io.ReadCloser, error)
 	GetProject(ctx context.Context, org, project string) (Project, error)
+	GetProjectID(ctx context.Context, org, projectName string) (string, error)
+	GetResourceAreas(ctx context.Context) (map[string]string, error)
+	GetSecurityNamespaceID(ctx context.Context, org, name string) (string, error)
+	ListAccessibleRepositories(ctx context.Context, org, subjectDescriptor string, opts ListAccessibleRepositoriesOptions) ([]Repository, error)
 	GetAuthorizedProfile(ctx context.Context) (Profile, error)
 	ListAuthorizedUserOrganizations(ctx context.Context, profile Profile) ([]Org, error)
+	ListAccessibleOrgs(ctx context.Context) ([]Org, error)
+	InspectCredentials(ctx context.Context, org string) (CredentialsInfo, error)
+	GetTokenInfo(ctx context.Context) (*TokenInfo, error)
+	ResolveCommitAuthors(ctx context.Context, org string, emails []string) (map[string]User, error)
+	QueryAuditLog(ctx context.Context, input QueryAuditLogInput) ([]AuditLogEntry, error)
+	EnsureSubscription(ctx context.Context, input EnsureSubscriptionInput) (*Subscription, error)
+	DoRaw(ctx context.Context, req *http.Request, result any) (*http.Response, error)
 	SetWaitForRateLimit(wait bool)
+	SetAPIVersion(version string)
+	SetCaptureRawJSON(capture bool)
+	SetStrictDecode(strict bool)
+	SetJobIDMetricLabel(enabled bool)
+	SetProbeNotFound(probe bool)
+	SetFollowRedirects(follow bool)
+	SetProjectIDCacheTTL(ttl time.Duration)
+	Close() error
 }
 
+// ErrClientClosed is returned by all requests made with a Client after Close
+// has been called on it.
+var ErrClientClosed = errors.New("azuredevops: client is closed")
+
+// ErrDryRunNotSupported is returned by mutating methods whose input has DryRun
+// set if Azure DevOps can't validate the mutation without performing it.
+var ErrDryRunNotSupported = errors.New("azuredevops: dry run is not supported by this endpoint")
+
 type client struct {
 	// HTTP Client used to communicate with the API.
 	httpClient httpcli.Doer
@@ -61,34 +188,117 @@ type client struct {
 	urn string
 
 	internalRateLimiter *ratelimit.InstrumentedLimiter
-	externalRateLimiter *ratelimit.Monitor
-	auth                auth.Authenticator
-	waitForRateLimit    bool
-	maxRateLimitRetries int
+	// interactiveRateLimiter is used instead of internalRateLimiter for
+	// requests made with WithInteractive.
+	interactiveRateLimiter *ratelimit.InstrumentedLimiter
+	externalRateLimiter    *ratelimit.Monitor
+	auth                   auth.Authenticator
+	waitForRateLimit       bool
+	maxRateLimitRetries    int
+
+	// requestAuth authenticates requests. It is auth, synchronized with authMu
+	// if auth refreshes itself in place.
+	requestAuth auth.Authenticator
+	authMu      *sync.Mutex
+
+	// apiVersion is the api-version sent with all requests that don't pin a
+	// version themselves.
+	apiVersion string
+
+	// captureRawJSON, if true, retains the raw response body on result types
+	// that support it. Off by default to avoid holding on to large buffers.
+	captureRawJSON bool
+
+	// strictDecode, if true, fails decoding responses with fields the result
+	// type doesn't model.
+	strictDecode bool
+
+	// jobIDMetricLabel, if true, adds the job ID set with WithJobID as a label
+	// to request metrics.
+	jobIDMetricLabel bool
+
+	// probeNotFound, if true, makes additional requests after a 404 to
+	// figure out whether the resource doesn't exist or isn't accessible.
+	probeNotFound bool
+
+	// followRedirects is true by default. If false, noRedirectHTTPClient is
+	// used instead of httpClient if it could be constructed.
+	followRedirects      bool
+	noRedirectHTTPClient httpcli.Doer
+	defaultHTTPClient    bool
+
+	// proxyURL is the proxy set with NewClientWithProxy, if any.
+	proxyURL *url.URL
+
+	// logger logs requests, with the job ID of their context if any.
+	logger log.Logger
+
+	// projectIDs caches project IDs by "org/project".
+	projectIDs *ttlCache[string, string]
+	// identityNames caches display names of identities by "org/id".
+	identityNames *ttlCache[string, string]
+	// usersByEmail caches users by "org/email", with the email lower-cased.
+	usersByEmail *ttlCache[string, User]
+	// resourceAreas caches the result of GetResourceAreas under "".
+	resourceAreas *ttlCache[string, map[string]string]
+	// resourceAreasErr caches the error of GetResourceAreas under "", so that
+	// instances not serving resource areas aren't asked for every request.
+	resourceAreasErr *ttlCache[string, error]
+	// securityNamespaces caches the security namespace IDs of each
+	// organization by lower-cased name.
+	securityNamespaces *ttlCache[string, map[string]string]
+
+	// throttledUntil is the time in Unix nanoseconds until which requests are
+	// held back, because Azure DevOps signalled that it throttles the client.
+	throttledUntil atomic.Int64
+	now            func() time.Time
+
+	closed atomic.Bool
 }
 
 // NewClient returns an authenticated AzureDevOps API client with
 // the provided configuration. If a nil httpClient is provided, http.DefaultClient
 // will be used.
+//
+// The url must be absolute. It is normalized to end with a slash, so that API
+// paths are resolved below it, e.g. below the collection of an Azure DevOps
+// Server instance.
 func NewClient(urn string, url string, auth auth.Authenticator, httpClient httpcli.Doer) (Client, error) {
-	u, err := urlx.Parse(url)
+	u, err := parseBaseURL(url)
 	if err != nil {
 		return nil, err
 	}
 
-	if httpClient == nil {
+	defaultHTTPClient := httpClient == nil
+	if defaultHTTPClient {
 		httpClient = httpcli.ExternalDoer
 	}
 
+	authMu := &sync.Mutex{}
+
 	return &client{
-		httpClient:          httpClient,
-		URL:                 u,
-		internalRateLimiter: ratelimit.DefaultRegistry.Get(urn),
-		externalRateLimiter: ratelimit.DefaultMonitorRegistry.GetOrSet(url, auth.Hash(), "rest", &ratelimit.Monitor{HeaderPrefix: "X-"}),
-		auth:                auth,
-		urn:                 urn,
-		waitForRateLimit:    true,
-		maxRateLimitRetries: 2,
+		httpClient:             httpClient,
+		URL:                    u,
+		internalRateLimiter:    ratelimit.DefaultRegistry.Get(urn),
+		interactiveRateLimiter: interactiveRateLimiter(urn),
+		externalRateLimiter:    ratelimit.DefaultMonitorRegistry.GetOrSet(url, auth.Hash(), "rest", &ratelimit.Monitor{HeaderPrefix: "X-"}),
+		auth:                   auth,
+		requestAuth:            synchronizeAuthenticator(auth, authMu),
+		authMu:                 authMu,
+		urn:                    urn,
+		waitForRateLimit:       true,
+		maxRateLimitRetries:    2,
+		apiVersion:             apiVersion,
+		followRedirects:        true,
+		defaultHTTPClient:      defaultHTTPClient,
+		projectIDs:             newTTLCache[string, string](defaultProjectIDCacheTTL, 0),
+		identityNames:          newTTLCache[string, string](defaultIdentityCacheTTL, 0),
+		usersByEmail:           newTTLCache[string, User](defaultIdentityCacheTTL, 0),
+		resourceAreas:          newTTLCache[string, map[string]string](defaultResourceAreasCacheTTL, 0),
+		resourceAreasErr:       newTTLCache[string, error](defaultResourceAreasCacheTTL, 0),
+		securityNamespaces:     newTTLCache[string, map[string]string](defaultSecurityNamespacesCacheTTL, maxSecurityNamespacesCacheEntries),
+		logger:                 log.Scoped("azuredevops.Client", "azuredevops Client logger"),
+		now:                    time.Now,
 	}, nil
 }
 
@@ -96,49 +306,268 @@ func NewClient(urn string, url string, auth auth.Authenticator, httpClient httpc
 //
 //nolint:unparam // http.Response is never used, but it makes sense API wise.
 func (c *client) do(ctx context.Context, req *http.Request, urlOverride string, result any) (continuationToken string, err error) {
+	return c.doPaginated(ctx, req, urlOverride, "", result)
+}
+
+// doPaginated is like do, but for endpoints that may return the continuation
+// token in a top-level property of the response body named tokenField instead
+// of the x-ms-continuationtoken header. The header takes precedence, so an
+// endpoint that moves its token between the two doesn't silently truncate the
+// results.
+//
+// Where not documented otherwise on the method, endpoints return the token in
+// the header and use do.
+func (c *client) doPaginated(ctx context.Context, req *http.Request, urlOverride, tokenField string, result any) (continuationToken string, err error) {
+	resp, bs, err := c.doResponse(ctx, req, urlOverride, result)
+	if err != nil {
+		return "", err
+	}
+
+	continuationToken = resp.Header.Get(continuationTokenHeader)
+	if continuationToken == "" && tokenField != "" && len(bs) > 0 {
+		if continuationToken, err = bodyContinuationToken(bs, tokenField); err != nil {
+			return "", err
+		}
+	}
+
+	return continuationToken, nil
+}
+
+// DoRaw sends req through the same pipeline as the typed methods, i.e. with
+// authentication, rate limiting, retries, tracing and error handling, and
+// decodes the JSON response into result unless it is nil. It is an escape
+// hatch for endpoints that don't have a method yet.
+//
+// As for all requests, a relative req.URL is resolved against the base URL of
+// the client, and the default api-version is set unless req.URL has one. The
+// returned response has been read in full already; its body holds a copy that
+// doesn't need to be closed. Unsuccessful responses are returned as an
+// *HTTPError like for other methods.
+//
+// Prefer adding a method for endpoints that are used in more than one place,
+// since DoRaw leaves modelling the request and response to the caller.
+func (c *client) DoRaw(ctx context.Context, req *http.Request, result any) (*http.Response, error) {
+	resp, _, err := c.doResponse(ctx, req, "", result)
+	return resp, err
+}
+
+// doResponse sends req, checks the response and decodes it into result unless
+// the response has no body or result is nil. It returns the response, whose
+// body is replaced by a reader of the returned bytes.
+func (c *client) doResponse(ctx context.Context, req *http.Request, urlOverride string, result any) (*http.Response, []byte, error) {
+	resp, err := c.send(ctx, req, urlOverride)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	bs, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(bs))
+
+	if err := c.checkResponse(ctx, req, resp, bs); err != nil {
+		return nil, nil, err
+	}
+
+	// Deletes and some updates don't return a body, in which case we leave result
+	// untouched.
+	if resp.StatusCode == http.StatusNoContent || len(bs) == 0 || result == nil {
+		return resp, bs, nil
+	}
+
+	// Azure DevOps always responds with JSON. Content-inspecting proxies may
+	// respond in its place, e.g. with a 203 or an HTML login page, in which
+	// case we point at the proxy rather than return a bare decoding error.
+	contentType := resp.Header.Get("Content-Type")
+	if resp.StatusCode == http.StatusNonAuthoritativeInfo && !isJSONContentType(contentType) {
+		return nil, nil, &ProxyInterferenceError{URL: req.URL, StatusCode: resp.StatusCode, ContentType: contentType}
+	}
+	if err := c.decode(bs, result); err != nil {
+		if resp.StatusCode == http.StatusNonAuthoritativeInfo || !isJSONContentType(contentType) {
+			return nil, nil, &ProxyInterferenceError{URL: req.URL, StatusCode: resp.StatusCode, ContentType: contentType, Err: err}
+		}
+		return nil, nil, err
+	}
+
+	if c.captureRawJSON {
+		if r, ok := result.(rawJSONCapturer); ok {
+			if err := r.setRawJSON(bs); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	return resp, bs, nil
+}
+
+// decode unmarshals the response body bs into result. encoding/json matches
+// field names case-insensitively and ignores unknown fields, which tolerates
+// the differences in responses between Azure DevOps versions. With
+// SetStrictDecode unknown fields are an error instead.
+func (c *client) decode(bs []byte, result any) error {
+	if !c.strictDecode {
+		return json.Unmarshal(bs, result)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(bs))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(result); err != nil {
+		return errors.Wrapf(err, "strictly decoding %T", result)
+	}
+	return nil
+}
+
+// isJSONContentType reports whether contentType is JSON or unset, and so
+// could be a response of Azure DevOps.
+func isJSONContentType(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// bodyContinuationToken returns the string value of the top-level property
+// tokenField of the JSON object bs, or an empty string if it's absent or null.
+func bodyContinuationToken(bs []byte, tokenField string) (string, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bs, &fields); err != nil {
+		return "", errors.Wrap(err, "decoding continuation token")
+	}
+
+	raw, ok := fields[tokenField]
+	if !ok {
+		return "", nil
+	}
+
+	var token *string
+	if err := json.Unmarshal(raw, &token); err != nil {
+		return "", errors.Wrapf(err, "decoding continuation token %q", tokenField)
+	}
+	if token == nil {
+		return "", nil
+	}
+	return *token, nil
+}
+
+// doStream is like do, but returns the response of a successful request for
+// the caller to read the body of instead of decoding it. The caller must close
+// the body.
+func (c *client) doStream(ctx context.Context, req *http.Request, urlOverride string) (*http.Response, error) {
+	resp, err := c.send(ctx, req, urlOverride)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		bs, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return nil, c.checkResponse(ctx, req, resp, bs)
+	}
+
+	return resp, nil
+}
+
+// send sends the request once the rate limits allow it, retrying if we got
+// rate limited nonetheless. The caller must close the body of the response.
+func (c *client) send(ctx context.Context, req *http.Request, urlOverride string) (resp *http.Response, err error) {
+	if c.closed.Load() {
+		return nil, ErrClientClosed
+	}
+
 	u := c.URL
 	if urlOverride != "" {
 		u, err = url.Parse(urlOverride)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 	}
 
 	queryParams := req.URL.Query()
-	queryParams.Set("api-version", apiVersion)
+	// Some endpoints are only available under a preview version, in which case
+	// the caller sets the version explicitly. A version set for the call with
+	// WithAPIVersion overrides both.
+	if v := apiVersionOverride(ctx); v != "" {
+		queryParams.Set("api-version", v)
+	} else if queryParams.Get("api-version") == "" {
+		queryParams.Set("api-version", c.apiVersion)
+	}
 	req.URL.RawQuery = queryParams.Encode()
 	req.URL = u.ResolveReference(req.URL)
 
 	var reqBody []byte
 	if req.Body != nil {
-		req.Header.Set("Content-Type", "application/json")
+		// Some endpoints expect a different content type, e.g. JSON-Patch.
+		if req.Header.Get("Content-Type") == "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
 		reqBody, err = io.ReadAll(req.Body)
 		if err != nil {
-			return "", err
+			return nil, err
 		}
 	}
 	req.Body = io.NopCloser(bytes.NewReader(reqBody))
 
+	// List responses compress well. We ask for gzip explicitly rather than
+	// rely on the transport doing so, as not every Doer is an http.Transport,
+	// and decompress the response ourselves in gunzipResponse.
+	if req.Header.Get("Accept-Encoding") == "" {
+		req.Header.Set("Accept-Encoding", "gzip")
+	}
+
+	for name, values := range requestHeaders(ctx) {
+		if http.CanonicalHeaderKey(name) == "Authorization" {
+			continue
+		}
+		for _, v := range values {
+			req.Header.Add(name, v)
+		}
+	}
+
 	// Add authentication headers for authenticated requests.
-	if err := c.auth.Authenticate(req); err != nil {
-		return "", err
+	if err := c.requestAuth.Authenticate(req); err != nil {
+		return nil, err
 	}
 
-	if err := c.internalRateLimiter.Wait(ctx); err != nil {
-		return "", err
+	if err := c.observedWaitForRateLimits(ctx); err != nil {
+		return nil, err
 	}
 
-	if c.waitForRateLimit {
-		_ = c.externalRateLimiter.WaitForRateLimit(ctx, 1)
+	logger := c.logger
+	if id := jobID(ctx); id != "" {
+		logger = logger.With(log.String("jobID", id))
+	}
+	httpClient := c.httpClient
+	if !c.followRedirects && c.noRedirectHTTPClient != nil {
+		httpClient = c.noRedirectHTTPClient
 	}
 
-	logger := log.Scoped("azuredevops.Client", "azuredevops Client logger")
-	resp, err := oauthutil.DoRequest(ctx, logger, c.httpClient, req, c.auth)
+	resp, err = c.observedDoRequest(ctx, logger, httpClient, req)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	// A 401 is retried with a refreshed token. If the refresh token was
+	// rejected, that is the error to report rather than the 401.
+	if resp.StatusCode == http.StatusUnauthorized {
+		if err := refreshError(c.requestAuth); err != nil {
+			resp.Body.Close()
+			return nil, err
+		}
+	}
+	if err := gunzipResponse(resp); err != nil {
+		return nil, err
 	}
 
 	c.externalRateLimiter.Update(resp.Header)
+	c.observeResponse(ctx, resp)
 
 	numRetries := 0
 	for c.waitForRateLimit && resp.StatusCode == http.StatusTooManyRequests &&
@@ -146,28 +575,174 @@ func (c *client) do(ctx context.Context, req *http.Request, urlOverride string,
 		// We always retry since we got a StatusTooManyRequests. This is safe
 		// since we bound retries by maxRateLimitRetries.
 		_ = c.externalRateLimiter.WaitForRateLimit(ctx, 1)
+		if ctx.Err() != nil {
+			return nil, retryAborted(ctx, req, resp)
+		}
 
+		resp.Body.Close()
 		req.Body = io.NopCloser(bytes.NewReader(reqBody))
-		resp, err = oauthutil.DoRequest(ctx, logger, c.httpClient, req, c.auth)
+		resp, err = c.observedDoRequest(ctx, logger, httpClient, req)
+		if err != nil {
+			return nil, err
+		}
+		if err := gunzipResponse(resp); err != nil {
+			return nil, err
+		}
+		c.externalRateLimiter.Update(resp.Header)
+		c.observeResponse(ctx, resp)
 		numRetries++
 	}
 
-	defer resp.Body.Close()
+	return resp, nil
+}
 
-	bs, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return "", err
+// gunzipResponse replaces the body of resp with its decompressed contents if
+// it is gzip encoded. The transport leaves such bodies as is, since we set
+// Accept-Encoding ourselves.
+func gunzipResponse(resp *http.Response) error {
+	if !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return nil
 	}
 
-	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
-		return "", &HTTPError{
+	zr, err := gzip.NewReader(resp.Body)
+	if err == io.EOF {
+		// An empty body, e.g. of a 204, isn't valid gzip but nothing to
+		// decompress either.
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(nil))
+	} else if err != nil {
+		resp.Body.Close()
+		return errors.Wrap(err, "decompressing response")
+	} else {
+		resp.Body = &gzipReadCloser{Reader: zr, body: resp.Body}
+	}
+
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
+
+// gzipReadCloser reads the decompressed body and closes the underlying one.
+type gzipReadCloser struct {
+	*gzip.Reader
+	body io.ReadCloser
+}
+
+func (r *gzipReadCloser) Close() error {
+	r.Reader.Close()
+	return r.body.Close()
+}
+
+// checkResponse returns an error if resp, whose body is bs, was not
+// successful.
+func (c *client) checkResponse(ctx context.Context, req *http.Request, resp *http.Response, bs []byte) error {
+	// Redirects are only returned if the client is configured to not follow
+	// them, in which case we treat them as errors too.
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		httpErr := &HTTPError{
+			Method:     req.Method,
 			URL:        req.URL,
 			StatusCode: resp.StatusCode,
 			Body:       bs,
+			E2EID:      resp.Header.Get(e2eIDHeader),
+		}
+		if resp.StatusCode == http.StatusNotFound && c.probeNotFound && ctx.Value(notFoundProbeKey{}) == nil {
+			return c.classifyNotFound(ctx, req.URL, httpErr)
+		}
+		if resp.StatusCode == http.StatusPreconditionFailed {
+			return &PreconditionFailedError{Err: httpErr}
 		}
+		if resp.StatusCode == http.StatusForbidden {
+			if scope := requiredScope(req.Method, req.URL.Path); scope != "" {
+				return &MissingScopeError{Scope: scope, Err: httpErr}
+			}
+		}
+		return httpErr
+	}
+	return nil
+}
+
+// NewClientWithProxy is like NewClient, but if httpClient is nil and proxyURL
+// is not empty, requests are sent through the HTTP(S) or SOCKS5 proxy at
+// proxyURL rather than the globally configured one.
+//
+// An explicit httpClient takes precedence: it is used as is and proxyURL is
+// ignored, since the Doer is expected to carry its own transport
+// configuration.
+func NewClientWithProxy(urn string, url string, proxyURL string, auth auth.Authenticator, httpClient httpcli.Doer) (Client, error) {
+	if httpClient != nil || proxyURL == "" {
+		return NewClient(urn, url, auth, httpClient)
+	}
+
+	proxy, err := parseProxyURL(proxyURL)
+	if err != nil {
+		return nil, err
 	}
 
-	return resp.Header.Get(continuationTokenHeader), json.Unmarshal(bs, result)
+	doer, err := httpcli.ExternalClientFactory.Doer(proxyOpt(proxy))
+	if err != nil {
+		return nil, err
+	}
+
+	cli, err := NewClient(urn, url, auth, doer)
+	if err != nil {
+		return nil, err
+	}
+	c := cli.(*client)
+	c.proxyURL = proxy
+	// The client is still built from the external client factory, so clients
+	// derived from it (see SetFollowRedirects) can be built the same way.
+	c.defaultHTTPClient = true
+	return c, nil
+}
+
+func parseProxyURL(rawURL string) (*url.URL, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid proxy URL")
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, errors.Newf("invalid proxy URL %q: scheme must be http, https or socks5", rawURL)
+	}
+	if u.Host == "" {
+		return nil, errors.Newf("invalid proxy URL %q: missing host", rawURL)
+	}
+	return u, nil
+}
+
+// proxyOpt returns an httpcli.Opt that sends requests through proxy. It must be
+// applied before the common options of the factory, which wrap the transport.
+func proxyOpt(proxy *url.URL) httpcli.Opt {
+	return func(cli *http.Client) error {
+		tr := http.DefaultTransport.(*http.Transport).Clone()
+		tr.Proxy = http.ProxyURL(proxy)
+		cli.Transport = tr
+		return nil
+	}
+}
+
+// parseBaseURL parses and normalizes the base URL of a client.
+func parseBaseURL(rawURL string) (*url.URL, error) {
+	u, err := urlx.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	// urlx defaults to http if the scheme is missing, so we check the input
+	// too to not silently downgrade e.g. "dev.azure.com".
+	if !strings.Contains(rawURL, "://") || u.Host == "" {
+		return nil, errors.Newf("invalid Azure DevOps URL %q: must be absolute", rawURL)
+	}
+	if !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+		if u.RawPath != "" {
+			u.RawPath += "/"
+		}
+	}
+	return u, nil
 }
 
 // WithAuthenticator returns a new Client that uses the same configuration,
@@ -185,13 +760,327 @@ func (c *client) WithAuthenticator(a auth.Authenticator) (Client, error) {
 		return nil, errors.Errorf("authenticator type unsupported for Azure DevOps clients: %s", a)
 	}
 
-	return NewClient(c.urn, c.URL.String(), a, c.httpClient)
+	cli, err := NewClient(c.urn, c.URL.String(), a, c.httpClient)
+	if err != nil {
+		return nil, err
+	}
+	nc := cli.(*client)
+	nc.apiVersion = c.apiVersion
+	nc.captureRawJSON = c.captureRawJSON
+	nc.strictDecode = c.strictDecode
+	nc.jobIDMetricLabel = c.jobIDMetricLabel
+	nc.probeNotFound = c.probeNotFound
+	nc.followRedirects = c.followRedirects
+	nc.noRedirectHTTPClient = c.noRedirectHTTPClient
+	nc.defaultHTTPClient = c.defaultHTTPClient
+	nc.proxyURL = c.proxyURL
+	nc.logger = c.logger
+
+	return nc, nil
 }
 
 func (c *client) SetWaitForRateLimit(wait bool) {
 	c.waitForRateLimit = wait
 }
 
+// SetAPIVersion configures the api-version sent with requests, which defaults
+// to 7.0. Endpoints that are only available as a preview always use their
+// preview version. See RequestOptions and WithAPIVersion to set the version of
+// a single call.
+func (c *client) SetAPIVersion(version string) {
+	if version == "" {
+		version = apiVersion
+	}
+	c.apiVersion = version
+}
+
+// setAPIVersion pins the api-version of a request to version, overriding the
+// version the client is configured with.
+func setAPIVersion(queryParams url.Values, version string) {
+	queryParams.Set("api-version", version)
+}
+
+// SetCaptureRawJSON configures whether the raw JSON of responses is retained on
+// the returned models (see the RawJSON fields on Repository, PullRequest and
+// Project). This is an escape hatch for callers that need fields we don't model
+// yet.
+func (c *client) SetCaptureRawJSON(capture bool) {
+	c.captureRawJSON = capture
+}
+
+// SetStrictDecode configures whether decoding a response fails if it has fields
+// the result type doesn't model. It is meant for tests, to catch responses
+// drifting from our models: in production the client stays lenient, since Azure
+// DevOps Server versions return different sets of fields and failing on those
+// would break syncs for no benefit. Fields of models with custom decoding, such
+// as PullRequest, and streamed responses are always decoded leniently.
+func (c *client) SetStrictDecode(strict bool) {
+	c.strictDecode = strict
+}
+
+// SetJobIDMetricLabel configures whether the job ID set with WithJobID is added
+// as the job label to request metrics. It is off by default, as every job ID
+// creates new time series: only enable it if job IDs come from a small set.
+func (c *client) SetJobIDMetricLabel(enabled bool) {
+	c.jobIDMetricLabel = enabled
+}
+
+// Close releases any resources held by the client. The client must not be used
+// after Close has been called: all further requests return ErrClientClosed.
+// Clients derived with WithAuthenticator are not affected. Calling Close more
+// than once is a no-op.
+func (c *client) Close() error {
+	c.closed.Store(true)
+	return nil
+}
+
+// SetProbeNotFound configures whether the client tries to distinguish between
+// resources that don't exist and resources the authenticated user can't access
+// when it receives a 404. Azure DevOps uses 404 for both, so when enabled the
+// client probes the enclosing repository, project and organization and returns
+// a *NotFoundError or *ForbiddenError instead of the plain *HTTPError. For
+// requests below a repository, e.g. of a commit, this tells whether the commit
+// or the repository is missing, and whether the repository was deleted.
+//
+// This costs up to three additional requests per 404, so it should not be
+// enabled in hot paths.
+func (c *client) SetProbeNotFound(probe bool) {
+	c.probeNotFound = probe
+}
+
+// SetFollowRedirects configures whether the client follows redirects, which it
+// does by default. If disabled, 3xx responses are returned as an *HTTPError.
+// This surfaces proxies and on-prem instances that redirect unauthenticated
+// requests to a sign-in page instead of failing with a 401.
+//
+// Redirects can only be disabled if the client was constructed with the
+// default (nil) HTTP client or an *http.Client. For any other httpcli.Doer the
+// Doer's own redirect policy applies.
+func (c *client) SetFollowRedirects(follow bool) {
+	c.followRedirects = follow
+	if follow || c.noRedirectHTTPClient != nil {
+		return
+	}
+
+	if cli, ok := c.httpClient.(*http.Client); ok {
+		noRedirect := *cli
+		noRedirect.CheckRedirect = noFollowRedirects
+		c.noRedirectHTTPClient = &noRedirect
+	} else if c.defaultHTTPClient {
+		opts := []httpcli.Opt{func(cli *http.Client) error {
+			cli.CheckRedirect = noFollowRedirects
+			return nil
+		}}
+		if c.proxyURL != nil {
+			opts = append(opts, proxyOpt(c.proxyURL))
+		}
+		c.noRedirectHTTPClient, _ = httpcli.ExternalClientFactory.Doer(opts...)
+	}
+}
+
+func noFollowRedirects(*http.Request, []*http.Request) error {
+	return http.ErrUseLastResponse
+}
+
+type notFoundProbeKey struct{}
+
+// RequestOptions are the options of a single call, embedded in the options of
+// the methods that take them.
+type RequestOptions struct {
+	// APIVersion, if set, is the api-version of the requests the call sends to
+	// its own endpoint, e.g. to work around a regression of the endpoint in a
+	// newer version without configuring the whole client with SetAPIVersion.
+	// Requests the client sends along the way to look up something else,
+	// e.g. identities or the host of a service, keep their version.
+	APIVersion string
+}
+
+// setQueryParams sets the api-version of o, if any, in queryParams. It must be
+// called after any preview version of the endpoint was set, which it takes
+// precedence over.
+func (o RequestOptions) setQueryParams(queryParams url.Values) {
+	if o.APIVersion != "" {
+		setAPIVersion(queryParams, o.APIVersion)
+	}
+}
+
+type apiVersionKey struct{}
+
+// WithAPIVersion returns a context that makes the client send version as the
+// api-version of every request of a call made using it, rather than of its
+// own requests only like RequestOptions.APIVersion. Requests the client sends
+// to look up something else on its own, e.g. identities or the host of a
+// service, keep their version. An empty version returns ctx unchanged.
+//
+// The version of a request is, in order of precedence, the one set with
+// WithAPIVersion, the one set with RequestOptions.APIVersion, the preview
+// version an endpoint is only available under, the one set with
+// SetAPIVersion, and 7.0.
+func WithAPIVersion(ctx context.Context, version string) context.Context {
+	if version == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, apiVersionKey{}, version)
+}
+
+func apiVersionOverride(ctx context.Context) string {
+	v, _ := ctx.Value(apiVersionKey{}).(string)
+	return v
+}
+
+// withoutAPIVersion returns ctx without the version set with WithAPIVersion,
+// for the requests the client sends on its own.
+func withoutAPIVersion(ctx context.Context) context.Context {
+	if apiVersionOverride(ctx) == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, apiVersionKey{}, "")
+}
+
+type requestHeadersKey struct{}
+
+// WithRequestHeaders returns a context that makes the client send headers with
+// every request made using it, e.g. to pass identity override headers such as
+// X-VSS-ForceMsaPassThrough for a single call on behalf of a user. Headers
+// already set on ctx are kept unless overridden. The Authorization header is
+// always set by the client's authenticator and cannot be overridden.
+func WithRequestHeaders(ctx context.Context, headers http.Header) context.Context {
+	merged := requestHeaders(ctx).Clone()
+	if merged == nil {
+		merged = make(http.Header, len(headers))
+	}
+	for name, values := range headers {
+		merged[http.CanonicalHeaderKey(name)] = append([]string(nil), values...)
+	}
+	return context.WithValue(ctx, requestHeadersKey{}, merged)
+}
+
+// WithIfMatch returns a context that sends etag as the If-Match header, so
+// that an update fails with a *PreconditionFailedError instead of overwriting
+// a concurrent change. The ETag of a resource is returned in the ETag header,
+// see ResponseMeta. An empty etag returns ctx unchanged.
+//
+// Azure DevOps only documents If-Match for some endpoints, e.g. wiki pages,
+// and may ignore it elsewhere, so it cannot be relied on to prevent lost
+// updates in general. UpdatePullRequest and UpdateRepository send it when
+// their input has IfMatch set.
+func WithIfMatch(ctx context.Context, etag string) context.Context {
+	if etag == "" {
+		return ctx
+	}
+	return WithRequestHeaders(ctx, http.Header{"If-Match": {etag}})
+}
+
+func requestHeaders(ctx context.Context) http.Header {
+	h, _ := ctx.Value(requestHeadersKey{}).(http.Header)
+	return h
+}
+
+// classifyNotFound probes the repository, project and organization of the
+// 404ed reqURL and wraps httpErr accordingly. Requests that are not scoped to
+// an organization on the configured host are returned unchanged.
+func (c *client) classifyNotFound(ctx context.Context, reqURL *url.URL, httpErr *HTTPError) error {
+	if reqURL.Host != c.URL.Host {
+		return httpErr
+	}
+
+	segments := strings.Split(strings.TrimPrefix(strings.TrimPrefix(reqURL.Path, c.URL.Path), "/"), "/")
+	org := segments[0]
+	if org == "" || org == "_apis" {
+		return httpErr
+	}
+	var project string
+	if len(segments) > 1 && segments[1] != "_apis" {
+		project = segments[1]
+	}
+	// repo is set for requests of a repository or of something in it, e.g. a
+	// commit or an item.
+	var repo string
+	if project != "" && len(segments) > 5 && segments[2] == "_apis" && segments[3] == "git" && segments[4] == "repositories" {
+		repo = segments[5]
+	}
+
+	ctx = withoutAPIVersion(context.WithValue(ctx, notFoundProbeKey{}, true))
+
+	if repo != "" && len(segments) > 6 {
+		switch status := c.probe(ctx, fmt.Sprintf("%s/%s/_apis/git/repositories/%s", org, project, repo)); {
+		case status >= 200 && status < 300:
+			return &NotFoundError{Scope: "resource", Err: httpErr}
+		case status == http.StatusUnauthorized || status == http.StatusForbidden:
+			return &ForbiddenError{Scope: "repository", Err: httpErr}
+		}
+	}
+
+	if project != "" {
+		switch status := c.probe(ctx, fmt.Sprintf("%s/_apis/projects/%s", org, project)); {
+		case status >= 200 && status < 300:
+			if repo != "" {
+				return &NotFoundError{Scope: "repository", Deleted: c.repositoryDeleted(ctx, org, project, repo), Err: httpErr}
+			}
+			return &NotFoundError{Scope: "resource", Err: httpErr}
+		case status == http.StatusUnauthorized || status == http.StatusForbidden:
+			return &ForbiddenError{Scope: "project", Err: httpErr}
+		}
+	}
+
+	switch status := c.probe(ctx, fmt.Sprintf("%s/_apis/connectionData", org)); {
+	case status >= 200 && status < 300:
+		if project != "" {
+			// Azure DevOps hides projects the user has no access to, so we can't
+			// tell any better than this.
+			return &NotFoundError{Scope: "project", Err: httpErr}
+		}
+		return &NotFoundError{Scope: "resource", Err: httpErr}
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return &ForbiddenError{Scope: "organization", Err: httpErr}
+	case status == http.StatusNotFound:
+		return &NotFoundError{Scope: "organization", Err: httpErr}
+	}
+
+	return httpErr
+}
+
+// repositoryDeleted returns true if repo, a repository name or ID, is in the
+// recycle bin of project. Errors are ignored, as this only refines an error.
+func (c *client) repositoryDeleted(ctx context.Context, org, project, repo string) bool {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/%s/_apis/git/deletedrepositories", org, project), nil)
+	if err != nil {
+		return false
+	}
+
+	var resp struct {
+		Value []Repository `json:"value"`
+	}
+	if _, err := c.do(ctx, req, "", &resp); err != nil {
+		return false
+	}
+	for _, r := range resp.Value {
+		if strings.EqualFold(r.ID, repo) || strings.EqualFold(r.Name, repo) {
+			return true
+		}
+	}
+	return false
+}
+
+// probe requests path and returns the response status code, or 0 if the
+// request failed without a response.
+func (c *client) probe(ctx context.Context, path string) int {
+	req, err := http.NewRequest("GET", path, nil)
+	if err != nil {
+		return 0
+	}
+
+	var result json.RawMessage
+	if _, err := c.do(ctx, req, "", &result); err != nil {
+		var e *HTTPError
+		if errors.As(err, &e) {
+			return e.StatusCode
+		}
+		return 0
+	}
+	return http.StatusOK
+}
+
 func (c *client) Authenticator() auth.Authenticator {
 	return c.auth
 }
@@ -206,6 +1095,32 @@ func (c *client) IsAzureDevOpsServices() bool {
 	return c.URL.String() == AzureDevOpsAPIURL
 }
 
+// resolveHost returns the absolute URL of path for the given service.
+//
+// On Azure DevOps Services some APIs (Graph, Search, User Entitlements, ...) are
+// hosted on a service specific host, e.g. https://vssps.dev.azure.com/{org}/_apis/graph.
+// The host is looked up in the resource areas of the instance, falling back to
+// the well-known subdomain if they can't be fetched. On Azure DevOps Server all
+// services are served from the configured URL, so the path is resolved against
+// it like any other request.
+//
+// The returned URL is absolute, so a request built from it is not re-resolved
+// against the base URL in do.
+func (c *client) resolveHost(ctx context.Context, service string, path string) *url.URL {
+	ref := &url.URL{Path: path}
+	if !c.IsAzureDevOpsServices() {
+		return c.URL.ResolveReference(ref)
+	}
+
+	base := *c.URL
+	if scheme, host, ok := c.resourceAreaHost(ctx, service); ok {
+		base.Scheme, base.Host = scheme, host
+	} else {
+		base.Host = service + "." + c.URL.Host
+	}
+	return base.ResolveReference(ref)
+}
+
 func GetOAuthContext(refreshToken string) (*oauthutil.OAuthContext, error) {
 	for _, authProvider := range conf.SiteConfig().AuthProviders {
 		if authProvider.AzureDevOps != nil {
@@ -281,3 +1196,199 @@ func (e *HTTPError) Unauthorized() bool {
 func (e *HTTPError) NotFound() bool {
 	return e.StatusCode == http.StatusNotFound
 }
+
+func (e *HTTPError) Forbidden() bool {
+	return e.StatusCode == http.StatusForbidden
+}
+
+// Redirect returns true if the error is a redirect that was not followed, see
+// Client.SetFollowRedirects.
+func (e *HTTPError) Redirect() bool {
+	return e.StatusCode >= 300 && e.StatusCode < 400
+}
+
+// IsRetryable reports whether the operation that failed with err may succeed
+// if retried later, e.g. because Azure DevOps was rate limiting or
+// temporarily unavailable, or a request timed out. The client retries rate
+// limited requests itself already, so this is meant for callers deciding
+// whether to retry a whole operation. Errors of a done context are not
+// retryable, as they are caused by the caller.
+func IsRetryable(err error) bool {
+	if err == nil || errors.IsContextError(err) {
+		return false
+	}
+	if errors.Is(err, ErrRateLimited) {
+		return true
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		switch httpErr.StatusCode {
+		case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// isNotFound reports whether err signals that a resource does not exist.
+func isNotFound(err error) bool {
+	var e interface{ NotFound() bool }
+	return errors.As(err, &e) && e.NotFound()
+}
+
+// synchronizeAuthenticator returns a wrapped a that is synchronized with mu if
+// a refreshes itself, and a otherwise.
+func synchronizeAuthenticator(a auth.Authenticator, mu *sync.Mutex) auth.Authenticator {
+	if r, ok := a.(auth.AuthenticatorWithRefresh); ok {
+		return &syncAuthenticator{mu: mu, a: r}
+	}
+	return a
+}
+
+// syncAuthenticator serializes the use of an authenticator that refreshes
+// itself in place, such as *auth.OAuthBearerToken, so that requests made
+// concurrently don't race on its token.
+type syncAuthenticator struct {
+	mu *sync.Mutex
+	a  auth.AuthenticatorWithRefresh
+
+	// refresh coalesces concurrent refreshes, e.g. of requests that all got a
+	// 401 for the same expired token, into a single call to the token
+	// endpoint.
+	refresh singleflight.Group
+	// refreshErr is set once the refresh token was rejected, after which
+	// requests fail with it rather than retrying the refresh. Guarded by mu.
+	refreshErr error
+}
+
+var _ auth.AuthenticatorWithRefresh = &syncAuthenticator{}
+
+func (s *syncAuthenticator) Authenticate(req *http.Request) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.refreshErr != nil {
+		return s.refreshErr
+	}
+	if sent, ok := req.Context().Value(sentTokenKey{}).(*sentToken); ok {
+		sent.hash = s.a.Hash()
+	}
+	return s.a.Authenticate(req)
+}
+
+func (s *syncAuthenticator) Hash() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.a.Hash()
+}
+
+func (s *syncAuthenticator) NeedsRefresh() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.refreshErr == nil && s.a.NeedsRefresh()
+}
+
+// Refresh holds the lock while the token is refreshed, so that concurrent
+// requests wait for the new token instead of using the old one. Callers
+// refreshing at the same time share a single refresh, and the token isn't
+// refreshed again for a request that got a 401 for a token that has been
+// refreshed since it was sent. The refresh isn't tied to the ctx of any one
+// caller, so that its cancellation doesn't fail the others, but it times out
+// after tokenRefreshTimeout.
+func (s *syncAuthenticator) Refresh(ctx context.Context, cli httpcli.Doer) error {
+	sent, _ := ctx.Value(sentTokenKey{}).(*sentToken)
+	ch := s.refresh.DoChan("", func() (any, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.refreshErr != nil {
+			return nil, s.refreshErr
+		}
+		if sent != nil && sent.hash != "" && sent.hash != s.a.Hash() {
+			return nil, nil
+		}
+
+		ctx, cancel := context.WithTimeout(xcontext.Detach(ctx), tokenRefreshTimeout)
+		defer cancel()
+		err := s.a.Refresh(ctx, cli)
+		if isRefreshTokenRejected(err) {
+			s.refreshErr = &InvalidRefreshTokenError{Err: err}
+			return nil, s.refreshErr
+		}
+		return nil, err
+	})
+
+	select {
+	case res := <-ch:
+		return res.Err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// tokenRefreshTimeout bounds the refresh of an OAuth token.
+const tokenRefreshTimeout = 30 * time.Second
+
+type sentTokenKey struct{}
+
+// sentToken records the hash of the token a request was authenticated with,
+// see syncAuthenticator.Refresh.
+type sentToken struct {
+	hash string
+}
+
+// withSentToken returns a context recording the token of the request whose
+// context it is.
+func withSentToken(ctx context.Context) context.Context {
+	return context.WithValue(ctx, sentTokenKey{}, &sentToken{})
+}
+
+// refreshError returns the error the last refresh of a failed with if the
+// refresh token was rejected, and nil otherwise.
+func refreshError(a auth.Authenticator) error {
+	s, ok := a.(*syncAuthenticator)
+	if !ok {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.refreshErr
+}
+
+// isRefreshTokenRejected reports whether err, returned by a token refresh,
+// means the token endpoint rejected the refresh token, rather than that the
+// endpoint could not be reached or failed.
+func isRefreshTokenRejected(err error) bool {
+	var tokenErr *oauthutil.TokenError
+	if errors.As(err, &tokenErr) {
+		return true
+	}
+	var retrieveErr *oauthutil.RetrieveError
+	if errors.As(err, &retrieveErr) && retrieveErr.Response != nil {
+		code := retrieveErr.Response.StatusCode
+		return code == http.StatusBadRequest || code == http.StatusUnauthorized
+	}
+	return false
+}
+
+// InvalidRefreshTokenError is returned for requests of a client whose OAuth
+// token could not be refreshed because Azure DevOps rejected the refresh
+// token, e.g. because it expired or the authorization was revoked. The
+// connection has to be authorized again, retrying does not help.
+type InvalidRefreshTokenError struct {
+	Err error
+}
+
+func (e *InvalidRefreshTokenError) Error() string {
+	return fmt.Sprintf("Azure DevOps OAuth refresh token was rejected, the connection must be authorized again: %v", e.Err)
+}
+
+func (e *InvalidRefreshTokenError) Unwrap() error {
+	return e.Err
+}
+
+func (e *InvalidRefreshTokenError) Unauthorized() bool {
+	return true
+}
@@ -1,23 +1,31 @@
 package azuredevops
 
 import (
+	"compress/gzip"
 	"context"
 	"flag"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/dnaeon/go-vcr/cassette"
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
 	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
 	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/httptestutil"
 	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
+	"github.com/sourcegraph/sourcegraph/internal/oauthutil"
 	"github.com/sourcegraph/sourcegraph/internal/rcache"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
 	"github.com/stretchr/testify/require"
 	"gotest.tools/assert"
 )
@@ -165,3 +173,955 @@ func ignoreHostMatcher(r *http.Request, i cassette.Request) bool {
 	u.Scheme = r.URL.Scheme
 	return r.URL.String() == u.String()
 }
+
+func TestCaptureRawJSON(t *testing.T) {
+	ctx := context.Background()
+	body := `{"id":"repo-id","name":"repo","unmodeledField":"value"}`
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	a := &auth.BasicAuth{Username: "test", Password: "test"}
+	client, err := NewClient("test", srv.URL, a, nil)
+	require.NoError(t, err)
+
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		repo, err := client.GetRepo(ctx, args)
+		require.NoError(t, err)
+		require.Nil(t, repo.RawJSON)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		client.SetCaptureRawJSON(true)
+		t.Cleanup(func() { client.SetCaptureRawJSON(false) })
+
+		repo, err := client.GetRepo(ctx, args)
+		require.NoError(t, err)
+		assert.Equal(t, "repo-id", repo.ID)
+		assert.Equal(t, body, string(repo.RawJSON))
+	})
+}
+
+func TestClient_Close(t *testing.T) {
+	numRequests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		numRequests++
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	a := &auth.BasicAuth{Username: "test", Password: "test"}
+	client, err := NewClient("test", srv.URL, a, nil)
+	require.NoError(t, err)
+
+	_, err = client.GetProject(context.Background(), "org", "project")
+	require.NoError(t, err)
+
+	require.NoError(t, client.Close())
+	// Close is idempotent.
+	require.NoError(t, client.Close())
+
+	_, err = client.GetProject(context.Background(), "org", "project")
+	require.ErrorIs(t, err, ErrClientClosed)
+	assert.Equal(t, 1, numRequests)
+}
+
+func TestClient_resolveHost(t *testing.T) {
+	a := &auth.BasicAuth{Username: "test", Password: "test"}
+
+	// resourceAreas serves the resource areas of dev.azure.com, with search
+	// moved to a different host.
+	resourceAreas := httpcli.DoerFunc(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "https://dev.azure.com/_apis/resourceAreas?api-version=7.0-preview.1", req.URL.String())
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+			Body: io.NopCloser(strings.NewReader(`{"count": 2, "value": [
+				{"id": "1", "name": "Graph", "locationUrl": "https://vssps.dev.azure.com/"},
+				{"id": "2", "name": "Search", "locationUrl": "https://search.example.com/"}
+			]}`)),
+		}, nil
+	})
+	unavailable := httpcli.DoerFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusServiceUnavailable, Header: make(http.Header), Body: io.NopCloser(strings.NewReader(""))}, nil
+	})
+
+	tests := map[string]struct {
+		baseURL    string
+		httpClient httpcli.Doer
+		service    string
+		path       string
+		want       string
+	}{
+		"cloud vssps": {
+			baseURL:    AzureDevOpsAPIURL,
+			httpClient: resourceAreas,
+			service:    serviceVSSPS,
+			path:       "org/_apis/graph/users",
+			want:       "https://vssps.dev.azure.com/org/_apis/graph/users",
+		},
+		"cloud almsearch": {
+			baseURL:    AzureDevOpsAPIURL,
+			httpClient: resourceAreas,
+			service:    serviceAlmSearch,
+			path:       "org/_apis/search/codesearchresults",
+			want:       "https://search.example.com/org/_apis/search/codesearchresults",
+		},
+		"cloud area missing": {
+			baseURL:    AzureDevOpsAPIURL,
+			httpClient: resourceAreas,
+			service:    serviceVSAEX,
+			path:       "org/_apis/userentitlements",
+			want:       "https://vsaex.dev.azure.com/org/_apis/userentitlements",
+		},
+		"cloud areas unavailable": {
+			baseURL:    AzureDevOpsAPIURL,
+			httpClient: unavailable,
+			service:    serviceAlmSearch,
+			path:       "org/_apis/search/codesearchresults",
+			want:       "https://almsearch.dev.azure.com/org/_apis/search/codesearchresults",
+		},
+		"on-prem": {
+			baseURL: "https://ado.example.com/tfs/",
+			httpClient: httpcli.DoerFunc(func(req *http.Request) (*http.Response, error) {
+				t.Errorf("unexpected request to %s", req.URL)
+				return nil, errors.New("unexpected request")
+			}),
+			service: serviceVSSPS,
+			path:    "DefaultCollection/_apis/graph/users",
+			want:    "https://ado.example.com/tfs/DefaultCollection/_apis/graph/users",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			cli, err := NewClient("test", tt.baseURL, a, tt.httpClient)
+			require.NoError(t, err)
+			cli.SetWaitForRateLimit(false)
+
+			got := cli.(*client).resolveHost(context.Background(), tt.service, tt.path)
+			assert.Equal(t, tt.want, got.String())
+		})
+	}
+}
+
+func TestClient_GetResourceAreas(t *testing.T) {
+	var numRequests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		numRequests++
+		assert.Equal(t, "/_apis/resourceAreas", r.URL.Path)
+		w.Write([]byte(`{"count": 1, "value": [{"id": "1", "name": "Graph", "locationUrl": "https://vssps.example.com/"}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		areas, err := cli.GetResourceAreas(context.Background())
+		require.NoError(t, err)
+		assert.DeepEqual(t, map[string]string{"graph": "https://vssps.example.com/"}, areas)
+	}
+	assert.Equal(t, 1, numRequests)
+}
+
+func TestClient_GetResourceAreas_Unavailable(t *testing.T) {
+	var numRequests int
+	unavailable := httpcli.DoerFunc(func(req *http.Request) (*http.Response, error) {
+		numRequests++
+		return &http.Response{StatusCode: http.StatusNotFound, Header: make(http.Header), Body: io.NopCloser(strings.NewReader(""))}, nil
+	})
+
+	cli, err := NewClient("test", AzureDevOpsAPIURL, &auth.BasicAuth{Username: "test", Password: "test"}, unavailable)
+	require.NoError(t, err)
+	c := cli.(*client)
+
+	// Every lookup falls back to the well-known host, but only the first one
+	// asks for the resource areas.
+	for i := 0; i < 3; i++ {
+		got := c.resolveHost(context.Background(), serviceVSSPS, "org/_apis/graph/users")
+		assert.Equal(t, "https://vssps.dev.azure.com/org/_apis/graph/users", got.String())
+	}
+	assert.Equal(t, 1, numRequests)
+
+	_, err = cli.GetResourceAreas(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, 1, numRequests)
+
+	// Once the failure expired, the resource areas are asked for again.
+	c.resourceAreasErr.now = func() time.Time { return time.Now().Add(defaultResourceAreasCacheTTL) }
+	_, err = cli.GetResourceAreas(context.Background())
+	require.Error(t, err)
+	assert.Equal(t, 2, numRequests)
+}
+
+func TestClient_ProbeNotFound(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		projectStatus int
+		orgStatus     int
+		wantNotFound  bool
+		wantForbidden bool
+		wantScope     string
+	}{
+		"repository missing in visible project": {
+			projectStatus: http.StatusOK,
+			wantNotFound:  true,
+			wantScope:     "repository",
+		},
+		"project not visible": {
+			projectStatus: http.StatusNotFound,
+			orgStatus:     http.StatusOK,
+			wantNotFound:  true,
+			wantScope:     "project",
+		},
+		"organization not accessible": {
+			projectStatus: http.StatusNotFound,
+			orgStatus:     http.StatusUnauthorized,
+			wantForbidden: true,
+			wantScope:     "organization",
+		},
+		"organization missing": {
+			projectStatus: http.StatusNotFound,
+			orgStatus:     http.StatusNotFound,
+			wantNotFound:  true,
+			wantScope:     "organization",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/org/_apis/projects/project":
+					w.WriteHeader(tt.projectStatus)
+				case "/org/_apis/connectionData":
+					w.WriteHeader(tt.orgStatus)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				w.Write([]byte(`{}`))
+			}))
+			t.Cleanup(srv.Close)
+
+			a := &auth.BasicAuth{Username: "test", Password: "test"}
+			cli, err := NewClient("test", srv.URL, a, nil)
+			require.NoError(t, err)
+
+			args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+
+			// Without probing we get the plain HTTP error.
+			_, err = cli.GetRepo(ctx, args)
+			var httpErr *HTTPError
+			require.True(t, errors.As(err, &httpErr))
+			require.True(t, errcode.IsNotFound(err))
+
+			cli.SetProbeNotFound(true)
+			_, err = cli.GetRepo(ctx, args)
+			assert.Equal(t, tt.wantNotFound, errcode.IsNotFound(err))
+			assert.Equal(t, tt.wantForbidden, errcode.IsForbidden(err))
+			require.True(t, errors.As(err, &httpErr), "original error is still accessible")
+
+			var notFound *NotFoundError
+			var forbidden *ForbiddenError
+			switch {
+			case errors.As(err, &notFound):
+				assert.Equal(t, tt.wantScope, notFound.Scope)
+			case errors.As(err, &forbidden):
+				assert.Equal(t, tt.wantScope, forbidden.Scope)
+			default:
+				t.Fatalf("unexpected error type %T", err)
+			}
+		})
+	}
+}
+
+func TestClient_ProbeNotFound_Commit(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		repoStatus    int
+		deleted       string
+		wantForbidden bool
+		wantScope     string
+		wantDeleted   bool
+	}{
+		"commit missing": {
+			repoStatus: http.StatusOK,
+			wantScope:  "resource",
+		},
+		"repository not accessible": {
+			repoStatus:    http.StatusForbidden,
+			wantForbidden: true,
+			wantScope:     "repository",
+		},
+		"repository missing": {
+			repoStatus: http.StatusNotFound,
+			deleted:    `{"count": 1, "value": [{"id": "other", "name": "other"}]}`,
+			wantScope:  "repository",
+		},
+		"repository deleted": {
+			repoStatus:  http.StatusNotFound,
+			deleted:     `{"count": 1, "value": [{"id": "b1f0c5e4", "name": "Repo"}]}`,
+			wantScope:   "repository",
+			wantDeleted: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/org/project/_apis/git/repositories/repo":
+					w.WriteHeader(tt.repoStatus)
+					w.Write([]byte(`{}`))
+				case "/org/_apis/projects/project":
+					w.Write([]byte(`{}`))
+				case "/org/project/_apis/git/deletedrepositories":
+					w.Write([]byte(tt.deleted))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			t.Cleanup(srv.Close)
+
+			cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+			require.NoError(t, err)
+			cli.SetProbeNotFound(true)
+
+			args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+			_, err = cli.GetCommit(ctx, args, "abc")
+			assert.Equal(t, !tt.wantForbidden, errcode.IsNotFound(err))
+			assert.Equal(t, tt.wantForbidden, errcode.IsForbidden(err))
+
+			var notFound *NotFoundError
+			var forbidden *ForbiddenError
+			switch {
+			case errors.As(err, &notFound):
+				assert.Equal(t, tt.wantScope, notFound.Scope)
+				assert.Equal(t, tt.wantDeleted, notFound.Deleted)
+			case errors.As(err, &forbidden):
+				assert.Equal(t, tt.wantScope, forbidden.Scope)
+			default:
+				t.Fatalf("unexpected error type %T", err)
+			}
+		})
+	}
+}
+
+func TestClient_SetFollowRedirects(t *testing.T) {
+	ctx := context.Background()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/_signin" {
+			w.Write([]byte(`{"name":"signed in"}`))
+			return
+		}
+		http.Redirect(w, r, "/_signin", http.StatusFound)
+	}))
+	t.Cleanup(srv.Close)
+
+	for name, httpClient := range map[string]httpcli.Doer{
+		"default client": nil,
+		"http.Client":    &http.Client{},
+	} {
+		t.Run(name, func(t *testing.T) {
+			a := &auth.BasicAuth{Username: "test", Password: "test"}
+			cli, err := NewClient("test", srv.URL, a, httpClient)
+			require.NoError(t, err)
+
+			// Redirects are followed by default.
+			p, err := cli.GetProject(ctx, "org", "project")
+			require.NoError(t, err)
+			assert.Equal(t, "signed in", p.Name)
+
+			cli.SetFollowRedirects(false)
+			_, err = cli.GetProject(ctx, "org", "project")
+			var httpErr *HTTPError
+			require.True(t, errors.As(err, &httpErr))
+			assert.Equal(t, http.StatusFound, httpErr.StatusCode)
+			assert.Assert(t, httpErr.Redirect())
+		})
+	}
+}
+
+func TestClient_NoContent(t *testing.T) {
+	for name, status := range map[string]int{
+		"204 No Content": http.StatusNoContent,
+		"200 empty body": http.StatusOK,
+	} {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(status)
+			}))
+			t.Cleanup(srv.Close)
+
+			a := &auth.BasicAuth{Username: "test", Password: "test"}
+			cli, err := NewClient("test", srv.URL, a, nil)
+			require.NoError(t, err)
+
+			p, err := cli.GetProject(context.Background(), "org", "project")
+			require.NoError(t, err)
+			assert.Equal(t, "", p.ID)
+		})
+	}
+}
+
+func TestClient_APIVersion(t *testing.T) {
+	var gotVersions []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotVersions = append(gotVersions, r.URL.Query().Get("api-version"))
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	a := &auth.BasicAuth{Username: "test", Password: "test"}
+	cli, err := NewClient("test", srv.URL, a, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	_, err = cli.GetProject(ctx, "org", "project")
+	require.NoError(t, err)
+
+	cli.SetAPIVersion("7.1")
+	_, err = cli.GetProject(ctx, "org", "project")
+	require.NoError(t, err)
+
+	// Preview-only endpoints keep their version.
+	_, err = cli.QueryAuditLog(ctx, QueryAuditLogInput{Org: "org"})
+	require.NoError(t, err)
+
+	// A version set for the call overrides both.
+	_, err = cli.GetPullRequest(ctx, PullRequestCommonArgs{Org: "org", Project: "project", RepoNameOrID: "repo", PullRequestID: "1"}, GetPullRequestOptions{RequestOptions: RequestOptions{APIVersion: "6.0"}})
+	require.NoError(t, err)
+	_, err = cli.QueryAuditLog(WithAPIVersion(ctx, "7.2-preview.1"), QueryAuditLogInput{Org: "org"})
+	require.NoError(t, err)
+	_, err = cli.GetProject(ctx, "org", "project")
+	require.NoError(t, err)
+
+	assert.DeepEqual(t, []string{"7.0", "7.1", auditLogAPIVersion, "6.0", "7.2-preview.1", "7.1"}, gotVersions)
+}
+
+func TestClient_APIVersion_LookupRequests(t *testing.T) {
+	gotVersions := map[string]string{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotVersions[r.URL.Path] = r.URL.Query().Get("api-version")
+		switch r.URL.Path {
+		case "/org/project/_apis/git/repositories/repo/pullrequests/1/reviewers":
+			w.Write([]byte(`{"count": 1, "value": [{"id": "id", "vote": 10}]}`))
+		case "/org/project/_apis/git/repositories/repo/pullrequests/2":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.Write([]byte(`{"count": 0, "value": []}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+	cli.SetProbeNotFound(true)
+
+	ctx := context.Background()
+	args := PullRequestCommonArgs{Org: "org", Project: "project", RepoNameOrID: "repo", PullRequestID: "1"}
+
+	// The version of the call only applies to the reviewers, not to looking
+	// up their names.
+	_, err = cli.ListPullRequestReviewers(ctx, args, ListPullRequestReviewersOptions{
+		ResolveDisplayNames: true,
+		RequestOptions:      RequestOptions{APIVersion: "7.1"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "7.1", gotVersions["/org/project/_apis/git/repositories/repo/pullrequests/1/reviewers"])
+	assert.Equal(t, "7.0", gotVersions["/org/_apis/identities"])
+
+	// Nor does a version set on the context apply to not-found probes.
+	args.PullRequestID = "2"
+	_, err = cli.GetPullRequest(WithAPIVersion(ctx, "7.1"), args, GetPullRequestOptions{})
+	require.Error(t, err)
+	assert.Equal(t, "7.1", gotVersions["/org/project/_apis/git/repositories/repo/pullrequests/2"])
+	assert.Equal(t, "7.0", gotVersions["/org/project/_apis/git/repositories/repo"])
+}
+
+func TestWithRequestHeaders(t *testing.T) {
+	var got http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	a := &auth.BasicAuth{Username: "test", Password: "test"}
+	cli, err := NewClient("test", srv.URL, a, nil)
+	require.NoError(t, err)
+
+	ctx := WithRequestHeaders(context.Background(), http.Header{
+		"X-Vss-Forcemsapassthrough": {"true"},
+		"X-Other":                   {"a"},
+	})
+	ctx = WithRequestHeaders(ctx, http.Header{
+		"x-other":       {"b"},
+		"authorization": {"Bearer nope"},
+	})
+
+	_, err = cli.GetProject(ctx, "org", "project")
+	require.NoError(t, err)
+
+	assert.Equal(t, "true", got.Get("X-VSS-ForceMsaPassThrough"))
+	assert.DeepEqual(t, []string{"b"}, got.Values("X-Other"))
+	user, pass, ok := (&http.Request{Header: got}).BasicAuth()
+	assert.Assert(t, ok)
+	assert.Equal(t, "test", user)
+	assert.Equal(t, "test", pass)
+
+	// Headers are scoped to the context they were added to.
+	_, err = cli.GetProject(context.Background(), "org", "project")
+	require.NoError(t, err)
+	assert.Equal(t, "", got.Get("X-VSS-ForceMsaPassThrough"))
+}
+
+func TestNewClient_BaseURL(t *testing.T) {
+	a := &auth.BasicAuth{Username: "test", Password: "test"}
+
+	for _, tc := range []struct {
+		url  string
+		want string
+	}{
+		{url: "https://dev.azure.com/org", want: "https://dev.azure.com/org/_apis/git/repositories"},
+		{url: "https://dev.azure.com/org/", want: "https://dev.azure.com/org/_apis/git/repositories"},
+		{url: "https://dev.azure.com", want: "https://dev.azure.com/_apis/git/repositories"},
+		{url: "https://ado.example.com/tfs/DefaultCollection", want: "https://ado.example.com/tfs/DefaultCollection/_apis/git/repositories"},
+	} {
+		cli, err := NewClient("test", tc.url, a, nil)
+		require.NoError(t, err)
+
+		got := cli.GetURL().ResolveReference(&url.URL{Path: "_apis/git/repositories"})
+		assert.Equal(t, tc.want, got.String(), tc.url)
+	}
+
+	cli, err := NewClient("test", "https://dev.azure.com", a, nil)
+	require.NoError(t, err)
+	assert.Assert(t, cli.IsAzureDevOpsServices())
+
+	for _, invalid := range []string{"", "dev.azure.com/org", "/org"} {
+		_, err := NewClient("test", invalid, a, nil)
+		assert.Assert(t, err != nil, invalid)
+	}
+}
+
+func TestNewClientWithProxy(t *testing.T) {
+	var proxiedHosts []string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Requests sent to a proxy carry the absolute target URL.
+		proxiedHosts = append(proxiedHosts, r.URL.Host)
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(proxy.Close)
+
+	a := &auth.BasicAuth{Username: "test", Password: "test"}
+	ctx := context.Background()
+
+	cli, err := NewClientWithProxy("test", "http://ado.example.com/tfs", proxy.URL, a, nil)
+	require.NoError(t, err)
+	_, err = cli.GetProject(ctx, "org", "project")
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{"ado.example.com"}, proxiedHosts)
+
+	// The proxy is kept when redirects are disabled.
+	cli.SetFollowRedirects(false)
+	_, err = cli.GetProject(ctx, "org", "project")
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{"ado.example.com", "ado.example.com"}, proxiedHosts)
+
+	// An explicit HTTP client takes precedence over the proxy.
+	var direct int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		direct++
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err = NewClientWithProxy("test", srv.URL, proxy.URL, a, http.DefaultClient)
+	require.NoError(t, err)
+	_, err = cli.GetProject(ctx, "org", "project")
+	require.NoError(t, err)
+	assert.Equal(t, 1, direct)
+	assert.Equal(t, 2, len(proxiedHosts))
+
+	_, err = NewClientWithProxy("test", srv.URL, "ftp://proxy", a, nil)
+	assert.Assert(t, err != nil)
+}
+
+func TestClient_E2EID(t *testing.T) {
+	fail := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-VSS-E2EID", "30d4e7a8-2ac4-4d8d-b447-3c1d8a7b6f6e")
+		if fail {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	a := &auth.BasicAuth{Username: "test", Password: "test"}
+	cli, err := NewClient("test", srv.URL, a, nil)
+	require.NoError(t, err)
+
+	var meta ResponseMeta
+	ctx := WithResponseMeta(context.Background(), &meta)
+	_, err = cli.GetProject(ctx, "org", "project")
+	require.NoError(t, err)
+	assert.Equal(t, "30d4e7a8-2ac4-4d8d-b447-3c1d8a7b6f6e", meta.E2EID)
+
+	fail = true
+	_, err = cli.GetProject(ctx, "org", "project")
+	var httpErr *HTTPError
+	require.True(t, errors.As(err, &httpErr))
+	assert.Equal(t, "30d4e7a8-2ac4-4d8d-b447-3c1d8a7b6f6e", httpErr.E2EID)
+	assert.Assert(t, strings.Contains(err.Error(), "e2eid=30d4e7a8-2ac4-4d8d-b447-3c1d8a7b6f6e"))
+}
+
+func TestClient_doPaginated(t *testing.T) {
+	tests := []struct {
+		name       string
+		header     string
+		body       string
+		tokenField string
+		want       string
+	}{
+		{name: "header", header: "from-header", body: `{"value": []}`, want: "from-header"},
+		{name: "body", body: `{"value": [], "continuationToken": "from-body"}`, tokenField: "continuationToken", want: "from-body"},
+		{name: "header takes precedence", header: "from-header", body: `{"continuationToken": "from-body"}`, tokenField: "continuationToken", want: "from-header"},
+		{name: "body field not configured", body: `{"continuationToken": "from-body"}`, want: ""},
+		{name: "body field null", body: `{"continuationToken": null}`, tokenField: "continuationToken", want: ""},
+		{name: "body field absent", body: `{"value": []}`, tokenField: "continuationToken", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.header != "" {
+					w.Header().Set(continuationTokenHeader, tt.header)
+				}
+				w.Write([]byte(tt.body))
+			}))
+			t.Cleanup(srv.Close)
+
+			cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest("GET", "org/_apis/things", nil)
+			require.NoError(t, err)
+
+			var result map[string]any
+			token, err := cli.(*client).doPaginated(context.Background(), req, "", tt.tokenField, &result)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, token)
+		})
+	}
+}
+
+func TestClient_Concurrency(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"count": 1, "value": [{"id": "repo-id", "name": "repo"}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	refreshes := atomic.Int32{}
+	for name, a := range map[string]auth.Authenticator{
+		"basic auth": &auth.BasicAuth{Username: "test", Password: "test"},
+		// An expired token is refreshed in place before every request.
+		"refreshing OAuth token": &auth.OAuthBearerToken{
+			Token:        "token",
+			RefreshToken: "refresh",
+			Expiry:       time.Now().Add(-time.Hour),
+			RefreshFunc: func(context.Context, httpcli.Doer, *auth.OAuthBearerToken) (string, string, time.Time, error) {
+				refreshes.Add(1)
+				return "refreshed", "refresh", time.Now().Add(-time.Hour), nil
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cli, err := NewClient("test", srv.URL, a, nil)
+			require.NoError(t, err)
+
+			ctx := context.Background()
+			var wg sync.WaitGroup
+			for i := 0; i < 50; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					repos, err := cli.ListRepositoriesByProjectOrOrg(ctx, ListRepositoriesByProjectOrOrgArgs{ProjectOrOrgName: "org/project"})
+					// Check doesn't stop the test, so it can be used in goroutines.
+					if assert.Check(t, err) {
+						assert.Check(t, len(repos) == 1)
+					}
+				}()
+			}
+			wg.Wait()
+		})
+	}
+	assert.Assert(t, refreshes.Load() > 0)
+}
+
+func TestClient_RefreshOAuthToken(t *testing.T) {
+	t.Run("concurrent 401s refresh once", func(t *testing.T) {
+		const n = 10
+		// Hold back the 401s until all requests arrived, so that they refresh
+		// at the same time.
+		var arrived sync.WaitGroup
+		arrived.Add(n)
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer refreshed" {
+				arrived.Done()
+				arrived.Wait()
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Write([]byte(`{"count": 1, "value": [{"id": "repo-id", "name": "repo"}]}`))
+		}))
+		t.Cleanup(srv.Close)
+
+		refreshes := atomic.Int32{}
+		cli, err := NewClient("test", srv.URL, &auth.OAuthBearerToken{
+			Token:        "token",
+			RefreshToken: "refresh",
+			Expiry:       time.Now().Add(time.Hour),
+			RefreshFunc: func(context.Context, httpcli.Doer, *auth.OAuthBearerToken) (string, string, time.Time, error) {
+				refreshes.Add(1)
+				return "refreshed", "refresh", time.Now().Add(time.Hour), nil
+			},
+		}, nil)
+		require.NoError(t, err)
+
+		var wg sync.WaitGroup
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := cli.ListRepositoriesByProjectOrOrg(context.Background(), ListRepositoriesByProjectOrOrgArgs{ProjectOrOrgName: "org/project"})
+				assert.Check(t, err)
+			}()
+		}
+		wg.Wait()
+		assert.Equal(t, int32(1), refreshes.Load())
+	})
+
+	t.Run("refresh outlives canceled caller", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		refreshed := make(chan error, 1)
+		a := synchronizeAuthenticator(&auth.OAuthBearerToken{
+			Token:        "token",
+			RefreshToken: "refresh",
+			RefreshFunc: func(ctx context.Context, _ httpcli.Doer, _ *auth.OAuthBearerToken) (string, string, time.Time, error) {
+				cancel()
+				_, hasDeadline := ctx.Deadline()
+				assert.Check(t, hasDeadline)
+				refreshed <- ctx.Err()
+				return "refreshed", "refresh", time.Now().Add(time.Hour), nil
+			},
+		}, &sync.Mutex{}).(auth.AuthenticatorWithRefresh)
+
+		_ = a.Refresh(ctx, http.DefaultClient)
+		assert.Check(t, <-refreshed)
+	})
+
+	t.Run("rejected refresh token", func(t *testing.T) {
+		requests := atomic.Int32{}
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		t.Cleanup(srv.Close)
+
+		refreshes := atomic.Int32{}
+		cli, err := NewClient("test", srv.URL, &auth.OAuthBearerToken{
+			Token:        "token",
+			RefreshToken: "revoked",
+			Expiry:       time.Now().Add(-time.Hour),
+			RefreshFunc: func(context.Context, httpcli.Doer, *auth.OAuthBearerToken) (string, string, time.Time, error) {
+				refreshes.Add(1)
+				return "", "", time.Time{}, &oauthutil.RetrieveError{
+					Response: &http.Response{Status: "400 Bad Request", StatusCode: http.StatusBadRequest},
+					Body:     []byte(`{"Error":"invalid_grant"}`),
+				}
+			},
+		}, nil)
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			_, err = cli.GetRepo(context.Background(), OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"})
+			var e *InvalidRefreshTokenError
+			assert.Assert(t, errors.As(err, &e), "got %v", err)
+			assert.Assert(t, errcode.IsUnauthorized(err))
+		}
+		// The rejected refresh token is neither retried nor sent to Azure
+		// DevOps again.
+		assert.Equal(t, int32(1), refreshes.Load())
+		assert.Equal(t, int32(0), requests.Load())
+	})
+}
+
+func TestClient_DoRaw(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/org/_apis/wit/workitems/1", r.URL.Path)
+		assert.Equal(t, "7.0", r.URL.Query().Get("api-version"))
+		_, _, ok := r.BasicAuth()
+		assert.Assert(t, ok)
+		if r.Method == "DELETE" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		w.Header().Set("X-Custom", "value")
+		w.Write([]byte(`{"id": 1}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest("GET", "org/_apis/wit/workitems/1", nil)
+	require.NoError(t, err)
+	var result struct {
+		ID int `json:"id"`
+	}
+	resp, err := cli.DoRaw(context.Background(), req, &result)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.ID)
+	assert.Equal(t, "value", resp.Header.Get("X-Custom"))
+	bs, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, `{"id": 1}`, string(bs))
+
+	req, err = http.NewRequest("DELETE", "org/_apis/wit/workitems/1", nil)
+	require.NoError(t, err)
+	_, err = cli.DoRaw(context.Background(), req, nil)
+	var httpErr *HTTPError
+	require.True(t, errors.As(err, &httpErr))
+	assert.Equal(t, http.StatusForbidden, httpErr.Status())
+}
+
+func TestClient_Gzip(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "gzip", r.Header.Get("Accept-Encoding"))
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		zw := gzip.NewWriter(w)
+		zw.Write([]byte(`{"id": "project-id", "name": "project"}`))
+		zw.Close()
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	project, err := cli.GetProject(context.Background(), "org", "project")
+	require.NoError(t, err)
+	assert.Equal(t, "project-id", project.ID)
+	assert.Equal(t, "project", project.Name)
+
+	// Empty bodies are fine even if they claim to be compressed.
+	resp := &http.Response{
+		StatusCode: http.StatusNoContent,
+		Header:     http.Header{"Content-Encoding": {"gzip"}},
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+	assert.NilError(t, gunzipResponse(resp))
+	bs, err := io.ReadAll(resp.Body)
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(bs))
+}
+
+func TestClient_ProxyInterference(t *testing.T) {
+	tests := map[string]struct {
+		status      int
+		contentType string
+		body        string
+		wantProxy   bool
+		wantErr     bool
+	}{
+		"203 with HTML": {
+			status:      http.StatusNonAuthoritativeInfo,
+			contentType: "text/html",
+			body:        `<html>blocked</html>`,
+			wantProxy:   true,
+		},
+		"203 with JSON": {
+			status:      http.StatusNonAuthoritativeInfo,
+			contentType: "application/json; charset=utf-8",
+			body:        `{"id": "project"}`,
+		},
+		"203 with broken JSON": {
+			status:      http.StatusNonAuthoritativeInfo,
+			contentType: "application/json",
+			body:        `{"id": "project"}<script>`,
+			wantProxy:   true,
+		},
+		"200 with login page": {
+			status:      http.StatusOK,
+			contentType: "text/html; charset=utf-8",
+			body:        `<html>sign in</html>`,
+			wantProxy:   true,
+		},
+		"200 with broken JSON": {
+			status:      http.StatusOK,
+			contentType: "application/json",
+			body:        `{"id":`,
+			wantErr:     true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", tt.contentType)
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			t.Cleanup(srv.Close)
+
+			cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+			require.NoError(t, err)
+
+			project, err := cli.GetProject(context.Background(), "org", "project")
+			var proxyErr *ProxyInterferenceError
+			assert.Equal(t, tt.wantProxy, errors.As(err, &proxyErr))
+			switch {
+			case tt.wantProxy:
+				assert.Equal(t, tt.status, proxyErr.StatusCode)
+				assert.Assert(t, strings.Contains(err.Error(), "proxy"))
+			case tt.wantErr:
+				assert.Assert(t, err != nil)
+			default:
+				assert.NilError(t, err)
+				assert.Equal(t, "project", project.ID)
+			}
+		})
+	}
+}
+
+func TestClient_SetStrictDecode(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"ID": "id", "Name": "project", "somethingNew": true}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	// By default unknown fields are ignored and names match regardless of case.
+	project, err := cli.GetProject(context.Background(), "org", "project")
+	require.NoError(t, err)
+	assert.Equal(t, "id", project.ID)
+	assert.Equal(t, "project", project.Name)
+
+	cli.SetStrictDecode(true)
+	_, err = cli.GetProject(context.Background(), "org", "project")
+	assert.ErrorContains(t, err, `unknown field "somethingNew"`)
+}
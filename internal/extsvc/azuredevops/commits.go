@@ -0,0 +1,310 @@
+package azuredevops
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/sourcegraph/conc/pool"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// Defaults of ListCommitsByProjectOptions.
+const (
+	defaultProjectCommitsPerRepository = 100
+	defaultProjectCommitsConcurrency   = 4
+)
+
+// commitsBatchSize is the maximum number of SHAs GetCommitsBatch sends in a
+// single commitsbatch request, the most commits Azure DevOps returns from a
+// commits endpoint without paging.
+const commitsBatchSize = 100
+
+// GetCommit returns the commit with the given SHA.
+func (c *client) GetCommit(ctx context.Context, args OrgProjectRepoArgs, commitID string) (Commit, error) {
+	reqURL := url.URL{Path: fmt.Sprintf("%s/%s/_apis/git/repositories/%s/commits/%s", args.Org, args.Project, args.RepoNameOrID, commitID)}
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return Commit{}, err
+	}
+
+	var commit Commit
+	if _, err = c.do(ctx, req, "", &commit); err != nil {
+		return Commit{}, err
+	}
+
+	return commit, nil
+}
+
+// GetCommitsBatch returns the commits with the given SHAs using the
+// commitsbatch endpoint, in batches of at most commitsBatchSize SHAs. The
+// returned commits are in the same order as the input SHAs. SHAs are matched
+// case-insensitively, and abbreviated SHAs match the commit they are a prefix
+// of. Any SHAs that could not be found are skipped and reported in the
+// returned error, which will contain one error per missing SHA.
+func (c *client) GetCommitsBatch(ctx context.Context, args OrgProjectRepoArgs, shas []string) ([]Commit, error) {
+	if len(shas) == 0 {
+		return nil, nil
+	}
+
+	var commits []Commit
+	for start := 0; start < len(shas); start += commitsBatchSize {
+		end := start + commitsBatchSize
+		if end > len(shas) {
+			end = len(shas)
+		}
+		batch := shas[start:end]
+		batchCommits, err := c.QueryCommitsBatch(ctx, args, QueryCommitsCriteria{IDs: batch, Top: len(batch)})
+		if err != nil {
+			return nil, err
+		}
+		commits = append(commits, batchCommits...)
+	}
+
+	byID := make(map[string]Commit, len(commits))
+	for _, commit := range commits {
+		byID[strings.ToLower(commit.CommitID)] = commit
+	}
+
+	var errs error
+	result := make([]Commit, 0, len(shas))
+	for _, sha := range shas {
+		commit, ok := findCommit(byID, commits, sha)
+		if !ok {
+			errs = errors.Append(errs, &CommitNotFoundError{CommitID: sha})
+			continue
+		}
+		result = append(result, commit)
+	}
+
+	return result, errs
+}
+
+// findCommit returns the commit of commits, keyed by their lowercase ID in
+// byID, whose ID is sha or, if sha is abbreviated, starts with it.
+func findCommit(byID map[string]Commit, commits []Commit, sha string) (Commit, bool) {
+	sha = strings.ToLower(sha)
+	if commit, ok := byID[sha]; ok {
+		return commit, true
+	}
+	for _, commit := range commits {
+		if strings.HasPrefix(strings.ToLower(commit.CommitID), sha) {
+			return commit, true
+		}
+	}
+	return Commit{}, false
+}
+
+// ListCommits returns the commits matching the given search criteria, a single
+// page of at most criteria.Top commits after skipping criteria.Skip. Azure
+// DevOps returns 100 commits if criteria.Top is unset.
+// criteria.ContinuationToken is not supported.
+func (c *client) ListCommits(ctx context.Context, args OrgProjectRepoArgs, criteria ListCommitsCriteria) ([]Commit, error) {
+	if criteria.ContinuationToken != "" {
+		return nil, errors.New("commits are paged with skip, not continuation tokens")
+	}
+	queryParams, err := criteria.queryParams()
+	if err != nil {
+		return nil, err
+	}
+	criteria.RequestOptions.setQueryParams(queryParams)
+
+	reqURL := url.URL{
+		Path:     fmt.Sprintf("%s/%s/_apis/git/repositories/%s/commits", args.Org, args.Project, args.RepoNameOrID),
+		RawQuery: queryParams.Encode(),
+	}
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var commits ListCommitsResponse
+	if _, err = c.do(ctx, req, "", &commits); err != nil {
+		return nil, err
+	}
+
+	return commits.Value, nil
+}
+
+func (c ListCommitsCriteria) queryParams() (url.Values, error) {
+	return newSearchCriteria().
+		str("author", c.Author).
+		str("committer", c.Committer).
+		dateRange("fromDate", c.FromDate, "toDate", c.ToDate).
+		version("itemVersion", c.ItemVersion).
+		version("compareVersion", c.CompareVersion).
+		str("itemPath", c.ItemPath).
+		int("$top", c.Top).
+		int("$skip", c.Skip).
+		encode()
+}
+
+// ListCommitsByProject returns the commits of all repositories in a project
+// that were committed in the time window of opts, newest first. Azure DevOps
+// has no endpoint listing commits across repositories, so this lists the
+// commits of every non-empty, enabled repository with bounded concurrency and
+// merges the results by commit date.
+func (c *client) ListCommitsByProject(ctx context.Context, org, project string, opts ListCommitsByProjectOptions) ([]ProjectCommit, error) {
+	if opts.FromDate.IsZero() {
+		return nil, errors.New("FromDate is required to bound the number of commits")
+	}
+	top := opts.TopPerRepository
+	if top <= 0 {
+		top = defaultProjectCommitsPerRepository
+	}
+	concurrency := opts.MaxConcurrency
+	if concurrency <= 0 {
+		concurrency = defaultProjectCommitsConcurrency
+	}
+
+	repos, err := c.ListRepositoriesByProjectOrOrg(ctx, ListRepositoriesByProjectOrOrgArgs{ProjectOrOrgName: org + "/" + project})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing repositories")
+	}
+
+	p := pool.NewWithResults[[]ProjectCommit]().
+		WithContext(ctx).
+		WithCancelOnError().
+		WithFirstError().
+		WithMaxGoroutines(concurrency)
+	for _, repo := range repos {
+		// Empty repositories have no default branch, and the commits of
+		// disabled repositories can't be listed.
+		if repo.DefaultBranch == "" || repo.IsDisabled {
+			continue
+		}
+
+		repo := repo
+		p.Go(func(ctx context.Context) ([]ProjectCommit, error) {
+			commits, err := c.ListCommits(ctx, OrgProjectRepoArgs{Org: org, Project: project, RepoNameOrID: repo.ID}, ListCommitsCriteria{
+				FromDate:    opts.FromDate,
+				ToDate:      opts.ToDate,
+				ListOptions: ListOptions{Top: top, RequestOptions: opts.RequestOptions},
+			})
+			if err != nil {
+				return nil, errors.Wrapf(err, "listing commits of repository %s", repo.Name)
+			}
+
+			projectCommits := make([]ProjectCommit, 0, len(commits))
+			for _, commit := range commits {
+				projectCommits = append(projectCommits, ProjectCommit{Repository: repo, Commit: commit})
+			}
+			return projectCommits, nil
+		})
+	}
+
+	perRepo, err := p.Wait()
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []ProjectCommit
+	for _, repoCommits := range perRepo {
+		commits = append(commits, repoCommits...)
+	}
+	sort.SliceStable(commits, func(i, j int) bool {
+		return commits[i].Committer.Date.After(commits[j].Committer.Date)
+	})
+	return commits, nil
+}
+
+// GetMergeBase returns the SHA of the best common ancestor of commitA and
+// commitB. If the commits have more than one merge base, the first one returned
+// by the API is used. A *NoMergeBaseError is returned if the commits share no
+// history.
+func (c *client) GetMergeBase(ctx context.Context, args OrgProjectRepoArgs, commitA, commitB string) (string, error) {
+	bases, err := c.GetMergeBases(ctx, args, commitA, commitB)
+	if err != nil {
+		return "", err
+	}
+	if len(bases) == 0 {
+		return "", &NoMergeBaseError{CommitA: commitA, CommitB: commitB}
+	}
+	return bases[0], nil
+}
+
+// GetMergeBases returns the SHAs of all merge bases of commitA and commitB,
+// which is empty if the commits share no history.
+func (c *client) GetMergeBases(ctx context.Context, args OrgProjectRepoArgs, commitA, commitB string) ([]string, error) {
+	queryParams := make(url.Values)
+	queryParams.Set("otherCommitId", commitB)
+	setAPIVersion(queryParams, mergeBasesAPIVersion)
+
+	reqURL := url.URL{
+		Path:     fmt.Sprintf("%s/%s/_apis/git/repositories/%s/commits/%s/mergebases", args.Org, args.Project, args.RepoNameOrID, commitA),
+		RawQuery: queryParams.Encode(),
+	}
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var commits ListCommitsResponse
+	if _, err = c.do(ctx, req, "", &commits); err != nil {
+		return nil, err
+	}
+
+	bases := make([]string, 0, len(commits.Value))
+	for _, commit := range commits.Value {
+		bases = append(bases, commit.CommitID)
+	}
+	return bases, nil
+}
+
+// QueryCommitsBatch returns the commits matching the given criteria using the
+// commitsbatch endpoint.
+func (c *client) QueryCommitsBatch(ctx context.Context, args OrgProjectRepoArgs, criteria QueryCommitsCriteria) ([]Commit, error) {
+	data, err := json.Marshal(criteria)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling request")
+	}
+
+	reqURL := url.URL{Path: fmt.Sprintf("%s/%s/_apis/git/repositories/%s/commitsbatch", args.Org, args.Project, args.RepoNameOrID)}
+
+	req, err := http.NewRequest("POST", reqURL.String(), bytes.NewBuffer(data))
+	if err != nil {
+		return nil, err
+	}
+
+	var commits ListCommitsResponse
+	if _, err = c.do(ctx, req, "", &commits); err != nil {
+		return nil, err
+	}
+
+	return commits.Value, nil
+}
+
+// CommitNotFoundError is returned when a requested commit does not exist.
+type CommitNotFoundError struct {
+	CommitID string
+}
+
+func (e *CommitNotFoundError) Error() string {
+	return fmt.Sprintf("commit %q not found", e.CommitID)
+}
+
+func (e *CommitNotFoundError) NotFound() bool {
+	return true
+}
+
+// NoMergeBaseError is returned by GetMergeBase if two commits share no history.
+type NoMergeBaseError struct {
+	CommitA, CommitB string
+}
+
+func (e *NoMergeBaseError) Error() string {
+	return fmt.Sprintf("no merge base of commits %s and %s", e.CommitA, e.CommitB)
+}
+
+func (e *NoMergeBaseError) NotFound() bool {
+	return true
+}
@@ -0,0 +1,237 @@
+package azuredevops
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetCommitsBatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/org/project/_apis/git/repositories/repo/commitsbatch", r.URL.Path)
+
+		var criteria QueryCommitsCriteria
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&criteria))
+		assert.Equal(t, []string{"c", "missing", "a"}, criteria.IDs)
+
+		// The API doesn't guarantee the order of the returned commits.
+		json.NewEncoder(w).Encode(ListCommitsResponse{
+			Value: []Commit{{CommitID: "a"}, {CommitID: "c"}},
+			Count: 2,
+		})
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+	commits, err := cli.GetCommitsBatch(context.Background(), args, []string{"c", "missing", "a"})
+	assert.Equal(t, []Commit{{CommitID: "c"}, {CommitID: "a"}}, commits)
+
+	require.Error(t, err)
+	assert.True(t, errcode.IsNotFound(err))
+	var notFound *CommitNotFoundError
+	require.True(t, errors.As(err, &notFound))
+	assert.Equal(t, "missing", notFound.CommitID)
+}
+
+func TestClient_GetCommitsBatch_Chunks(t *testing.T) {
+	shas := make([]string, commitsBatchSize+10)
+	for i := range shas {
+		shas[i] = fmt.Sprintf("%040x", i)
+	}
+
+	var batches [][]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var criteria QueryCommitsCriteria
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&criteria))
+		assert.Equal(t, len(criteria.IDs), criteria.Top)
+		batches = append(batches, criteria.IDs)
+
+		// Azure DevOps returns full SHAs in upper or lower case, whatever
+		// the case of the requested ones.
+		var commits []Commit
+		for _, id := range criteria.IDs {
+			commits = append(commits, Commit{CommitID: strings.ToUpper(id)})
+		}
+		json.NewEncoder(w).Encode(ListCommitsResponse{Value: commits, Count: len(commits)})
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+	commits, err := cli.GetCommitsBatch(context.Background(), args, shas)
+	require.NoError(t, err)
+	require.Len(t, commits, len(shas))
+	assert.Equal(t, strings.ToUpper(shas[len(shas)-1]), commits[len(shas)-1].CommitID)
+
+	require.Len(t, batches, 2)
+	assert.Equal(t, shas[:commitsBatchSize], batches[0])
+	assert.Equal(t, shas[commitsBatchSize:], batches[1])
+}
+
+func TestClient_GetCommitsBatch_AbbreviatedSHAs(t *testing.T) {
+	full := "4b825dc642cb6eb9a060e54bf8d69288fbee4904"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(ListCommitsResponse{Value: []Commit{{CommitID: full}}, Count: 1})
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+	commits, err := cli.GetCommitsBatch(context.Background(), args, []string{"4B825DC642CB6EB9A060E54BF8D69288FBEE4904", "4b825dc", "deadbeef"})
+	assert.Equal(t, []Commit{{CommitID: full}, {CommitID: full}}, commits)
+
+	var notFound *CommitNotFoundError
+	require.True(t, errors.As(err, &notFound))
+	assert.Equal(t, "deadbeef", notFound.CommitID)
+}
+
+func TestClient_ListCommits(t *testing.T) {
+	var got url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "/org/project/_apis/git/repositories/repo/commits", r.URL.Path)
+		got = r.URL.Query()
+		json.NewEncoder(w).Encode(ListCommitsResponse{Value: []Commit{{CommitID: "a"}}, Count: 1})
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+
+	commits, err := cli.ListCommits(ctx, args, ListCommitsCriteria{
+		Author:         "alice@example.com",
+		FromDate:       time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+		ToDate:         time.Date(2023, 5, 8, 0, 0, 0, 0, time.UTC),
+		CompareVersion: &GitVersionDescriptor{Version: "main", VersionType: GitVersionTypeBranch},
+		ListOptions:    ListOptions{Top: 50},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []Commit{{CommitID: "a"}}, commits)
+
+	assert.Equal(t, "alice@example.com", got.Get("searchCriteria.author"))
+	assert.Equal(t, "2023-05-01T00:00:00Z", got.Get("searchCriteria.fromDate"))
+	assert.Equal(t, "2023-05-08T00:00:00Z", got.Get("searchCriteria.toDate"))
+	assert.Equal(t, "main", got.Get("searchCriteria.compareVersion.version"))
+	assert.Equal(t, "branch", got.Get("searchCriteria.compareVersion.versionType"))
+	assert.Equal(t, "50", got.Get("searchCriteria.$top"))
+	assert.False(t, got.Has("searchCriteria.committer"))
+	assert.False(t, got.Has("searchCriteria.itemVersion.version"))
+
+	t.Run("invalid criteria", func(t *testing.T) {
+		for name, criteria := range map[string]ListCommitsCriteria{
+			"date range": {
+				FromDate: time.Date(2023, 5, 8, 0, 0, 0, 0, time.UTC),
+				ToDate:   time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC),
+			},
+			"version type": {
+				ItemVersion: &GitVersionDescriptor{Version: "main", VersionType: "trunk"},
+			},
+		} {
+			_, err := cli.ListCommits(ctx, args, criteria)
+			assert.Error(t, err, name)
+		}
+	})
+}
+
+func TestClient_ListCommitsByProject(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/org/project/_apis/git/repositories":
+			w.Write([]byte(`{"value": [
+				{"id": "a", "name": "repo-a", "defaultBranch": "refs/heads/main"},
+				{"id": "b", "name": "repo-b", "defaultBranch": "refs/heads/main"},
+				{"id": "empty", "name": "empty"},
+				{"id": "disabled", "name": "disabled", "defaultBranch": "refs/heads/main", "isDisabled": true}
+			]}`))
+		case "/org/project/_apis/git/repositories/a/commits":
+			assert.Equal(t, "2023-01-01T00:00:00Z", r.URL.Query().Get("searchCriteria.fromDate"))
+			assert.Equal(t, "100", r.URL.Query().Get("searchCriteria.$top"))
+			w.Write([]byte(`{"value": [
+				{"commitId": "a2", "committer": {"date": "2023-01-04T00:00:00Z"}},
+				{"commitId": "a1", "committer": {"date": "2023-01-02T00:00:00Z"}}
+			]}`))
+		case "/org/project/_apis/git/repositories/b/commits":
+			w.Write([]byte(`{"value": [
+				{"commitId": "b1", "committer": {"date": "2023-01-03T00:00:00Z"}}
+			]}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	_, err = cli.ListCommitsByProject(ctx, "org", "project", ListCommitsByProjectOptions{})
+	assert.Error(t, err)
+
+	commits, err := cli.ListCommitsByProject(ctx, "org", "project", ListCommitsByProjectOptions{
+		FromDate: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	var got []string
+	for _, c := range commits {
+		got = append(got, c.Repository.Name+"@"+c.CommitID)
+	}
+	assert.Equal(t, []string{"repo-a@a2", "repo-b@b1", "repo-a@a1"}, got)
+}
+
+func TestClient_GetMergeBase(t *testing.T) {
+	bases := `[{"commitId": "base1"}, {"commitId": "base2"}]`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/org/project/_apis/git/repositories/repo/commits/a/mergebases", r.URL.Path)
+		assert.Equal(t, "b", r.URL.Query().Get("otherCommitId"))
+		assert.Equal(t, mergeBasesAPIVersion, r.URL.Query().Get("api-version"))
+		w.Write([]byte(`{"value": ` + bases + `}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+
+	all, err := cli.GetMergeBases(ctx, args, "a", "b")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"base1", "base2"}, all)
+
+	base, err := cli.GetMergeBase(ctx, args, "a", "b")
+	require.NoError(t, err)
+	assert.Equal(t, "base1", base)
+
+	bases = `[]`
+	_, err = cli.GetMergeBase(ctx, args, "a", "b")
+	require.Error(t, err)
+	assert.True(t, errcode.IsNotFound(err))
+	var noBase *NoMergeBaseError
+	require.True(t, errors.As(err, &noBase))
+}
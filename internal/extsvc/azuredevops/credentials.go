@@ -0,0 +1,147 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// AuthType is the kind of credentials a client authenticates with.
+type AuthType string
+
+const (
+	// AuthTypePersonalAccessToken is a PAT sent with basic auth.
+	AuthTypePersonalAccessToken AuthType = "pat"
+	// AuthTypeOAuth is an OAuth (or Azure AD) bearer token.
+	AuthTypeOAuth AuthType = "oauth"
+	// AuthTypeUnknown is any other authenticator.
+	AuthTypeUnknown AuthType = "unknown"
+)
+
+// CredentialsInfo describes the credentials of a client, see
+// InspectCredentials.
+type CredentialsInfo struct {
+	AuthType AuthType
+	// Valid is true if the instance accepted the credentials.
+	Valid bool
+	// UserID is the ID of the identity the credentials authenticate as, if
+	// Valid.
+	UserID string
+	// ExpiresAt is when the credentials expire, if that is known. Azure DevOps
+	// doesn't tell the expiry of the PAT a request is authenticated with, so it
+	// is only set for OAuth tokens, see GetTokenInfo.
+	ExpiresAt *time.Time
+}
+
+// ExpiresWithin returns true if the credentials are known to expire within d
+// of now, or have expired already.
+func (i CredentialsInfo) ExpiresWithin(now time.Time, d time.Duration) bool {
+	return i.ExpiresAt != nil && i.ExpiresAt.Before(now.Add(d))
+}
+
+// InspectCredentials reports the kind of credentials the client uses and
+// whether org accepts them, by requesting its connection data. Rejected
+// credentials are reported as not Valid; an error is only returned if the
+// instance couldn't be asked.
+func (c *client) InspectCredentials(ctx context.Context, org string) (CredentialsInfo, error) {
+	info := CredentialsInfo{AuthType: authTypeOf(c.auth), ExpiresAt: c.oauthExpiry()}
+
+	reqURL := url.URL{Path: fmt.Sprintf("%s/_apis/connectionData", org)}
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return CredentialsInfo{}, err
+	}
+
+	var data ConnectionData
+	if _, err = c.do(ctx, req, "", &data); err != nil {
+		var e *HTTPError
+		if errors.As(err, &e) && (e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden) {
+			return info, nil
+		}
+		return CredentialsInfo{}, errors.Wrap(err, "requesting connection data")
+	}
+
+	info.Valid = true
+	info.UserID = data.AuthenticatedUser.ID
+	return info, nil
+}
+
+// TokenInfo describes the token a client authenticates with, see
+// GetTokenInfo.
+type TokenInfo struct {
+	AuthType AuthType
+	// ExpiresAt is when the token expires, if that is known.
+	ExpiresAt *time.Time
+}
+
+// TokenInfoUnsupportedError is returned by GetTokenInfo if the token of the
+// client can't be introspected on its instance.
+type TokenInfoUnsupportedError struct {
+	Reason string
+}
+
+func (e *TokenInfoUnsupportedError) Error() string {
+	return "token introspection is not supported on this instance: " + e.Reason
+}
+
+// GetTokenInfo returns what is known about the token of the client, e.g. to
+// warn admins about tokens close to expiry. Only OAuth tokens of Azure DevOps
+// Services can be introspected, for everything else a
+// *TokenInfoUnsupportedError is returned.
+//
+// There is no way to introspect a PAT with the PAT itself: the PAT lifecycle
+// management API (_apis/tokens/pats) only accepts Azure AD tokens, isn't
+// available on Azure DevOps Server, and lists the tokens of a user without
+// telling which of them authenticated the request. Scopes aren't reported for
+// any kind of token, missing scopes surface as a *MissingScopeError once a
+// request needs them.
+func (c *client) GetTokenInfo(ctx context.Context) (*TokenInfo, error) {
+	if !c.IsAzureDevOpsServices() {
+		return nil, &TokenInfoUnsupportedError{Reason: "Azure DevOps Server has no token API"}
+	}
+
+	authType := authTypeOf(c.auth)
+	switch authType {
+	case AuthTypeOAuth:
+		return &TokenInfo{AuthType: authType, ExpiresAt: c.oauthExpiry()}, nil
+	case AuthTypePersonalAccessToken:
+		return nil, &TokenInfoUnsupportedError{Reason: "personal access tokens can't introspect themselves"}
+	default:
+		return nil, &TokenInfoUnsupportedError{Reason: "unknown kind of credentials"}
+	}
+}
+
+// oauthExpiry returns the expiry of the OAuth token of the client, or nil if
+// it doesn't use one or its expiry is unknown.
+func (c *client) oauthExpiry() *time.Time {
+	t, ok := c.auth.(*auth.OAuthBearerToken)
+	if !ok {
+		return nil
+	}
+
+	// The token might be refreshed concurrently.
+	c.authMu.Lock()
+	expiry := t.Expiry
+	c.authMu.Unlock()
+	if expiry.IsZero() {
+		return nil
+	}
+	return &expiry
+}
+
+func authTypeOf(a auth.Authenticator) AuthType {
+	switch a.(type) {
+	case *auth.BasicAuth, *auth.BasicAuthWithSSH:
+		return AuthTypePersonalAccessToken
+	case *auth.OAuthBearerToken:
+		return AuthTypeOAuth
+	default:
+		return AuthTypeUnknown
+	}
+}
@@ -0,0 +1,117 @@
+package azuredevops
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_InspectCredentials(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/valid/_apis/connectionData":
+			w.Write([]byte(`{"authenticatedUser": {"id": "user-id"}, "instanceId": "instance"}`))
+		case "/invalid/_apis/connectionData":
+			w.WriteHeader(http.StatusUnauthorized)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	ctx := context.Background()
+	expiry := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		auth    auth.Authenticator
+		org     string
+		want    CredentialsInfo
+		wantErr bool
+	}{
+		"valid PAT": {
+			auth: &auth.BasicAuth{Username: "test", Password: "pat"},
+			org:  "valid",
+			want: CredentialsInfo{AuthType: AuthTypePersonalAccessToken, Valid: true, UserID: "user-id"},
+		},
+		"rejected PAT": {
+			auth: &auth.BasicAuth{Username: "test", Password: "expired"},
+			org:  "invalid",
+			want: CredentialsInfo{AuthType: AuthTypePersonalAccessToken},
+		},
+		"OAuth token": {
+			auth: &auth.OAuthBearerToken{Token: "token", Expiry: expiry},
+			org:  "valid",
+			want: CredentialsInfo{AuthType: AuthTypeOAuth, Valid: true, UserID: "user-id", ExpiresAt: &expiry},
+		},
+		"server error": {
+			auth:    &auth.BasicAuth{Username: "test", Password: "pat"},
+			org:     "broken",
+			wantErr: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cli, err := NewClient("test", srv.URL, tc.auth, nil)
+			require.NoError(t, err)
+
+			info, err := cli.InspectCredentials(ctx, tc.org)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, info)
+		})
+	}
+}
+
+func TestCredentialsInfo_ExpiresWithin(t *testing.T) {
+	now := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	expiry := now.Add(48 * time.Hour)
+
+	assert.False(t, CredentialsInfo{}.ExpiresWithin(now, 7*24*time.Hour))
+	assert.True(t, CredentialsInfo{ExpiresAt: &expiry}.ExpiresWithin(now, 7*24*time.Hour))
+	assert.False(t, CredentialsInfo{ExpiresAt: &expiry}.ExpiresWithin(now, 24*time.Hour))
+}
+
+func TestClient_GetTokenInfo(t *testing.T) {
+	ctx := context.Background()
+	expiry := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("OAuth token", func(t *testing.T) {
+		cli, err := NewClient("test", AzureDevOpsAPIURL, &auth.OAuthBearerToken{Token: "token", Expiry: expiry}, nil)
+		require.NoError(t, err)
+
+		info, err := cli.GetTokenInfo(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, &TokenInfo{AuthType: AuthTypeOAuth, ExpiresAt: &expiry}, info)
+	})
+
+	for name, tc := range map[string]struct {
+		url  string
+		auth auth.Authenticator
+	}{
+		"PAT": {
+			url:  AzureDevOpsAPIURL,
+			auth: &auth.BasicAuth{Username: "test", Password: "pat"},
+		},
+		"Azure DevOps Server": {
+			url:  "https://ado.example.com/collection/",
+			auth: &auth.OAuthBearerToken{Token: "token", Expiry: expiry},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cli, err := NewClient("test", tc.url, tc.auth, nil)
+			require.NoError(t, err)
+
+			_, err = cli.GetTokenInfo(ctx)
+			var e *TokenInfoUnsupportedError
+			assert.ErrorAs(t, err, &e)
+		})
+	}
+}
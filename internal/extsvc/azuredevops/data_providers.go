@@ -0,0 +1,58 @@
+package azuredevops
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// DecodeDataProvider decodes the payload of the data provider providerID,
+// e.g. "ms.vss-code-web.repository-overview-data-provider", from data into
+// result. Data providers are contributed by the web UI and extensions, and
+// are queried with a POST to {org}/_apis/Contribution/HierarchyQuery, which
+// has no method of its own: send it with DoRaw, decoding the response into a
+// json.RawMessage. The shape of the payloads is undocumented and may change
+// between versions of Azure DevOps, so prefer the REST endpoints where they
+// offer the same data.
+//
+// If the provider failed, a *DataProviderError is returned.
+func DecodeDataProvider(data []byte, providerID string, result any) error {
+	var resp dataProvidersResponse
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return errors.Wrap(err, "unmarshalling data providers")
+	}
+
+	if e, ok := resp.DataProviderExceptions[providerID]; ok {
+		e.ProviderID = providerID
+		return &e
+	}
+	payload, ok := resp.DataProviders[providerID]
+	if !ok || string(payload) == "null" {
+		return errors.Newf("data provider %q is not in the response", providerID)
+	}
+
+	if err := json.Unmarshal(payload, result); err != nil {
+		return errors.Wrapf(err, "unmarshalling data provider %q", providerID)
+	}
+	return nil
+}
+
+// dataProvidersResponse is the response of contribution queries, which wrap
+// the data of each requested provider rather than returning a list.
+type dataProvidersResponse struct {
+	DataProviders          map[string]json.RawMessage   `json:"dataProviders"`
+	DataProviderExceptions map[string]DataProviderError `json:"dataProviderExceptions"`
+}
+
+// DataProviderError is returned by DecodeDataProvider if the data provider
+// failed to produce its data.
+type DataProviderError struct {
+	ProviderID    string `json:"-"`
+	Message       string `json:"message"`
+	ExceptionType string `json:"exceptionType"`
+}
+
+func (e *DataProviderError) Error() string {
+	return fmt.Sprintf("Azure DevOps data provider %q failed: %s: %s", e.ProviderID, e.ExceptionType, e.Message)
+}
@@ -0,0 +1,38 @@
+package azuredevops
+
+import (
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeDataProvider(t *testing.T) {
+	data := []byte(`{
+		"dataProviderSharedData": {},
+		"dataProviders": {
+			"ms.vss-web.component-data": {},
+			"ms.vss-code-web.repository-overview-data-provider": {"contributorsCount": 3},
+			"ms.vss-code-web.empty-data-provider": null
+		},
+		"dataProviderExceptions": {
+			"ms.vss-code-web.broken-data-provider": {"message": "boom", "exceptionType": "InvalidOperationException"}
+		}
+	}`)
+
+	var overview struct {
+		ContributorsCount int `json:"contributorsCount"`
+	}
+	require.NoError(t, DecodeDataProvider(data, "ms.vss-code-web.repository-overview-data-provider", &overview))
+	assert.Equal(t, 3, overview.ContributorsCount)
+
+	err := DecodeDataProvider(data, "ms.vss-code-web.broken-data-provider", &overview)
+	var e *DataProviderError
+	require.True(t, errors.As(err, &e))
+	assert.Equal(t, "ms.vss-code-web.broken-data-provider", e.ProviderID)
+	assert.Equal(t, "boom", e.Message)
+
+	assert.Error(t, DecodeDataProvider(data, "ms.vss-code-web.empty-data-provider", &overview))
+	assert.Error(t, DecodeDataProvider(data, "ms.vss-code-web.missing-data-provider", &overview))
+}
@@ -0,0 +1,90 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// DedupingClient is a Client that coalesces concurrent identical metadata reads
+// into a single request to the code host: while a call is in flight, callers
+// requesting the same method with the same arguments wait for and share its
+// result. Nothing is cached once a call returns, see CachingClient for that.
+// All other methods are passed through to the wrapped client.
+//
+// The context of the call that started a request is the one used for it, so
+// its cancellation is seen by every caller sharing the request.
+type DedupingClient struct {
+	Client
+
+	group singleflight.Group
+	// joined, if set, is called once a caller started or joined a call in
+	// flight. It is set in tests.
+	joined func()
+}
+
+var _ Client = &DedupingClient{}
+
+// NewDedupingClient returns a DedupingClient wrapping inner.
+func NewDedupingClient(inner Client) *DedupingClient {
+	return &DedupingClient{Client: inner}
+}
+
+func (c *DedupingClient) GetRepo(ctx context.Context, args OrgProjectRepoArgs) (Repository, error) {
+	return dedup(c, flightKey("GetRepo", args), func() (Repository, error) {
+		return c.Client.GetRepo(ctx, args)
+	})
+}
+
+func (c *DedupingClient) GetRepositoryBranch(ctx context.Context, args OrgProjectRepoArgs, branchName string) (Ref, error) {
+	return dedup(c, flightKey("GetRepositoryBranch", args, branchName), func() (Ref, error) {
+		return c.Client.GetRepositoryBranch(ctx, args, branchName)
+	})
+}
+
+func (c *DedupingClient) GetProject(ctx context.Context, org, project string) (Project, error) {
+	return dedup(c, flightKey("GetProject", org, project), func() (Project, error) {
+		return c.Client.GetProject(ctx, org, project)
+	})
+}
+
+func (c *DedupingClient) GetProjectID(ctx context.Context, org, projectName string) (string, error) {
+	return dedup(c, flightKey("GetProjectID", org, projectName), func() (string, error) {
+		return c.Client.GetProjectID(ctx, org, projectName)
+	})
+}
+
+func (c *DedupingClient) GetPullRequest(ctx context.Context, args PullRequestCommonArgs, opts GetPullRequestOptions) (PullRequest, error) {
+	return dedup(c, flightKey("GetPullRequest", args, opts), func() (PullRequest, error) {
+		return c.Client.GetPullRequest(ctx, args, opts)
+	})
+}
+
+func (c *DedupingClient) GetCommit(ctx context.Context, args OrgProjectRepoArgs, commitID string) (Commit, error) {
+	return dedup(c, flightKey("GetCommit", args, commitID), func() (Commit, error) {
+		return c.Client.GetCommit(ctx, args, commitID)
+	})
+}
+
+// flightKey returns the singleflight key for a call of method with args. Args
+// are formatted with %#v so that strings are quoted and keys are unambiguous.
+func flightKey(method string, args ...any) string {
+	return fmt.Sprintf("%s%#v", method, args)
+}
+
+func dedup[T any](c *DedupingClient, key string, fn func() (T, error)) (T, error) {
+	ch := c.group.DoChan(key, func() (any, error) {
+		return fn()
+	})
+	if c.joined != nil {
+		c.joined()
+	}
+
+	res := <-ch
+	if res.Err != nil {
+		var zero T
+		return zero, res.Err
+	}
+	return res.Val.(T), nil
+}
@@ -0,0 +1,71 @@
+package azuredevops
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDedupingClient(t *testing.T) {
+	var requests atomic.Int32
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		<-release
+		w.Write([]byte(`{"id": "repo-id", "name": "repo"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	inner, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+	cli := NewDedupingClient(inner)
+
+	const n = 10
+	var joined sync.WaitGroup
+	joined.Add(n)
+	cli.joined = joined.Done
+
+	ctx := context.Background()
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+
+	var wg sync.WaitGroup
+	repos := make([]Repository, n)
+	errs := make([]error, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			repos[i], errs[i] = cli.GetRepo(ctx, args)
+		}(i)
+	}
+
+	// Only let the request return once all callers joined it.
+	joined.Wait()
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), requests.Load())
+	for i := 0; i < n; i++ {
+		require.NoError(t, errs[i])
+		assert.Equal(t, "repo-id", repos[i].ID)
+	}
+
+	// Results are not cached once the request is done.
+	cli.joined = nil
+	_, err = cli.GetRepo(ctx, args)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestFlightKey(t *testing.T) {
+	assert.Equal(t, flightKey("GetProject", "org", "project"), flightKey("GetProject", "org", "project"))
+	assert.NotEqual(t, flightKey("GetProject", "org", "project"), flightKey("GetProjectID", "org", "project"))
+	assert.NotEqual(t, flightKey("GetProject", "a", "b,c"), flightKey("GetProject", "a,b", "c"))
+}
@@ -0,0 +1,162 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sourcegraph/conc/pool"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const defaultIdentityCacheTTL = 10 * time.Minute
+
+// defaultResolveAuthorsConcurrency is the number of email addresses
+// ResolveCommitAuthors looks up at the same time.
+const defaultResolveAuthorsConcurrency = 4
+
+// maxIdentitiesPerRequest is the number of identity IDs we look up per
+// request, to keep the URL short.
+const maxIdentitiesPerRequest = 50
+
+// resolveDisplayNames returns the display names of the identities with the
+// given IDs in org. Names are cached by the client. Identities that don't exist
+// are missing from the result.
+func (c *client) resolveDisplayNames(ctx context.Context, org string, ids []string) (map[string]string, error) {
+	ctx = withoutAPIVersion(ctx)
+
+	names := make(map[string]string, len(ids))
+	var missing []string
+	for _, id := range ids {
+		if name, ok := c.identityNames.Get(org + "/" + id); ok {
+			names[id] = name
+		} else {
+			missing = append(missing, id)
+		}
+	}
+
+	for len(missing) > 0 {
+		batch := missing
+		if len(batch) > maxIdentitiesPerRequest {
+			batch = batch[:maxIdentitiesPerRequest]
+		}
+		missing = missing[len(batch):]
+
+		identities, err := c.listIdentities(ctx, org, batch)
+		if err != nil {
+			return nil, err
+		}
+		for _, identity := range identities {
+			name := identity.DisplayName()
+			names[identity.ID] = name
+			c.identityNames.Set(org+"/"+identity.ID, name)
+		}
+	}
+
+	return names, nil
+}
+
+// ResolveCommitAuthors returns the users of org with the given email
+// addresses, e.g. of commit authors, keyed by email address as given. Email
+// addresses that don't belong to a user are omitted. If several identities
+// share an email address, active ones are preferred, and of those the one with
+// the lowest ID is picked so that the result is stable. Results are cached by
+// the client, including misses.
+func (c *client) ResolveCommitAuthors(ctx context.Context, org string, emails []string) (map[string]User, error) {
+	var mu sync.Mutex
+	users := make(map[string]User, len(emails))
+
+	p := pool.New().WithContext(ctx).WithCancelOnError().WithFirstError().WithMaxGoroutines(defaultResolveAuthorsConcurrency)
+	for _, email := range emails {
+		email := email
+		p.Go(func(ctx context.Context) error {
+			user, ok, err := c.resolveEmail(ctx, org, email)
+			if err != nil {
+				return errors.Wrapf(err, "resolving %q", email)
+			}
+			if ok {
+				mu.Lock()
+				users[email] = user
+				mu.Unlock()
+			}
+			return nil
+		})
+	}
+	if err := p.Wait(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+// resolveEmail returns the user with the given email address in org, or false
+// if there is none.
+func (c *client) resolveEmail(ctx context.Context, org, email string) (User, bool, error) {
+	key := org + "/" + strings.ToLower(email)
+	if user, ok := c.usersByEmail.Get(key); ok {
+		return user, user.ID != "", nil
+	}
+
+	queryParams := make(url.Values)
+	queryParams.Set("searchFilter", "MailAddress")
+	queryParams.Set("filterValue", email)
+	queryParams.Set("queryMembership", "None")
+	identities, err := c.queryIdentities(ctx, org, queryParams)
+	if err != nil {
+		return User{}, false, err
+	}
+
+	var best *Identity
+	for i := range identities {
+		identity := &identities[i]
+		if best == nil || (identity.IsActive && !best.IsActive) ||
+			(identity.IsActive == best.IsActive && identity.ID < best.ID) {
+			best = identity
+		}
+	}
+
+	// Misses are cached as the zero User.
+	var user User
+	if best != nil {
+		user = User{ID: best.ID, DisplayName: best.DisplayName(), MailAddress: email}
+	}
+	c.usersByEmail.Set(key, user)
+
+	return user, best != nil, nil
+}
+
+func (c *client) listIdentities(ctx context.Context, org string, ids []string) ([]Identity, error) {
+	queryParams := make(url.Values)
+	queryParams.Set("identityIds", strings.Join(ids, ","))
+	queryParams.Set("queryMembership", "None")
+	return c.queryIdentities(ctx, org, queryParams)
+}
+
+func (c *client) queryIdentities(ctx context.Context, org string, queryParams url.Values) ([]Identity, error) {
+	reqURL := c.resolveHost(ctx, serviceVSSPS, fmt.Sprintf("%s/_apis/identities", org))
+	reqURL.RawQuery = queryParams.Encode()
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ListIdentitiesResponse
+	if _, err = c.do(ctx, req, "", &resp); err != nil {
+		return nil, err
+	}
+
+	// The API returns null for IDs that don't resolve to an identity.
+	identities := make([]Identity, 0, len(resp.Value))
+	for _, identity := range resp.Value {
+		if identity != nil {
+			identities = append(identities, *identity)
+		}
+	}
+	return identities, nil
+}
@@ -0,0 +1,56 @@
+package azuredevops
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ResolveCommitAuthors(t *testing.T) {
+	var numRequests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		numRequests.Add(1)
+		assert.Equal(t, "/org/_apis/identities", r.URL.Path)
+		assert.Equal(t, "MailAddress", r.URL.Query().Get("searchFilter"))
+		switch r.URL.Query().Get("filterValue") {
+		case "alice@example.com":
+			w.Write([]byte(`{"count": 1, "value": [{"id": "a", "providerDisplayName": "Alice", "isActive": true}]}`))
+		case "bob@example.com":
+			// Bob has an old, inactive identity and two active ones.
+			w.Write([]byte(`{"count": 3, "value": [
+				{"id": "b0", "providerDisplayName": "Bob (old)", "isActive": false},
+				{"id": "b2", "providerDisplayName": "Bob (MSA)", "isActive": true},
+				{"id": "b1", "providerDisplayName": "Bob", "customDisplayName": "Bobby", "isActive": true}
+			]}`))
+		default:
+			w.Write([]byte(`{"count": 0, "value": []}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	emails := []string{"alice@example.com", "bob@example.com", "nobody@example.com"}
+	want := map[string]User{
+		"alice@example.com": {ID: "a", DisplayName: "Alice", MailAddress: "alice@example.com"},
+		"bob@example.com":   {ID: "b1", DisplayName: "Bobby", MailAddress: "bob@example.com"},
+	}
+
+	users, err := cli.ResolveCommitAuthors(context.Background(), "org", emails)
+	require.NoError(t, err)
+	assert.Equal(t, want, users)
+	assert.Equal(t, int32(3), numRequests.Load())
+
+	// Hits and misses are cached.
+	users, err = cli.ResolveCommitAuthors(context.Background(), "org", emails)
+	require.NoError(t, err)
+	assert.Equal(t, want, users)
+	assert.Equal(t, int32(3), numRequests.Load())
+}
@@ -0,0 +1,305 @@
+package azuredevops
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ErrReadmeNotFound is returned by GetReadme if the repository has no README.
+var ErrReadmeNotFound = errors.New("azuredevops: README not found")
+
+// readmeNames are the file names GetReadme looks for, in order.
+var readmeNames = []string{"README.md", "README", "readme.md"}
+
+// GetItem returns the file or folder at path, including its content if it is a
+// file. If version is nil, the item is looked up on the default branch. An
+// *ItemNotFoundError is returned if there is no item at path.
+func (c *client) GetItem(ctx context.Context, args OrgProjectRepoArgs, path string, version *GitVersionDescriptor) (Item, error) {
+	return c.getItem(ctx, args, path, version, true)
+}
+
+// StatItem is like GetItem, but doesn't download the content of the item. Use
+// it to check whether and what kind of item exists at path.
+func (c *client) StatItem(ctx context.Context, args OrgProjectRepoArgs, path string, version *GitVersionDescriptor) (Item, error) {
+	return c.getItem(ctx, args, path, version, false)
+}
+
+func (c *client) getItem(ctx context.Context, args OrgProjectRepoArgs, path string, version *GitVersionDescriptor, includeContent bool) (Item, error) {
+	queryParams := make(url.Values)
+	queryParams.Set("path", path)
+	queryParams.Set("$format", "json")
+	queryParams.Set("includeContent", strconv.FormatBool(includeContent))
+	setVersionDescriptor(queryParams, "versionDescriptor", version)
+
+	reqURL := url.URL{
+		Path:     fmt.Sprintf("%s/%s/_apis/git/repositories/%s/items", args.Org, args.Project, args.RepoNameOrID),
+		RawQuery: queryParams.Encode(),
+	}
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return Item{}, err
+	}
+
+	var item Item
+	if _, err = c.do(ctx, req, "", &item); err != nil {
+		if isItemNotFound(err) {
+			return Item{}, &ItemNotFoundError{Path: path, Err: err}
+		}
+		return Item{}, err
+	}
+
+	return item, nil
+}
+
+// ListItems returns the items below opts.ScopePath, including the item at
+// ScopePath itself. Listing a large repository with RecursionLevelFull can
+// return a lot of items, so the response is decoded while it is read.
+func (c *client) ListItems(ctx context.Context, args OrgProjectRepoArgs, opts ListItemsOptions) ([]Item, error) {
+	scopePath := opts.ScopePath
+	if scopePath == "" {
+		scopePath = "/"
+	}
+	recursionLevel := opts.RecursionLevel
+	if recursionLevel == "" {
+		recursionLevel = RecursionLevelOneLevel
+	}
+	switch recursionLevel {
+	case RecursionLevelNone, RecursionLevelOneLevel, RecursionLevelFull:
+	default:
+		return nil, errors.Newf("invalid recursion level %q", recursionLevel)
+	}
+
+	queryParams := make(url.Values)
+	queryParams.Set("scopePath", scopePath)
+	queryParams.Set("recursionLevel", string(recursionLevel))
+	queryParams.Set("$format", "json")
+	setVersionDescriptor(queryParams, "versionDescriptor", opts.Version)
+	opts.RequestOptions.setQueryParams(queryParams)
+
+	reqURL := url.URL{
+		Path:     fmt.Sprintf("%s/%s/_apis/git/repositories/%s/items", args.Org, args.Project, args.RepoNameOrID),
+		RawQuery: queryParams.Encode(),
+	}
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.doStream(ctx, req, "")
+	if err != nil {
+		if isItemNotFound(err) {
+			return nil, &ItemNotFoundError{Path: scopePath, Err: err}
+		}
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	items, err := decodeValues[Item](resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "decoding items")
+	}
+	return items, nil
+}
+
+// decodeValues decodes the "value" array of a list response one element at a
+// time, so that the raw response doesn't have to be held in memory in full.
+func decodeValues[T any](r io.Reader) ([]T, error) {
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return nil, err
+	}
+
+	var values []T
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		if key, _ := tok.(string); key != "value" {
+			// Skip other fields, e.g. "count".
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if err := expectDelim(dec, '['); err != nil {
+			return nil, err
+		}
+		for dec.More() {
+			var v T
+			if err := dec.Decode(&v); err != nil {
+				return nil, err
+			}
+			values = append(values, v)
+		}
+		if err := expectDelim(dec, ']'); err != nil {
+			return nil, err
+		}
+	}
+
+	return values, expectDelim(dec, '}')
+}
+
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if got, ok := tok.(json.Delim); !ok || got != want {
+		return errors.Newf("unexpected token %v, want %v", tok, want)
+	}
+	return nil
+}
+
+// setVersionDescriptor sets the query parameters of the items API selecting
+// version, using prefix as in "{prefix}.version".
+func setVersionDescriptor(queryParams url.Values, prefix string, version *GitVersionDescriptor) {
+	if version == nil {
+		return
+	}
+	queryParams.Set(prefix+".version", version.Version)
+	if version.VersionType != "" {
+		queryParams.Set(prefix+".versionType", string(version.VersionType))
+	}
+}
+
+// DownloadRepositoryZip returns a zip archive of the whole repository at
+// version, or at the default branch if version is nil. The archive is streamed
+// and the caller must close it. A *VersionNotFoundError is returned if version
+// doesn't exist.
+func (c *client) DownloadRepositoryZip(ctx context.Context, args OrgProjectRepoArgs, version *GitVersionDescriptor) (io.ReadCloser, error) {
+	queryParams := make(url.Values)
+	queryParams.Set("scopePath", "/")
+	queryParams.Set("$format", "zip")
+	queryParams.Set("download", "true")
+	setVersionDescriptor(queryParams, "versionDescriptor", version)
+
+	reqURL := url.URL{
+		Path:     fmt.Sprintf("%s/%s/_apis/git/repositories/%s/items", args.Org, args.Project, args.RepoNameOrID),
+		RawQuery: queryParams.Encode(),
+	}
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/zip")
+
+	resp, err := c.doStream(ctx, req, "")
+	if err != nil {
+		if isNotFound(err) && version != nil {
+			return nil, &VersionNotFoundError{Version: *version, Err: err}
+		}
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+// GetReadme returns the content and path of the README at the root of the
+// default branch. ErrReadmeNotFound is returned if there is none, e.g. because
+// the repository is empty, but not if the repository itself doesn't exist.
+func (c *client) GetReadme(ctx context.Context, args OrgProjectRepoArgs) ([]byte, string, error) {
+	// Listing the root finds the README with at most one more request, instead
+	// of one per README name.
+	items, err := c.ListItems(ctx, args, ListItemsOptions{RecursionLevel: RecursionLevelOneLevel})
+	if err != nil {
+		var e *ItemNotFoundError
+		if errors.As(err, &e) {
+			return nil, "", ErrReadmeNotFound
+		}
+		return nil, "", err
+	}
+
+	files := make(map[string]bool, len(items))
+	for _, item := range items {
+		if !item.IsFolder {
+			files[item.Path] = true
+		}
+	}
+	for _, name := range readmeNames {
+		if !files["/"+name] {
+			continue
+		}
+		item, err := c.GetItem(ctx, args, "/"+name, nil)
+		if err != nil {
+			return nil, "", err
+		}
+		return []byte(item.Content), item.Path, nil
+	}
+
+	return nil, "", ErrReadmeNotFound
+}
+
+// isItemNotFound returns true if err is a 404 because the requested item
+// doesn't exist, and not because its repository, project or organization
+// doesn't.
+func isItemNotFound(err error) bool {
+	var notFound *NotFoundError
+	if errors.As(err, &notFound) {
+		// The client probed the repository, see classifyNotFound.
+		return notFound.Scope == "resource"
+	}
+	var httpErr *HTTPError
+	if !errors.As(err, &httpErr) || httpErr.StatusCode != http.StatusNotFound {
+		return false
+	}
+	switch httpErr.Code() {
+	case "GitRepositoryNotFoundException", "ProjectDoesNotExistWithNameException", "ProjectDoesNotExistException":
+		return false
+	default:
+		return true
+	}
+}
+
+// ItemNotFoundError is returned when there is no item at the requested path.
+type ItemNotFoundError struct {
+	Path string
+	Err  error
+}
+
+func (e *ItemNotFoundError) Error() string {
+	return fmt.Sprintf("item %q not found: %s", e.Path, e.Err)
+}
+
+func (e *ItemNotFoundError) Unwrap() error {
+	return e.Err
+}
+
+func (e *ItemNotFoundError) NotFound() bool {
+	return true
+}
+
+// VersionNotFoundError is returned when the requested branch, tag or commit
+// doesn't exist.
+type VersionNotFoundError struct {
+	Version GitVersionDescriptor
+	Err     error
+}
+
+func (e *VersionNotFoundError) Error() string {
+	versionType := e.Version.VersionType
+	if versionType == "" {
+		versionType = GitVersionTypeBranch
+	}
+	return fmt.Sprintf("%s %q not found: %v", versionType, e.Version.Version, e.Err)
+}
+
+func (e *VersionNotFoundError) Unwrap() error {
+	return e.Err
+}
+
+func (e *VersionNotFoundError) NotFound() bool {
+	return true
+}
@@ -0,0 +1,270 @@
+package azuredevops
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetItem(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/org/project/_apis/git/repositories/repo/items", r.URL.Path)
+		assert.Equal(t, "/main.go", r.URL.Query().Get("path"))
+		assert.Equal(t, "true", r.URL.Query().Get("includeContent"))
+		assert.Equal(t, "v1.0", r.URL.Query().Get("versionDescriptor.version"))
+		assert.Equal(t, "tag", r.URL.Query().Get("versionDescriptor.versionType"))
+		w.Write([]byte(`{"objectId": "abc", "gitObjectType": "blob", "commitId": "def", "path": "/main.go", "content": "package main"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+	item, err := cli.GetItem(context.Background(), args, "/main.go", &GitVersionDescriptor{Version: "v1.0", VersionType: GitVersionTypeTag})
+	require.NoError(t, err)
+	assert.Equal(t, Item{
+		ObjectID:      "abc",
+		GitObjectType: "blob",
+		CommitID:      "def",
+		Path:          "/main.go",
+		Content:       "package main",
+	}, item)
+}
+
+func TestClient_GetReadme(t *testing.T) {
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+
+	newServer := func(t *testing.T, root string, requests *[]string) *httptest.Server {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			if q.Get("scopePath") != "" {
+				*requests = append(*requests, "list "+q.Get("scopePath"))
+				assert.Equal(t, string(RecursionLevelOneLevel), q.Get("recursionLevel"))
+				w.Write([]byte(root))
+				return
+			}
+			*requests = append(*requests, "get "+q.Get("path"))
+			w.Write([]byte(`{"path": "` + q.Get("path") + `", "content": "hello"}`))
+		}))
+		t.Cleanup(srv.Close)
+		return srv
+	}
+
+	t.Run("found", func(t *testing.T) {
+		var requests []string
+		srv := newServer(t, `{"count": 4, "value": [
+			{"path": "/", "isFolder": true},
+			{"path": "/README.md", "isFolder": true},
+			{"path": "/README"},
+			{"path": "/readme.md"}
+		]}`, &requests)
+
+		cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+		require.NoError(t, err)
+
+		content, path, err := cli.GetReadme(context.Background(), args)
+		require.NoError(t, err)
+		assert.Equal(t, "hello", string(content))
+		assert.Equal(t, "/README", path)
+		assert.Equal(t, []string{"list /", "get /README"}, requests)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		var requests []string
+		srv := newServer(t, `{"count": 2, "value": [{"path": "/", "isFolder": true}, {"path": "/main.go"}]}`, &requests)
+
+		cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+		require.NoError(t, err)
+
+		_, _, err = cli.GetReadme(context.Background(), args)
+		assert.ErrorIs(t, err, ErrReadmeNotFound)
+		assert.Equal(t, []string{"list /"}, requests)
+	})
+
+	t.Run("empty repository", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "TF401174: The item '/' could not be found in the repository.", "typeKey": "GitItemNotFoundException"}`))
+		}))
+		t.Cleanup(srv.Close)
+
+		cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+		require.NoError(t, err)
+
+		_, _, err = cli.GetReadme(context.Background(), args)
+		assert.ErrorIs(t, err, ErrReadmeNotFound)
+	})
+
+	t.Run("missing repository", func(t *testing.T) {
+		requests := 0
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "TF401019: The Git repository with name or identifier repo does not exist or you do not have permissions for the operation you are attempting.", "typeKey": "GitRepositoryNotFoundException"}`))
+		}))
+		t.Cleanup(srv.Close)
+
+		cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+		require.NoError(t, err)
+
+		_, _, err = cli.GetReadme(context.Background(), args)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrReadmeNotFound)
+		assert.True(t, errcode.IsNotFound(err))
+		assert.Equal(t, 1, requests)
+	})
+
+	t.Run("other error", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		t.Cleanup(srv.Close)
+
+		cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+		require.NoError(t, err)
+
+		_, _, err = cli.GetReadme(context.Background(), args)
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrReadmeNotFound)
+	})
+}
+
+func TestClient_StatItem(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "false", r.URL.Query().Get("includeContent"))
+		assert.Equal(t, "json", r.URL.Query().Get("$format"))
+		if r.URL.Path != "/org/project/_apis/git/repositories/repo/items" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"typeKey": "GitRepositoryNotFoundException"}`))
+			return
+		}
+		if r.URL.Query().Get("path") != "/cmd" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"objectId": "abc", "gitObjectType": "tree", "commitId": "def", "path": "/cmd", "isFolder": true}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+
+	item, err := cli.StatItem(ctx, args, "/cmd", nil)
+	require.NoError(t, err)
+	assert.Equal(t, Item{ObjectID: "abc", GitObjectType: "tree", CommitID: "def", Path: "/cmd", IsFolder: true}, item)
+
+	_, err = cli.StatItem(ctx, args, "/missing", nil)
+	var notFound *ItemNotFoundError
+	require.True(t, errors.As(err, &notFound))
+	assert.Equal(t, "/missing", notFound.Path)
+	assert.True(t, errcode.IsNotFound(err))
+
+	// A missing repository is not reported as a missing item.
+	_, err = cli.StatItem(ctx, OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "missing"}, "/cmd", nil)
+	assert.False(t, errors.As(err, &notFound))
+	assert.True(t, errcode.IsNotFound(err))
+}
+
+func TestClient_ListItems(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/org/project/_apis/git/repositories/repo/items", r.URL.Path)
+		assert.Equal(t, "/cmd", r.URL.Query().Get("scopePath"))
+		assert.Equal(t, "full", r.URL.Query().Get("recursionLevel"))
+		assert.Equal(t, "main", r.URL.Query().Get("versionDescriptor.version"))
+		w.Write([]byte(`{"count": 3, "value": [
+			{"objectId": "1", "gitObjectType": "tree", "path": "/cmd", "isFolder": true},
+			{"objectId": "2", "gitObjectType": "tree", "path": "/cmd/app", "isFolder": true, "_links": {"self": {"href": "x"}}},
+			{"objectId": "3", "gitObjectType": "blob", "path": "/cmd/app/main.go"}
+		]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+
+	items, err := cli.ListItems(ctx, args, ListItemsOptions{
+		ScopePath:      "/cmd",
+		RecursionLevel: RecursionLevelFull,
+		Version:        &GitVersionDescriptor{Version: "main"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []Item{
+		{ObjectID: "1", GitObjectType: "tree", Path: "/cmd", IsFolder: true},
+		{ObjectID: "2", GitObjectType: "tree", Path: "/cmd/app", IsFolder: true},
+		{ObjectID: "3", GitObjectType: "blob", Path: "/cmd/app/main.go"},
+	}, items)
+
+	_, err = cli.ListItems(ctx, args, ListItemsOptions{RecursionLevel: "deep"})
+	assert.Error(t, err)
+}
+
+func TestDecodeValues(t *testing.T) {
+	values, err := decodeValues[int](strings.NewReader(`{"count": 2, "value": [1, 2], "extra": {"a": [3]}}`))
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, values)
+
+	values, err = decodeValues[int](strings.NewReader(`{"count": 0}`))
+	require.NoError(t, err)
+	assert.Empty(t, values)
+
+	_, err = decodeValues[int](strings.NewReader(`[1, 2]`))
+	assert.Error(t, err)
+}
+
+func TestClient_DownloadRepositoryZip(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/org/project/_apis/git/repositories/repo/items", r.URL.Path)
+		assert.Equal(t, "application/zip", r.Header.Get("Accept"))
+		assert.Equal(t, "zip", r.URL.Query().Get("$format"))
+		assert.Equal(t, "/", r.URL.Query().Get("scopePath"))
+		assert.Equal(t, "true", r.URL.Query().Get("download"))
+
+		if r.URL.Query().Get("versionDescriptor.version") == "missing" {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "TF401175: The version descriptor <Branch: missing > could not be resolved to a version in the repository repo"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/zip")
+		w.Write([]byte("PK\x03\x04zip"))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+
+	t.Run("found", func(t *testing.T) {
+		body, err := cli.DownloadRepositoryZip(context.Background(), args, &GitVersionDescriptor{Version: "main"})
+		require.NoError(t, err)
+		t.Cleanup(func() { body.Close() })
+
+		bs, err := io.ReadAll(body)
+		require.NoError(t, err)
+		assert.Equal(t, "PK\x03\x04zip", string(bs))
+	})
+
+	t.Run("missing version", func(t *testing.T) {
+		_, err := cli.DownloadRepositoryZip(context.Background(), args, &GitVersionDescriptor{Version: "missing"})
+		var e *VersionNotFoundError
+		require.True(t, errors.As(err, &e))
+		assert.Equal(t, "missing", e.Version.Version)
+		assert.True(t, errcode.IsNotFound(err))
+	})
+}
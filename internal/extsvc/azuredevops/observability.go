@@ -0,0 +1,112 @@
+package azuredevops
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/sourcegraph/log"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
+	"github.com/sourcegraph/sourcegraph/internal/oauthutil"
+	"github.com/sourcegraph/sourcegraph/internal/trace"
+)
+
+// The time a request spends waiting for our rate limiters and the time of the
+// HTTP round trip are measured separately, to tell whether slow syncs are
+// caused by self-imposed throttling or by the code host.
+var (
+	rateLimitWaitDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "src_azuredevops_rate_limit_wait_duration_seconds",
+		Help:    "Time (in seconds) requests to Azure DevOps spent waiting for rate limits.",
+		Buckets: prometheus.DefBuckets,
+	})
+	requestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "src_azuredevops_request_duration_seconds",
+		Help:    "Time (in seconds) spent on the HTTP round trip of requests to Azure DevOps.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"code", "job"})
+)
+
+type jobIDKey struct{}
+
+// WithJobID returns a context that tags requests made using it with id, e.g.
+// the ID of the sync job making them, to correlate them in logs and traces.
+// The ID is only added to metrics if enabled with Client.SetJobIDMetricLabel.
+func WithJobID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, jobIDKey{}, id)
+}
+
+func jobID(ctx context.Context) string {
+	id, _ := ctx.Value(jobIDKey{}).(string)
+	return id
+}
+
+// traceAttributes returns attrs and the job ID of ctx, if any.
+func traceAttributes(ctx context.Context, attrs ...attribute.KeyValue) []attribute.KeyValue {
+	if id := jobID(ctx); id != "" {
+		attrs = append(attrs, attribute.String("job.id", id))
+	}
+	return attrs
+}
+
+// observedWaitForRateLimits is waitForRateLimits, measured and traced.
+func (c *client) observedWaitForRateLimits(ctx context.Context) (err error) {
+	tr, ctx := trace.New(ctx, "AzureDevOps", "waitForRateLimits", traceAttributes(ctx)...)
+	start := time.Now()
+	defer func() {
+		rateLimitWaitDuration.Observe(time.Since(start).Seconds())
+		tr.FinishWithErr(&err)
+	}()
+
+	return c.waitForRateLimits(ctx)
+}
+
+// observedDoRequest sends req, measuring, tracing and logging the round trip.
+func (c *client) observedDoRequest(ctx context.Context, logger log.Logger, httpClient httpcli.Doer, req *http.Request) (resp *http.Response, err error) {
+	tr, ctx := trace.New(ctx, "AzureDevOps", "request", traceAttributes(ctx,
+		attribute.String("method", req.Method),
+		attribute.Stringer("url", req.URL))...)
+	start := time.Now()
+	defer func() {
+		code := "error"
+		if resp != nil {
+			code = strconv.Itoa(resp.StatusCode)
+			tr.SetAttributes(attribute.Int("status", resp.StatusCode))
+		}
+		job := ""
+		if c.jobIDMetricLabel {
+			job = jobID(ctx)
+		}
+		requestDuration.WithLabelValues(code, job).Observe(time.Since(start).Seconds())
+		tr.FinishWithErr(&err)
+	}()
+
+	// The token the request is sent with is recorded on its context, so that a
+	// 401 for a token that was refreshed since doesn't refresh it again.
+	ctx = withSentToken(ctx)
+	resp, err = oauthutil.DoRequest(ctx, logger, httpClient, req.WithContext(ctx), c.requestAuth)
+	if err != nil {
+		logger.Warn("request failed", log.String("method", req.Method), log.String("url", req.URL.String()), log.Error(err))
+		return nil, err
+	}
+
+	fields := []log.Field{
+		log.String("method", req.Method),
+		log.String("url", req.URL.String()),
+		log.Int("status", resp.StatusCode),
+		log.Duration("duration", time.Since(start)),
+	}
+	// Client errors such as 404s are often expected by the caller, so only
+	// server errors are logged as failures.
+	if resp.StatusCode >= 500 {
+		logger.Warn("request failed", fields...)
+	} else {
+		logger.Debug("request", fields...)
+	}
+	return resp, nil
+}
@@ -0,0 +1,111 @@
+package azuredevops
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/sourcegraph/log"
+	"github.com/sourcegraph/log/logtest"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Observability(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	// Hold requests back for a bit, as if Azure DevOps throttled us.
+	c := cli.(*client)
+	c.throttledUntil.Store(time.Now().Add(50 * time.Millisecond).UnixNano())
+
+	waits, waited := histogramStats(t, rateLimitWaitDuration)
+	requests, _ := histogramStats(t, requestDuration.WithLabelValues("200", "").(prometheus.Histogram))
+
+	_, err = cli.GetProject(context.Background(), "org", "project")
+	require.NoError(t, err)
+
+	newWaits, newWaited := histogramStats(t, rateLimitWaitDuration)
+	newRequests, _ := histogramStats(t, requestDuration.WithLabelValues("200", "").(prometheus.Histogram))
+	assert.Equal(t, waits+1, newWaits)
+	assert.GreaterOrEqual(t, newWaited-waited, 0.04)
+	assert.Equal(t, requests+1, newRequests)
+}
+
+func TestClient_JobIDMetricLabel(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	ctx := WithJobID(context.Background(), "sync-1")
+	untagged := requestDuration.WithLabelValues("200", "").(prometheus.Histogram)
+	tagged := requestDuration.WithLabelValues("200", "sync-1").(prometheus.Histogram)
+
+	// The job ID is not added to metrics by default.
+	untaggedBefore, _ := histogramStats(t, untagged)
+	taggedBefore, _ := histogramStats(t, tagged)
+	_, err = cli.GetProject(ctx, "org", "project")
+	require.NoError(t, err)
+	untaggedAfter, _ := histogramStats(t, untagged)
+	taggedAfter, _ := histogramStats(t, tagged)
+	assert.Equal(t, untaggedBefore+1, untaggedAfter)
+	assert.Equal(t, taggedBefore, taggedAfter)
+
+	cli.SetJobIDMetricLabel(true)
+	_, err = cli.GetProject(ctx, "org", "project")
+	require.NoError(t, err)
+	taggedAfter, _ = histogramStats(t, tagged)
+	assert.Equal(t, taggedBefore+1, taggedAfter)
+}
+
+func histogramStats(t *testing.T, h prometheus.Histogram) (count uint64, sum float64) {
+	t.Helper()
+	var m dto.Metric
+	require.NoError(t, h.Write(&m))
+	return m.GetHistogram().GetSampleCount(), m.GetHistogram().GetSampleSum()
+}
+
+func TestClient_JobIDLogging(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/org/_apis/projects/broken" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+	logger, exportLogs := logtest.Captured(t)
+	cli.(*client).logger = logger
+
+	ctx := WithJobID(context.Background(), "sync-1")
+	_, err = cli.GetProject(ctx, "org", "project")
+	require.NoError(t, err)
+	_, err = cli.GetProject(ctx, "org", "broken")
+	require.Error(t, err)
+
+	logs := exportLogs().Filter(func(l logtest.CapturedLog) bool { return l.Fields["jobID"] == "sync-1" })
+	require.Len(t, logs, 2)
+	assert.Equal(t, log.LevelDebug, logs[0].Level)
+	assert.Equal(t, "request", logs[0].Message)
+	assert.EqualValues(t, http.StatusOK, logs[0].Fields["status"])
+	assert.Equal(t, log.LevelWarn, logs[1].Level)
+	assert.Equal(t, "request failed", logs[1].Message)
+	assert.EqualValues(t, http.StatusInternalServerError, logs[1].Fields["status"])
+}
@@ -0,0 +1,96 @@
+package azuredevops
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ListOptions are the paging and request options embedded in the options of
+// every list method whose endpoint pages. The zero value lists all results from
+// the start. Endpoints that return all results in a single response, e.g. those
+// listing items, PR threads and PR reviewers, and methods combining several
+// lists, e.g. ListCommitsByProject, have no paging options.
+//
+// Azure DevOps endpoints page either with $skip or with continuation tokens,
+// so each method documents which of Skip and ContinuationToken it supports,
+// and returns an error if the other one is set.
+type ListOptions struct {
+	// Top is the maximum number of results returned. If zero, all results
+	// are returned.
+	Top int
+	// Skip is the number of results to skip.
+	Skip int
+	// ContinuationToken continues listing after the results returned by an
+	// earlier call with Top set. The token of the last response is recorded
+	// in ResponseMeta.ContinuationToken.
+	ContinuationToken string
+
+	RequestOptions
+}
+
+// setQueryParams sets the query parameters selecting the first page of opts.
+func (o ListOptions) setQueryParams(queryParams url.Values) {
+	if o.Top > 0 {
+		queryParams.Set("$top", strconv.Itoa(o.Top))
+	}
+	if o.Skip > 0 {
+		queryParams.Set("$skip", strconv.Itoa(o.Skip))
+	}
+	if o.ContinuationToken != "" {
+		queryParams.Set("continuationToken", o.ContinuationToken)
+	}
+	o.RequestOptions.setQueryParams(queryParams)
+}
+
+// listResponse is the response of list endpoints.
+type listResponse[T any] struct {
+	Value []T `json:"value"`
+	Count int `json:"count"`
+}
+
+// listPages lists the values of path, an endpoint paging with continuation
+// tokens, following them until opts.Top values were fetched or there are no
+// more pages. queryParams are the endpoint specific parameters. With a
+// context created by WithPartialResults, the values fetched so far are
+// returned along with ErrRateLimited.
+func listPages[T any](ctx context.Context, c *client, path string, queryParams url.Values, opts ListOptions) ([]T, error) {
+	if opts.Skip > 0 {
+		return nil, errors.New("skip is not supported by this endpoint, use a continuation token")
+	}
+	opts.setQueryParams(queryParams)
+
+	reqURL := url.URL{Path: path}
+
+	var values []T
+	for {
+		reqURL.RawQuery = queryParams.Encode()
+		req, err := http.NewRequest("GET", reqURL.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp listResponse[T]
+		continuationToken, err := c.do(ctx, req, "", &resp)
+		if err != nil {
+			if errors.Is(err, ErrRateLimited) {
+				return values, err
+			}
+			return nil, err
+		}
+		values = append(values, resp.Value...)
+
+		if continuationToken == "" || (opts.Top > 0 && len(values) >= opts.Top) {
+			break
+		}
+		queryParams.Set("continuationToken", continuationToken)
+	}
+
+	if opts.Top > 0 && len(values) > opts.Top {
+		values = values[:opts.Top]
+	}
+	return values, nil
+}
@@ -0,0 +1,72 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListPages(t *testing.T) {
+	// Serves refs 0 to 9 in pages of $top refs, 2 by default.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		start, _ := strconv.Atoi(q.Get("continuationToken"))
+		top := 2
+		if q.Has("$top") {
+			top, _ = strconv.Atoi(q.Get("$top"))
+		}
+		end := start + top
+		if end < 10 {
+			w.Header().Set(continuationTokenHeader, strconv.Itoa(end))
+		} else {
+			end = 10
+		}
+		var values []string
+		for i := start; i < end; i++ {
+			values = append(values, fmt.Sprintf(`{"name": "refs/heads/%d"}`, i))
+		}
+		fmt.Fprintf(w, `{"count": %d, "value": [%s]}`, len(values), strings.Join(values, ", "))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+	names := func(refs []Ref) (names []string) {
+		for _, r := range refs {
+			names = append(names, ShortRefName(r.Name))
+		}
+		return names
+	}
+
+	refs, err := cli.ListRefs(ctx, args, ListRefsOptions{})
+	require.NoError(t, err)
+	assert.Len(t, refs, 10)
+
+	var meta ResponseMeta
+	refs, err = cli.ListRefs(WithResponseMeta(ctx, &meta), args, ListRefsOptions{ListOptions: ListOptions{Top: 3}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0", "1", "2"}, names(refs))
+	assert.Equal(t, "3", meta.ContinuationToken)
+
+	refs, err = cli.ListRefs(ctx, args, ListRefsOptions{ListOptions: ListOptions{Top: 3, ContinuationToken: meta.ContinuationToken}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3", "4", "5"}, names(refs))
+
+	refs, err = cli.ListRefs(ctx, args, ListRefsOptions{ListOptions: ListOptions{ContinuationToken: "7"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"7", "8", "9"}, names(refs))
+
+	_, err = cli.ListRefs(ctx, args, ListRefsOptions{ListOptions: ListOptions{Skip: 1}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,227 @@
+package azuredevops
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ListPipelines returns the YAML pipelines of the project, following
+// continuation tokens until all pipelines or opts.Top pipelines were fetched.
+// Classic build definitions are not included. opts.Skip is not supported.
+func (c *client) ListPipelines(ctx context.Context, org, project string, opts ListPipelinesOptions) ([]Pipeline, error) {
+	return listPages[Pipeline](ctx, c, fmt.Sprintf("%s/%s/_apis/pipelines", org, project), make(url.Values), opts.ListOptions)
+}
+
+// ListBuildDefinitions returns the build definitions of the project, which
+// include YAML pipelines as well as classic build definitions, optionally only
+// those building the repository opts.RepositoryID. opts.Skip is not
+// supported.
+func (c *client) ListBuildDefinitions(ctx context.Context, org, project string, opts ListBuildDefinitionsOptions) ([]BuildDefinition, error) {
+	queryParams := make(url.Values)
+	// Triggers are only included with all properties.
+	queryParams.Set("includeAllProperties", "true")
+	if opts.RepositoryID != "" {
+		repoType := opts.RepositoryType
+		if repoType == "" {
+			repoType = BuildRepositoryTypeTfsGit
+		}
+		queryParams.Set("repositoryId", opts.RepositoryID)
+		queryParams.Set("repositoryType", repoType)
+	} else if opts.RepositoryType != "" {
+		return nil, errors.New("repository type requires a repository ID")
+	}
+
+	return listPages[BuildDefinition](ctx, c, fmt.Sprintf("%s/%s/_apis/build/definitions", org, project), queryParams, opts.ListOptions)
+}
+
+// RunPipeline queues a run of the pipeline with the ID input.PipelineID. If
+// input.DryRun is set, the run is only validated and the returned run has no ID
+// but the FinalYAML the run would use.
+func (c *client) RunPipeline(ctx context.Context, org, project string, input RunPipelineInput) (PipelineRun, error) {
+	body := runPipelineRequest{
+		Resources:          runResources{Repositories: map[string]repositoryResource{}},
+		TemplateParameters: input.TemplateParameters,
+		PreviewRun:         input.DryRun,
+	}
+	if input.RefName != "" {
+		ref, err := validateRefName(input.RefName)
+		if err != nil {
+			return PipelineRun{}, err
+		}
+		body.Resources.Repositories["self"] = repositoryResource{RefName: ref}
+	}
+	if len(input.Variables) > 0 {
+		body.Variables = make(map[string]variable, len(input.Variables))
+		for name, value := range input.Variables {
+			body.Variables[name] = variable{Value: value}
+		}
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return PipelineRun{}, errors.Wrap(err, "marshalling request")
+	}
+
+	reqURL := url.URL{Path: fmt.Sprintf("%s/%s/_apis/pipelines/%d/runs", org, project, input.PipelineID)}
+
+	req, err := http.NewRequest("POST", reqURL.String(), bytes.NewBuffer(data))
+	if err != nil {
+		return PipelineRun{}, err
+	}
+
+	var run PipelineRun
+	if _, err = c.do(ctx, req, "", &run); err != nil {
+		return PipelineRun{}, err
+	}
+
+	return run, nil
+}
+
+// GetPipelineRun returns the run with the ID runID of the pipeline with the ID
+// pipelineID, e.g. to poll its state.
+func (c *client) GetPipelineRun(ctx context.Context, org, project string, pipelineID, runID int) (PipelineRun, error) {
+	reqURL := url.URL{Path: fmt.Sprintf("%s/%s/_apis/pipelines/%d/runs/%d", org, project, pipelineID, runID)}
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return PipelineRun{}, err
+	}
+
+	var run PipelineRun
+	if _, err = c.do(ctx, req, "", &run); err != nil {
+		return PipelineRun{}, err
+	}
+
+	return run, nil
+}
+
+// runPipelineRequest is the body of a request to run a pipeline.
+type runPipelineRequest struct {
+	Resources          runResources        `json:"resources"`
+	Variables          map[string]variable `json:"variables,omitempty"`
+	TemplateParameters map[string]string   `json:"templateParameters,omitempty"`
+	PreviewRun         bool                `json:"previewRun,omitempty"`
+}
+
+type runResources struct {
+	Repositories map[string]repositoryResource `json:"repositories,omitempty"`
+}
+
+type repositoryResource struct {
+	RefName string `json:"refName"`
+}
+
+type variable struct {
+	Value string `json:"value"`
+}
+
+// ListBuildArtifacts returns the artifacts published by the build with the ID
+// buildID. The ID of a pipeline run is also the ID of its build.
+func (c *client) ListBuildArtifacts(ctx context.Context, org, project string, buildID int) ([]BuildArtifact, error) {
+	reqURL := url.URL{Path: fmt.Sprintf("%s/%s/_apis/build/builds/%d/artifacts", org, project, buildID)}
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ListBuildArtifactsResponse
+	if _, err = c.do(ctx, req, "", &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Value, nil
+}
+
+// DownloadBuildArtifact streams the zip archive of artifact. The caller must
+// close the returned reader.
+//
+// The download URL of an artifact is absolute and may point at a host other
+// than the one of the client. The request is only authenticated if the host is
+// the one of the client or, on Azure DevOps Services, one of its artifact
+// hosts. Any other host is sent the URL as is without credentials.
+func (c *client) DownloadBuildArtifact(ctx context.Context, artifact BuildArtifact) (io.ReadCloser, error) {
+	downloadURL, err := url.Parse(artifact.Resource.DownloadURL)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing artifact download URL")
+	}
+	if !downloadURL.IsAbs() {
+		return nil, errors.Newf("artifact %q has no absolute download URL", artifact.Name)
+	}
+
+	if !c.isArtifactHost(downloadURL) {
+		return c.downloadUnauthenticated(ctx, downloadURL)
+	}
+
+	// An absolute request URL is used as is by send rather than resolved
+	// against the URL of the client.
+	req, err := http.NewRequest("GET", downloadURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/zip")
+
+	resp, err := c.doStream(ctx, req, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+// artifactHostSuffixes are the suffixes of the hosts of Azure DevOps Services
+// that serve build artifacts with the credentials of the client.
+var artifactHostSuffixes = []string{".dev.azure.com", ".visualstudio.com"}
+
+// isArtifactHost reports whether the credentials of the client may be sent to
+// the host of u.
+func (c *client) isArtifactHost(u *url.URL) bool {
+	if strings.EqualFold(u.Host, c.URL.Host) && u.Scheme == c.URL.Scheme {
+		return true
+	}
+	if !c.IsAzureDevOpsServices() || u.Scheme != "https" || u.Port() != "" {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, suffix := range artifactHostSuffixes {
+		if strings.HasSuffix(host, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// downloadUnauthenticated streams the body of a GET request of u, which is
+// sent without the credentials, api-version or any other additions of the
+// client.
+func (c *client) downloadUnauthenticated(ctx context.Context, u *url.URL) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/zip")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		bs, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		return nil, &HTTPError{Method: req.Method, URL: req.URL, StatusCode: resp.StatusCode, Body: bs}
+	}
+
+	return resp.Body, nil
+}
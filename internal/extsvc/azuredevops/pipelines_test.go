@@ -0,0 +1,210 @@
+package azuredevops
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ListPipelines(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/org/project/_apis/pipelines", r.URL.Path)
+		if r.URL.Query().Get("continuationToken") == "" {
+			w.Header().Set(continuationTokenHeader, "next")
+			w.Write([]byte(`{"count": 1, "value": [{"id": 1, "name": "ci", "folder": "\\"}]}`))
+			return
+		}
+		w.Write([]byte(`{"count": 1, "value": [{"id": 2, "name": "release", "folder": "\\"}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	pipelines, err := cli.ListPipelines(context.Background(), "org", "project", ListPipelinesOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []Pipeline{
+		{ID: 1, Name: "ci", Folder: `\`},
+		{ID: 2, Name: "release", Folder: `\`},
+	}, pipelines)
+
+	pipelines, err = cli.ListPipelines(context.Background(), "org", "project", ListPipelinesOptions{ListOptions: ListOptions{Top: 1}})
+	require.NoError(t, err)
+	assert.Equal(t, []Pipeline{{ID: 1, Name: "ci", Folder: `\`}}, pipelines)
+}
+
+func TestClient_ListBuildDefinitions(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/org/project/_apis/build/definitions", r.URL.Path)
+		q := r.URL.Query()
+		assert.Equal(t, "true", q.Get("includeAllProperties"))
+		assert.Equal(t, "repo-id", q.Get("repositoryId"))
+		assert.Equal(t, "TfsGit", q.Get("repositoryType"))
+		if q.Get("continuationToken") == "" {
+			w.Header().Set(continuationTokenHeader, "next")
+			w.Write([]byte(`{"count": 1, "value": [{"id": 1, "name": "ci", "path": "\\", "repository": {"id": "repo-id", "type": "TfsGit"}, "triggers": [
+				{"triggerType": "continuousIntegration", "branchFilters": ["+refs/heads/main", "-refs/heads/wip/*", "refs/heads/releases/*"]},
+				{"triggerType": "pullRequest", "branchFilters": ["+refs/heads/main"]}
+			]}]}`))
+			return
+		}
+		w.Write([]byte(`{"count": 1, "value": [{"id": 2, "name": "yaml", "repository": {"id": "repo-id", "type": "TfsGit"}, "triggers": [{"triggerType": "continuousIntegration", "settingsSourceType": 2}]}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	definitions, err := cli.ListBuildDefinitions(context.Background(), "org", "project", ListBuildDefinitionsOptions{RepositoryID: "repo-id"})
+	require.NoError(t, err)
+	require.Len(t, definitions, 2)
+	assert.Equal(t, "ci", definitions[0].Name)
+	assert.Equal(t, []string{"refs/heads/main", "refs/heads/releases/*"}, definitions[0].TriggerBranches())
+	assert.Equal(t, 2, definitions[1].ID)
+	assert.Empty(t, definitions[1].TriggerBranches())
+
+	_, err = cli.ListBuildDefinitions(context.Background(), "org", "project", ListBuildDefinitionsOptions{RepositoryType: BuildRepositoryTypeGitHub})
+	assert.Error(t, err)
+}
+
+func TestClient_RunPipeline(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case "POST":
+			assert.Equal(t, "/org/project/_apis/pipelines/1/runs", r.URL.Path)
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{
+				"resources": {"repositories": {"self": {"refName": "refs/heads/feature"}}},
+				"variables": {"target": {"value": "staging"}}
+			}`, string(body))
+			w.Write([]byte(`{"id": 7, "name": "20230501.1", "state": "inProgress", "pipeline": {"id": 1, "name": "ci"}}`))
+		case "GET":
+			assert.Equal(t, "/org/project/_apis/pipelines/1/runs/7", r.URL.Path)
+			w.Write([]byte(`{"id": 7, "name": "20230501.1", "state": "completed", "result": "succeeded", "pipeline": {"id": 1, "name": "ci"}}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	run, err := cli.RunPipeline(ctx, "org", "project", RunPipelineInput{
+		PipelineID: 1,
+		RefName:    "refs/heads/feature",
+		Variables:  map[string]string{"target": "staging"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, PipelineRunStateInProgress, run.State)
+	assert.Equal(t, PipelineRunResult(""), run.Result)
+
+	run, err = cli.GetPipelineRun(ctx, "org", "project", 1, run.ID)
+	require.NoError(t, err)
+	assert.Equal(t, PipelineRunStateCompleted, run.State)
+	assert.Equal(t, PipelineRunResultSucceeded, run.Result)
+	assert.Equal(t, Pipeline{ID: 1, Name: "ci"}, run.Pipeline)
+}
+
+func TestClient_RunPipeline_DryRun(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"resources": {}, "previewRun": true}`, string(body))
+		w.Write([]byte(`{"id": -1, "finalYaml": "steps:\n- script: make\n", "pipeline": {"id": 1, "name": "ci"}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	run, err := cli.RunPipeline(context.Background(), "org", "project", RunPipelineInput{PipelineID: 1, DryRun: true})
+	require.NoError(t, err)
+	assert.Equal(t, "steps:\n- script: make\n", run.FinalYAML)
+}
+
+func TestClient_BuildArtifacts(t *testing.T) {
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The storage host is neither the one of the client nor one of
+		// Azure DevOps, so it must not be sent the credentials.
+		assert.Equal(t, "/artifacts/1", r.URL.Path)
+		assert.Equal(t, "artifactName=sarif&$format=zip", r.URL.RawQuery)
+		assert.Empty(t, r.Header.Get("Authorization"))
+		w.Write([]byte("PK\x03\x04sarif"))
+	}))
+	t.Cleanup(storage.Close)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/org/project/_apis/build/builds/7/artifacts/sarif" {
+			assert.NotEmpty(t, r.Header.Get("Authorization"))
+			w.Write([]byte("PK\x03\x04local"))
+			return
+		}
+		assert.Equal(t, "/org/project/_apis/build/builds/7/artifacts", r.URL.Path)
+		w.Write([]byte(`{"count": 1, "value": [{"id": 1, "name": "sarif", "source": "x", "resource": {
+			"type": "Container",
+			"data": "#/1/sarif",
+			"url": "` + storage.URL + `/artifacts/1/meta",
+			"downloadUrl": "` + storage.URL + `/artifacts/1?artifactName=sarif&$format=zip"
+		}}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	artifacts, err := cli.ListBuildArtifacts(ctx, "org", "project", 7)
+	require.NoError(t, err)
+	require.Len(t, artifacts, 1)
+	assert.Equal(t, "sarif", artifacts[0].Name)
+	assert.Equal(t, "Container", artifacts[0].Resource.Type)
+
+	body, err := cli.DownloadBuildArtifact(ctx, artifacts[0])
+	require.NoError(t, err)
+	t.Cleanup(func() { body.Close() })
+	bs, err := io.ReadAll(body)
+	require.NoError(t, err)
+	assert.Equal(t, "PK\x03\x04sarif", string(bs))
+
+	body, err = cli.DownloadBuildArtifact(ctx, BuildArtifact{Name: "sarif", Resource: BuildArtifactResource{
+		Type:        "Container",
+		DownloadURL: srv.URL + "/org/project/_apis/build/builds/7/artifacts/sarif",
+	}})
+	require.NoError(t, err)
+	t.Cleanup(func() { body.Close() })
+	bs, err = io.ReadAll(body)
+	require.NoError(t, err)
+	assert.Equal(t, "PK\x03\x04local", string(bs))
+
+	_, err = cli.DownloadBuildArtifact(ctx, BuildArtifact{Name: "share", Resource: BuildArtifactResource{Type: "FilePath", Data: `\\share\drop`}})
+	assert.Error(t, err)
+}
+
+func TestClient_isArtifactHost(t *testing.T) {
+	cli, err := NewClient("test", AzureDevOpsAPIURL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+	c := cli.(*client)
+
+	for rawURL, want := range map[string]bool{
+		"https://dev.azure.com/org/_apis/resources/Containers/1":                 true,
+		"https://artprodcus3.artifacts.visualstudio.com/org/_apis/artifact/x":    true,
+		"https://vsblobprodcus3.vsblob.visualstudio.com/blob/1":                  true,
+		"http://artprodcus3.artifacts.visualstudio.com/org/_apis/artifact/x":     false,
+		"https://artprodcus3.artifacts.visualstudio.com:8443/org/_apis/artifact": false,
+		"https://storage.example.com/artifacts/1":                                false,
+		"https://dev.azure.com.example.com/artifacts/1":                          false,
+	} {
+		u, err := url.Parse(rawURL)
+		require.NoError(t, err)
+		assert.Equal(t, want, c.isArtifactHost(u), rawURL)
+	}
+}
@@ -0,0 +1,253 @@
+package azuredevops
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// IDs of the built-in policy types. They are the same on all Azure DevOps
+// instances.
+const (
+	PolicyTypeMinimumReviewers  = "fa4e907d-c16b-4a4c-9dfa-4906e5d171dd"
+	PolicyTypeRequiredReviewers = "fd2167ab-b0be-447a-8ec8-39368250530e"
+	PolicyTypeBuild             = "0609b952-1397-4640-95ec-e00a01b2c241"
+	PolicyTypeCommentResolution = "c6a1889d-b943-4856-b76f-9e46bb6b0df2"
+	PolicyTypeWorkItemLinking   = "40e92b44-2fe1-4dd6-b3d8-74a9c21d0c6e"
+)
+
+// ListBranchPolicies returns the policy configurations that apply to refName
+// (e.g. refs/heads/main) in the given repository.
+// With a context created by WithPartialResults, the configurations fetched so
+// far are returned along with ErrRateLimited.
+// NOTE: this API needs repository ID specified not repository Name in OrgProjectRepoArgs.
+func (c *client) ListBranchPolicies(ctx context.Context, args OrgProjectRepoArgs, refName string) ([]PolicyConfiguration, error) {
+	queryParams := make(url.Values)
+	queryParams.Set("repositoryId", args.RepoNameOrID)
+	queryParams.Set("refName", refName)
+	return c.listPolicyConfigurations(ctx, args, queryParams)
+}
+
+// ListDefaultReviewers returns the reviewers that are automatically added to
+// PRs in the given repository by its enabled required reviewers policies, on
+// any branch. A reviewer that is required by more than one policy is returned
+// once per policy.
+// NOTE: this API needs repository ID specified not repository Name in OrgProjectRepoArgs.
+func (c *client) ListDefaultReviewers(ctx context.Context, args OrgProjectRepoArgs) ([]RequiredReviewer, error) {
+	queryParams := make(url.Values)
+	queryParams.Set("repositoryId", args.RepoNameOrID)
+	queryParams.Set("policyType", PolicyTypeRequiredReviewers)
+	policies, err := c.listPolicyConfigurations(ctx, args, queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	var reviewers []RequiredReviewer
+	for _, p := range policies {
+		// The API filters by type already, but we don't rely on it.
+		if !p.IsEnabled || p.IsDeleted || p.Type.ID != PolicyTypeRequiredReviewers {
+			continue
+		}
+
+		settings, err := p.RequiredReviewersSettings()
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range settings.RequiredReviewerIDs {
+			reviewers = append(reviewers, RequiredReviewer{
+				ID:               id,
+				PolicyID:         p.ID,
+				IsBlocking:       p.IsBlocking,
+				FilenamePatterns: settings.FilenamePatterns,
+				Scope:            settings.Scope,
+			})
+		}
+	}
+
+	return reviewers, nil
+}
+
+// CreateOrUpdateRequiredReviewersPolicy creates a required reviewers policy
+// configuration in the project, or replaces the configuration input.ID if it
+// is set, e.g. one found with ListBranchPolicies. It returns the created or
+// updated configuration, whose Revision is incremented on every update.
+func (c *client) CreateOrUpdateRequiredReviewersPolicy(ctx context.Context, org, project string, input RequiredReviewersPolicyInput) (PolicyConfiguration, error) {
+	settings, err := input.Settings.normalize()
+	if err != nil {
+		return PolicyConfiguration{}, err
+	}
+
+	data, err := json.Marshal(policyConfigurationInput{
+		IsEnabled:  input.IsEnabled,
+		IsBlocking: input.IsBlocking,
+		Type:       PolicyType{ID: PolicyTypeRequiredReviewers},
+		Settings:   settings,
+	})
+	if err != nil {
+		return PolicyConfiguration{}, errors.Wrap(err, "marshalling request")
+	}
+
+	method := "POST"
+	reqURL := url.URL{Path: fmt.Sprintf("%s/%s/_apis/policy/configurations", org, project)}
+	if input.ID != 0 {
+		method = "PUT"
+		reqURL.Path += "/" + strconv.Itoa(input.ID)
+	}
+
+	req, err := http.NewRequest(method, reqURL.String(), bytes.NewBuffer(data))
+	if err != nil {
+		return PolicyConfiguration{}, err
+	}
+
+	var policy PolicyConfiguration
+	if _, err = c.do(ctx, req, "", &policy); err != nil {
+		return PolicyConfiguration{}, err
+	}
+
+	return policy, nil
+}
+
+// normalize validates the settings and returns them with the ref names of
+// exact scopes qualified and unset match kinds defaulted to exact.
+func (s RequiredReviewersPolicySettings) normalize() (RequiredReviewersPolicySettings, error) {
+	if len(s.RequiredReviewerIDs) == 0 {
+		return s, errors.New("a required reviewers policy needs at least one reviewer")
+	}
+	for _, id := range s.RequiredReviewerIDs {
+		if !guidPattern.MatchString(id) {
+			return s, errors.Newf("reviewer ID %q is not a GUID", id)
+		}
+	}
+	if s.MinimumApproverCount < 0 {
+		return s, errors.New("minimum approver count must not be negative")
+	}
+
+	if len(s.Scope) == 0 {
+		return s, errors.New("a required reviewers policy needs a scope")
+	}
+	scopes := make([]PolicyScope, len(s.Scope))
+	for i, scope := range s.Scope {
+		if !guidPattern.MatchString(scope.RepositoryID) {
+			return s, errors.Newf("scope repository ID %q is not a GUID", scope.RepositoryID)
+		}
+		// Match kinds are compared case-insensitively and sent back as given,
+		// so that the settings of a policy read from Azure DevOps can be
+		// passed back whatever their casing.
+		switch {
+		case scope.MatchKind == "" || strings.EqualFold(scope.MatchKind, "exact"):
+			if scope.MatchKind == "" {
+				scope.MatchKind = "exact"
+			}
+			ref, err := validateRefName(scope.RefName)
+			if err != nil {
+				return s, errors.Wrap(err, "scope")
+			}
+			scope.RefName = ref
+		case strings.EqualFold(scope.MatchKind, "prefix"):
+			// Prefixes such as refs/heads/releases/ are not valid ref names
+			// themselves, so they are only checked to be qualified.
+			if !strings.HasPrefix(scope.RefName, "refs/") {
+				return s, errors.Newf("scope ref name prefix %q must start with refs/", scope.RefName)
+			}
+		default:
+			return s, errors.Newf("invalid scope match kind %q", scope.MatchKind)
+		}
+		scopes[i] = scope
+	}
+	s.Scope = scopes
+	return s, nil
+}
+
+// policyConfigurationInput is the body of requests creating or updating a
+// policy configuration.
+type policyConfigurationInput struct {
+	IsEnabled  bool       `json:"isEnabled"`
+	IsBlocking bool       `json:"isBlocking"`
+	Type       PolicyType `json:"type"`
+	Settings   any        `json:"settings"`
+}
+
+// ListPolicyEvaluations returns the evaluations of the policies that apply to
+// the specified PR, e.g. to tell which of them keeps it from being completed,
+// see PolicyEvaluation.Blocking.
+func (c *client) ListPolicyEvaluations(ctx context.Context, args PullRequestCommonArgs) ([]PolicyEvaluation, error) {
+	prID, err := strconv.Atoi(args.PullRequestID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid pull request ID %q", args.PullRequestID)
+	}
+	// Evaluations are looked up by the artifact ID of the PR, which contains
+	// the ID of the project rather than its name.
+	projectID, err := c.GetProjectID(ctx, args.Org, args.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	queryParams := make(url.Values)
+	queryParams.Set("artifactId", PullRequestArtifactID(projectID, prID))
+	setAPIVersion(queryParams, policyEvaluationsAPIVersion)
+
+	reqURL := url.URL{
+		Path:     fmt.Sprintf("%s/%s/_apis/policy/evaluations", args.Org, args.Project),
+		RawQuery: queryParams.Encode(),
+	}
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp listResponse[PolicyEvaluation]
+	if _, err = c.do(ctx, req, "", &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Value, nil
+}
+
+// PullRequestArtifactID returns the artifact ID identifying a PR to other
+// services such as the policy service, in the same form as
+// PullRequest.ArtifactID. projectID is the ID of the project of the PR, not
+// its name.
+func PullRequestArtifactID(projectID string, pullRequestID int) string {
+	return fmt.Sprintf("vstfs:///CodeReview/CodeReviewId/%s/%d", projectID, pullRequestID)
+}
+
+func (c *client) listPolicyConfigurations(ctx context.Context, args OrgProjectRepoArgs, queryParams url.Values) ([]PolicyConfiguration, error) {
+	return listPages[PolicyConfiguration](ctx, c, fmt.Sprintf("%s/%s/_apis/policy/configurations", args.Org, args.Project), queryParams, ListOptions{})
+}
+
+// MinimumReviewersSettings returns the settings of a minimum number of
+// reviewers policy.
+func (p PolicyConfiguration) MinimumReviewersSettings() (*MinimumReviewersPolicySettings, error) {
+	return decodePolicySettings[MinimumReviewersPolicySettings](p, PolicyTypeMinimumReviewers)
+}
+
+// RequiredReviewersSettings returns the settings of a required reviewers
+// policy.
+func (p PolicyConfiguration) RequiredReviewersSettings() (*RequiredReviewersPolicySettings, error) {
+	return decodePolicySettings[RequiredReviewersPolicySettings](p, PolicyTypeRequiredReviewers)
+}
+
+// BuildSettings returns the settings of a build validation policy.
+func (p PolicyConfiguration) BuildSettings() (*BuildPolicySettings, error) {
+	return decodePolicySettings[BuildPolicySettings](p, PolicyTypeBuild)
+}
+
+func decodePolicySettings[T any](p PolicyConfiguration, policyType string) (*T, error) {
+	if p.Type.ID != policyType {
+		return nil, errors.Errorf("policy %d is of type %q (%s), not %q", p.ID, p.Type.ID, p.Type.DisplayName, policyType)
+	}
+
+	var settings T
+	if err := json.Unmarshal(p.Settings, &settings); err != nil {
+		return nil, errors.Wrap(err, "unmarshalling policy settings")
+	}
+	return &settings, nil
+}
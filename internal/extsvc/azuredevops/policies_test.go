@@ -0,0 +1,182 @@
+package azuredevops
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ListBranchPolicies(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/org/project/_apis/policy/configurations", r.URL.Path)
+		assert.Equal(t, "repo-id", r.URL.Query().Get("repositoryId"))
+		assert.Equal(t, "refs/heads/main", r.URL.Query().Get("refName"))
+
+		if r.URL.Query().Get("continuationToken") == "" {
+			w.Header().Set(continuationTokenHeader, "next")
+			w.Write([]byte(`{"count": 1, "value": [{"id": 1, "isEnabled": true, "isBlocking": true, "type": {"id": "fa4e907d-c16b-4a4c-9dfa-4906e5d171dd", "displayName": "Minimum number of reviewers"}, "settings": {"minimumApproverCount": 2, "creatorVoteCounts": false}}]}`))
+			return
+		}
+		w.Write([]byte(`{"count": 1, "value": [{"id": 2, "isEnabled": true, "type": {"id": "0609b952-1397-4640-95ec-e00a01b2c241", "displayName": "Build"}, "settings": {"buildDefinitionId": 5}}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	policies, err := cli.ListBranchPolicies(context.Background(), OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo-id"}, "refs/heads/main")
+	require.NoError(t, err)
+	require.Len(t, policies, 2)
+
+	minReviewers, err := policies[0].MinimumReviewersSettings()
+	require.NoError(t, err)
+	assert.Equal(t, 2, minReviewers.MinimumApproverCount)
+
+	_, err = policies[0].BuildSettings()
+	assert.Error(t, err, "policy type mismatch")
+
+	build, err := policies[1].BuildSettings()
+	require.NoError(t, err)
+	assert.Equal(t, 5, build.BuildDefinitionID)
+}
+
+func TestClient_ListDefaultReviewers(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/org/project/_apis/policy/configurations", r.URL.Path)
+		assert.Equal(t, "repo-id", r.URL.Query().Get("repositoryId"))
+		assert.Equal(t, PolicyTypeRequiredReviewers, r.URL.Query().Get("policyType"))
+		assert.False(t, r.URL.Query().Has("refName"))
+
+		w.Write([]byte(`{"count": 3, "value": [
+			{"id": 1, "isEnabled": true, "isBlocking": true, "type": {"id": "fd2167ab-b0be-447a-8ec8-39368250530e"}, "settings": {"requiredReviewerIds": ["a", "b"], "filenamePatterns": ["/docs/*"], "scope": [{"repositoryId": "repo-id", "refName": "refs/heads/main", "matchKind": "exact"}]}},
+			{"id": 2, "isEnabled": false, "type": {"id": "fd2167ab-b0be-447a-8ec8-39368250530e"}, "settings": {"requiredReviewerIds": ["c"]}},
+			{"id": 3, "isEnabled": true, "type": {"id": "fd2167ab-b0be-447a-8ec8-39368250530e"}, "settings": {"requiredReviewerIds": ["a"]}}
+		]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	reviewers, err := cli.ListDefaultReviewers(context.Background(), OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo-id"})
+	require.NoError(t, err)
+
+	scope := []PolicyScope{{RepositoryID: "repo-id", RefName: "refs/heads/main", MatchKind: "exact"}}
+	assert.Equal(t, []RequiredReviewer{
+		{ID: "a", PolicyID: 1, IsBlocking: true, FilenamePatterns: []string{"/docs/*"}, Scope: scope},
+		{ID: "b", PolicyID: 1, IsBlocking: true, FilenamePatterns: []string{"/docs/*"}, Scope: scope},
+		{ID: "a", PolicyID: 3},
+	}, reviewers)
+}
+
+func TestClient_ListPolicyEvaluations(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/org/_apis/projects/project":
+			w.Write([]byte(`{"id": "project-id", "name": "project"}`))
+		case "/org/project/_apis/policy/evaluations":
+			assert.Equal(t, "vstfs:///CodeReview/CodeReviewId/project-id/42", r.URL.Query().Get("artifactId"))
+			assert.Equal(t, policyEvaluationsAPIVersion, r.URL.Query().Get("api-version"))
+			w.Write([]byte(`{"count": 3, "value": [
+				{"evaluationId": "a", "status": "approved", "configuration": {"id": 1, "isEnabled": true, "isBlocking": true}},
+				{"evaluationId": "b", "status": "running", "configuration": {"id": 2, "isEnabled": true, "isBlocking": true}},
+				{"evaluationId": "c", "status": "rejected", "configuration": {"id": 3, "isEnabled": true, "isBlocking": false}}
+			]}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	evaluations, err := cli.ListPolicyEvaluations(context.Background(), PullRequestCommonArgs{Org: "org", Project: "project", RepoNameOrID: "repo", PullRequestID: "42"})
+	require.NoError(t, err)
+
+	var blocking []string
+	for _, e := range evaluations {
+		if e.Blocking() {
+			blocking = append(blocking, e.EvaluationID)
+		}
+	}
+	assert.Equal(t, []string{"b"}, blocking)
+}
+
+func TestClient_CreateOrUpdateRequiredReviewersPolicy(t *testing.T) {
+	const (
+		repoID     = "2f3d4c5b-6a79-4b8c-9d0e-1f2a3b4c5d6e"
+		reviewerID = "8a7b6c5d-4e3f-4a1b-9c8d-7e6f5a4b3c2d"
+	)
+
+	var gotMethod, gotPath string
+	var gotBody map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod, gotPath = r.Method, r.URL.Path
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+		w.Write([]byte(`{"id": 7, "revision": 1, "isEnabled": true, "type": {"id": "fd2167ab-b0be-447a-8ec8-39368250530e"}, "settings": {"requiredReviewerIds": ["` + reviewerID + `"]}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	input := RequiredReviewersPolicyInput{
+		IsEnabled:  true,
+		IsBlocking: true,
+		Settings: RequiredReviewersPolicySettings{
+			RequiredReviewerIDs: []string{reviewerID},
+			Scope:               []PolicyScope{{RepositoryID: repoID, RefName: "main"}},
+		},
+	}
+	policy, err := cli.CreateOrUpdateRequiredReviewersPolicy(ctx, "org", "project", input)
+	require.NoError(t, err)
+	assert.Equal(t, 7, policy.ID)
+	assert.Equal(t, "POST", gotMethod)
+	assert.Equal(t, "/org/project/_apis/policy/configurations", gotPath)
+	assert.Equal(t, PolicyTypeRequiredReviewers, gotBody["type"].(map[string]any)["id"])
+	settings := gotBody["settings"].(map[string]any)
+	assert.Equal(t, []any{reviewerID}, settings["requiredReviewerIds"])
+	assert.Equal(t, []any{map[string]any{"repositoryId": repoID, "refName": "refs/heads/main", "matchKind": "exact"}}, settings["scope"])
+
+	input.ID = 7
+	_, err = cli.CreateOrUpdateRequiredReviewersPolicy(ctx, "org", "project", input)
+	require.NoError(t, err)
+	assert.Equal(t, "PUT", gotMethod)
+	assert.Equal(t, "/org/project/_apis/policy/configurations/7", gotPath)
+
+	// Settings read from a policy are accepted whatever the casing of their
+	// match kinds.
+	read := PolicyConfiguration{
+		Type:     PolicyType{ID: PolicyTypeRequiredReviewers},
+		Settings: json.RawMessage(`{"requiredReviewerIds": ["` + reviewerID + `"], "scope": [{"repositoryId": "` + repoID + `", "refName": "refs/heads/main", "matchKind": "Exact"}, {"repositoryId": "` + repoID + `", "refName": "refs/heads/releases/", "matchKind": "Prefix"}]}`),
+	}
+	readSettings, err := read.RequiredReviewersSettings()
+	require.NoError(t, err)
+	_, err = cli.CreateOrUpdateRequiredReviewersPolicy(ctx, "org", "project", RequiredReviewersPolicyInput{ID: 7, Settings: *readSettings})
+	require.NoError(t, err)
+	assert.Equal(t, []any{
+		map[string]any{"repositoryId": repoID, "refName": "refs/heads/main", "matchKind": "Exact"},
+		map[string]any{"repositoryId": repoID, "refName": "refs/heads/releases/", "matchKind": "Prefix"},
+	}, gotBody["settings"].(map[string]any)["scope"])
+
+	for name, settings := range map[string]RequiredReviewersPolicySettings{
+		"no reviewers":       {Scope: []PolicyScope{{RepositoryID: repoID, RefName: "main"}}},
+		"reviewer name":      {RequiredReviewerIDs: []string{"alice"}, Scope: []PolicyScope{{RepositoryID: repoID, RefName: "main"}}},
+		"no scope":           {RequiredReviewerIDs: []string{reviewerID}},
+		"repository name":    {RequiredReviewerIDs: []string{reviewerID}, Scope: []PolicyScope{{RepositoryID: "repo", RefName: "main"}}},
+		"invalid ref":        {RequiredReviewerIDs: []string{reviewerID}, Scope: []PolicyScope{{RepositoryID: repoID, RefName: "a..b"}}},
+		"unqualified prefix": {RequiredReviewerIDs: []string{reviewerID}, Scope: []PolicyScope{{RepositoryID: repoID, RefName: "releases/", MatchKind: "prefix"}}},
+		"invalid match kind": {RequiredReviewerIDs: []string{reviewerID}, Scope: []PolicyScope{{RepositoryID: repoID, RefName: "main", MatchKind: "glob"}}},
+	} {
+		_, err := cli.CreateOrUpdateRequiredReviewersPolicy(ctx, "org", "project", RequiredReviewersPolicyInput{Settings: settings})
+		assert.Error(t, err, name)
+	}
+}
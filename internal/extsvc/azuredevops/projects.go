@@ -5,8 +5,14 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"time"
 )
 
+// defaultProjectIDCacheTTL is the default TTL of the project ID cache used by
+// GetProjectID. Project IDs never change, but a project name can be reused
+// after a project is renamed or deleted.
+const defaultProjectIDCacheTTL = 10 * time.Minute
+
 func (c *client) GetProject(ctx context.Context, org, project string) (Project, error) {
 	reqURL := url.URL{Path: fmt.Sprintf("%s/_apis/projects/%s", org, project)}
 
@@ -19,3 +25,27 @@ func (c *client) GetProject(ctx context.Context, org, project string) (Project,
 	_, err = c.do(ctx, req, "", &p)
 	return p, err
 }
+
+// GetProjectID returns the ID of the given project, which many security and
+// policy APIs require instead of the project name. IDs are cached by the
+// client, see SetProjectIDCacheTTL.
+func (c *client) GetProjectID(ctx context.Context, org, projectName string) (string, error) {
+	key := org + "/" + projectName
+	if id, ok := c.projectIDs.Get(key); ok {
+		return id, nil
+	}
+
+	p, err := c.GetProject(ctx, org, projectName)
+	if err != nil {
+		return "", err
+	}
+
+	c.projectIDs.Set(key, p.ID)
+	return p.ID, nil
+}
+
+// SetProjectIDCacheTTL configures how long project IDs returned by
+// GetProjectID are cached. A TTL of zero disables the cache.
+func (c *client) SetProjectIDCacheTTL(ttl time.Duration) {
+	c.projectIDs.SetTTL(ttl)
+}
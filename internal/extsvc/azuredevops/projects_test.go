@@ -2,9 +2,14 @@ package azuredevops
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
 	"github.com/sourcegraph/sourcegraph/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestClient_GetProject(t *testing.T) {
@@ -18,3 +23,29 @@ func TestClient_GetProject(t *testing.T) {
 
 	testutil.AssertGolden(t, "testdata/golden/GetProject.json", *update, resp)
 }
+
+func TestClient_GetProjectID(t *testing.T) {
+	numRequests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		numRequests++
+		assert.Equal(t, "/org/_apis/projects/My Project", r.URL.Path)
+		w.Write([]byte(`{"id": "dc493f7d-0b57-4de2-a59b-3f74ff3ea334", "name": "My Project"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		id, err := cli.GetProjectID(ctx, "org", "My Project")
+		require.NoError(t, err)
+		assert.Equal(t, "dc493f7d-0b57-4de2-a59b-3f74ff3ea334", id)
+	}
+	assert.Equal(t, 1, numRequests, "project ID is cached")
+
+	cli.SetProjectIDCacheTTL(0)
+	_, err = cli.GetProjectID(ctx, "org", "My Project")
+	require.NoError(t, err)
+	assert.Equal(t, 2, numRequests)
+}
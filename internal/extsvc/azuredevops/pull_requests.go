@@ -5,12 +5,23 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/sourcegraph/conc/pool"
+
+	"github.com/sourcegraph/sourcegraph/internal/lazyregexp"
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
 
+// guidPattern matches the GUIDs Azure DevOps uses to identify users and groups.
+var guidPattern = lazyregexp.New(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
 // AbandonPullRequest abandons (closes) the specified PR, returns the updated PR.
 func (c *client) AbandonPullRequest(ctx context.Context, args PullRequestCommonArgs) (PullRequest, error) {
 	reqURL := url.URL{Path: fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%s", args.Org, args.Project, args.RepoNameOrID, args.PullRequestID)}
@@ -34,9 +45,36 @@ func (c *client) AbandonPullRequest(ctx context.Context, args PullRequestCommonA
 	return pr, nil
 }
 
+// ReactivatePullRequest reopens the specified abandoned PR, returns the
+// updated PR. An error is returned if the PR isn't abandoned, since active PRs
+// don't need reactivating and completed PRs can't be reopened.
+func (c *client) ReactivatePullRequest(ctx context.Context, args PullRequestCommonArgs) (PullRequest, error) {
+	pr, err := c.GetPullRequest(ctx, args, GetPullRequestOptions{})
+	if err != nil {
+		return PullRequest{}, err
+	}
+	if pr.Status != PullRequestStatusAbandoned {
+		return PullRequest{}, errors.Newf("cannot reactivate pull request %s: it is %s, not abandoned", args.PullRequestID, pr.Status)
+	}
+
+	active := PullRequestStatusActive
+	return c.UpdatePullRequest(ctx, args, PullRequestUpdateInput{Status: &active})
+}
+
 // CreatePullRequest creates a new PR with the specified properties, returns the newly created PR.
 // NOTE: this API needs repository ID specified not repository Name in OrgProjectRepoArgs.
 func (c *client) CreatePullRequest(ctx context.Context, args OrgProjectRepoArgs, input CreatePullRequestInput) (PullRequest, error) {
+	if input.DryRun {
+		return PullRequest{}, ErrDryRunNotSupported
+	}
+	var err error
+	if input.SourceRefName, err = validateRefName(input.SourceRefName); err != nil {
+		return PullRequest{}, errors.Wrap(err, "source")
+	}
+	if input.TargetRefName, err = validateRefName(input.TargetRefName); err != nil {
+		return PullRequest{}, errors.Wrap(err, "target")
+	}
+
 	data, err := json.Marshal(&input)
 	if err != nil {
 		return PullRequest{}, errors.Wrap(err, "marshalling request")
@@ -57,9 +95,22 @@ func (c *client) CreatePullRequest(ctx context.Context, args OrgProjectRepoArgs,
 	return pr, nil
 }
 
-// GetPullRequest gets the specified PR.
-func (c *client) GetPullRequest(ctx context.Context, args PullRequestCommonArgs) (PullRequest, error) {
-	reqURL := url.URL{Path: fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%s", args.Org, args.Project, args.RepoNameOrID, args.PullRequestID)}
+// GetPullRequest gets the specified PR, including the resources requested by
+// opts.
+func (c *client) GetPullRequest(ctx context.Context, args PullRequestCommonArgs, opts GetPullRequestOptions) (PullRequest, error) {
+	queryParams := make(url.Values)
+	if opts.IncludeWorkItemRefs {
+		queryParams.Set("includeWorkItemRefs", "true")
+	}
+	if opts.IncludeCommits {
+		queryParams.Set("includeCommits", "true")
+	}
+	opts.RequestOptions.setQueryParams(queryParams)
+
+	reqURL := url.URL{
+		Path:     fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%s", args.Org, args.Project, args.RepoNameOrID, args.PullRequestID),
+		RawQuery: queryParams.Encode(),
+	}
 
 	req, err := http.NewRequest("GET", reqURL.String(), nil)
 	if err != nil {
@@ -74,6 +125,153 @@ func (c *client) GetPullRequest(ctx context.Context, args PullRequestCommonArgs)
 	return pr, nil
 }
 
+// Bounds of GetPullRequestsBatchOptions.MaxConcurrency.
+const (
+	defaultPullRequestsBatchConcurrency = 4
+	maxPullRequestsBatchConcurrency     = 16
+)
+
+// GetPullRequestsBatch gets the PRs with the given IDs of a repository with
+// bounded concurrency, e.g. to refresh the PRs a burst of webhooks reported as
+// changed. Requests wait for the rate limits of the client like all others.
+// A PR that can't be fetched doesn't fail the others: its error is recorded in
+// the Errors of the result instead. The returned error is only set if ctx is
+// done.
+func (c *client) GetPullRequestsBatch(ctx context.Context, args OrgProjectRepoArgs, pullRequestIDs []string, opts GetPullRequestsBatchOptions) (GetPullRequestsBatchResult, error) {
+	concurrency := opts.MaxConcurrency
+	if concurrency <= 0 {
+		concurrency = defaultPullRequestsBatchConcurrency
+	}
+	if concurrency > maxPullRequestsBatchConcurrency {
+		concurrency = maxPullRequestsBatchConcurrency
+	}
+
+	prs := make([]PullRequest, len(pullRequestIDs))
+	errs := make([]error, len(pullRequestIDs))
+	p := pool.New().WithMaxGoroutines(concurrency)
+	for i, id := range pullRequestIDs {
+		i, id := i, id
+		p.Go(func() {
+			prs[i], errs[i] = c.GetPullRequest(ctx, PullRequestCommonArgs{
+				Org:           args.Org,
+				Project:       args.Project,
+				RepoNameOrID:  args.RepoNameOrID,
+				PullRequestID: id,
+			}, opts.GetPullRequestOptions)
+		})
+	}
+	p.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return GetPullRequestsBatchResult{}, err
+	}
+
+	var result GetPullRequestsBatchResult
+	for i, id := range pullRequestIDs {
+		if errs[i] != nil {
+			if result.Errors == nil {
+				result.Errors = make(map[string]error)
+			}
+			result.Errors[id] = errs[i]
+			continue
+		}
+		result.PullRequests = append(result.PullRequests, prs[i])
+	}
+
+	return result, nil
+}
+
+// ListPullRequests returns the PRs of a repository matching the given search
+// criteria, following pages until all PRs or criteria.Top PRs were fetched,
+// after skipping criteria.Skip. criteria.ContinuationToken is not supported.
+func (c *client) ListPullRequests(ctx context.Context, args OrgProjectRepoArgs, criteria PullRequestSearchCriteria) ([]PullRequest, error) {
+	if criteria.ContinuationToken != "" {
+		return nil, errors.New("pull requests are paged with skip, not continuation tokens")
+	}
+	queryParams, err := criteria.queryParams()
+	if err != nil {
+		return nil, err
+	}
+	criteria.RequestOptions.setQueryParams(queryParams)
+
+	reqURL := url.URL{Path: fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests", args.Org, args.Project, args.RepoNameOrID)}
+
+	var prs []PullRequest
+	for {
+		pageSize := listPullRequestsPageSize
+		if remaining := criteria.Top - len(prs); criteria.Top > 0 && remaining < pageSize {
+			pageSize = remaining
+		}
+		// The PR list doesn't return continuation tokens, so we page with
+		// $skip until a page isn't full.
+		queryParams.Set("$top", strconv.Itoa(pageSize))
+		queryParams.Set("$skip", strconv.Itoa(criteria.Skip+len(prs)))
+		reqURL.RawQuery = queryParams.Encode()
+		req, err := http.NewRequest("GET", reqURL.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp ListPullRequestsResponse
+		if _, err = c.do(ctx, req, "", &resp); err != nil {
+			return nil, err
+		}
+		prs = append(prs, resp.Value...)
+
+		if len(resp.Value) < pageSize || len(prs) == criteria.Top {
+			break
+		}
+	}
+
+	return prs, nil
+}
+
+// GetPullRequestByRefs returns the active PR from sourceRef to targetRef in
+// the given repository. The refs can be branch names, e.g. main, or full ref
+// names, e.g. refs/heads/main.
+//
+// A *NotFoundError is returned if there is no such PR. Azure DevOps doesn't
+// allow more than one active PR per pair of refs, but an error is returned if
+// several are found anyway.
+func (c *client) GetPullRequestByRefs(ctx context.Context, args OrgProjectRepoArgs, sourceRef, targetRef string) (PullRequest, error) {
+	sourceRef, targetRef = FullBranchRef(sourceRef), FullBranchRef(targetRef)
+	prs, err := c.ListPullRequests(ctx, args, PullRequestSearchCriteria{
+		Status:        PullRequestStatusActive,
+		SourceRefName: sourceRef,
+		TargetRefName: targetRef,
+	})
+	if err != nil {
+		return PullRequest{}, err
+	}
+
+	switch len(prs) {
+	case 0:
+		return PullRequest{}, &NotFoundError{Scope: "pull request", Err: errors.Newf("no active pull request from %q to %q", sourceRef, targetRef)}
+	case 1:
+		return prs[0], nil
+	default:
+		ids := make([]string, len(prs))
+		for i, pr := range prs {
+			ids[i] = strconv.Itoa(pr.ID)
+		}
+		return PullRequest{}, errors.Newf("found %d active pull requests from %q to %q: %s", len(prs), sourceRef, targetRef, strings.Join(ids, ", "))
+	}
+}
+
+// listPullRequestsPageSize is the number of PRs requested per page by
+// ListPullRequests.
+const listPullRequestsPageSize = 100
+
+func (c PullRequestSearchCriteria) queryParams() (url.Values, error) {
+	return newSearchCriteria().
+		guid("creatorId", c.CreatorID).
+		guid("reviewerId", c.ReviewerID).
+		enum("status", string(c.Status), c.Status.valid).
+		str("sourceRefName", c.SourceRefName).
+		str("targetRefName", c.TargetRefName).
+		encode()
+}
+
 // GetPullRequestStatuses returns the build statuses associated with the specified PR.
 func (c *client) GetPullRequestStatuses(ctx context.Context, args PullRequestCommonArgs) ([]PullRequestBuildStatus, error) {
 	reqURL := url.URL{Path: fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%s/statuses", args.Org, args.Project, args.RepoNameOrID, args.PullRequestID)}
@@ -95,6 +293,14 @@ func (c *client) GetPullRequestStatuses(ctx context.Context, args PullRequestCom
 //
 // Warning: If you are setting the TargetRefName in the PullRequestUpdateInput, it will be the only thing to get updated (bug in the ADO API).
 func (c *client) UpdatePullRequest(ctx context.Context, args PullRequestCommonArgs, input PullRequestUpdateInput) (PullRequest, error) {
+	if input.TargetRefName != nil {
+		ref, err := validateRefName(*input.TargetRefName)
+		if err != nil {
+			return PullRequest{}, errors.Wrap(err, "target")
+		}
+		input.TargetRefName = &ref
+	}
+
 	reqURL := url.URL{Path: fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%s", args.Org, args.Project, args.RepoNameOrID, args.PullRequestID)}
 
 	data, err := json.Marshal(input)
@@ -108,15 +314,53 @@ func (c *client) UpdatePullRequest(ctx context.Context, args PullRequestCommonAr
 	}
 
 	var pr PullRequest
-	if _, err = c.do(ctx, req, "", &pr); err != nil {
+	if _, err = c.do(WithIfMatch(ctx, input.IfMatch), req, "", &pr); err != nil {
 		return PullRequest{}, err
 	}
 
 	return pr, nil
 }
 
+// SetPullRequestDraft converts the specified PR to a draft or publishes it,
+// returns the updated PR. Azure DevOps doesn't notify reviewers of drafts, and
+// notifies them once the PR is published. Converting a PR to a draft also
+// cancels its auto-complete, as a draft must not be completed.
+func (c *client) SetPullRequestDraft(ctx context.Context, args PullRequestCommonArgs, draft bool) (PullRequest, error) {
+	input := PullRequestUpdateInput{IsDraft: &draft}
+	if draft {
+		input.AutoCompleteSetBy = &IdentityRefInput{ID: cancelAutoCompleteID}
+	}
+	return c.UpdatePullRequest(ctx, args, input)
+}
+
+// cancelAutoCompleteID is set as the ID of the identity setting auto-complete
+// to cancel it.
+const cancelAutoCompleteID = "00000000-0000-0000-0000-000000000000"
+
+// SetPullRequestAutoComplete marks the specified PR to be completed
+// automatically once all its policies pass, returns the updated PR.
+func (c *client) SetPullRequestAutoComplete(ctx context.Context, args PullRequestCommonArgs, input PullRequestAutoCompleteInput) (PullRequest, error) {
+	if input.SetByID == "" {
+		return PullRequest{}, errors.New("auto-complete requires the ID of the identity setting it")
+	}
+	if s := input.CompletionOptions.MergeStrategy; s != "" && !s.valid() {
+		return PullRequest{}, errors.Errorf("invalid merge strategy %q", s)
+	}
+
+	return c.UpdatePullRequest(ctx, args, PullRequestUpdateInput{
+		AutoCompleteSetBy: &IdentityRefInput{ID: input.SetByID},
+		CompletionOptions: &input.CompletionOptions,
+	})
+}
+
 // CreatePullRequestCommentThread creates a new comment Thread specified PR, returns the updated PR.
 func (c *client) CreatePullRequestCommentThread(ctx context.Context, args PullRequestCommonArgs, input PullRequestCommentInput) (PullRequestCommentResponse, error) {
+	if ic := input.IterationContext; ic != nil {
+		if ic.FirstComparingIteration < 1 || ic.SecondComparingIteration < ic.FirstComparingIteration {
+			return PullRequestCommentResponse{}, errors.Newf("invalid iteration context %d..%d", ic.FirstComparingIteration, ic.SecondComparingIteration)
+		}
+	}
+
 	reqURL := url.URL{Path: fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%s/threads", args.Org, args.Project, args.RepoNameOrID, args.PullRequestID)}
 
 	data, err := json.Marshal(input)
@@ -137,6 +381,426 @@ func (c *client) CreatePullRequestCommentThread(ctx context.Context, args PullRe
 	return pr, nil
 }
 
+// InlineCommentContext returns the thread context placing a comment on the
+// lines startLine to endLine (1-based and inclusive) of filePath, on the given
+// side of the diff: CommentSideLeft for the target version of the file, e.g.
+// for removed lines, and CommentSideRight for the source version. filePath is
+// relative to the repository root, with or without a leading slash. The lines
+// refer to the diff of the IterationContext the thread is created with.
+func InlineCommentContext(filePath string, side CommentSide, startLine, endLine int) (*PullRequestThreadContext, error) {
+	if filePath == "" || strings.HasSuffix(filePath, "/") {
+		return nil, errors.Newf("invalid file path %q", filePath)
+	}
+	if startLine < 1 || endLine < startLine {
+		return nil, errors.Newf("invalid line range %d-%d", startLine, endLine)
+	}
+
+	// Offsets are 1-based too. Azure DevOps shows the thread at the end line,
+	// which doesn't require knowing the length of the lines.
+	start := &CommentPosition{Line: startLine, Offset: 1}
+	end := &CommentPosition{Line: endLine, Offset: 1}
+	tc := &PullRequestThreadContext{FilePath: "/" + strings.TrimPrefix(filePath, "/")}
+	switch side {
+	case CommentSideLeft:
+		tc.LeftFileStart, tc.LeftFileEnd = start, end
+	case CommentSideRight:
+		tc.RightFileStart, tc.RightFileEnd = start, end
+	default:
+		return nil, errors.Newf("invalid comment side %q", side)
+	}
+	return tc, nil
+}
+
+// ListPullRequestReviewers returns the reviewers of the specified PR along with
+// their current votes. It is cheaper than GetPullRequest when only the review
+// status is needed.
+func (c *client) ListPullRequestReviewers(ctx context.Context, args PullRequestCommonArgs, opts ListPullRequestReviewersOptions) ([]Reviewer, error) {
+	queryParams := make(url.Values)
+	opts.RequestOptions.setQueryParams(queryParams)
+
+	reqURL := url.URL{
+		Path:     fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%s/reviewers", args.Org, args.Project, args.RepoNameOrID, args.PullRequestID),
+		RawQuery: queryParams.Encode(),
+	}
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var reviewers ListPullRequestReviewersResponse
+	if _, err = c.do(ctx, req, "", &reviewers); err != nil {
+		return nil, err
+	}
+
+	if opts.ResolveDisplayNames {
+		if err := c.resolveReviewerNames(ctx, args.Org, reviewers.Value); err != nil {
+			return nil, errors.Wrap(err, "resolving reviewer names")
+		}
+	}
+
+	return reviewers.Value, nil
+}
+
+func (c *client) resolveReviewerNames(ctx context.Context, org string, reviewers []Reviewer) error {
+	var ids []string
+	for _, r := range reviewers {
+		if r.DisplayName == "" {
+			ids = append(ids, r.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	names, err := c.resolveDisplayNames(ctx, org, ids)
+	if err != nil {
+		return err
+	}
+	for i := range reviewers {
+		if reviewers[i].DisplayName == "" {
+			reviewers[i].DisplayName = names[reviewers[i].ID]
+		}
+	}
+	return nil
+}
+
+// PullRequestApprovalState fetches the reviewers of the specified PR and
+// computes whether the PR has the approvals it requires. Only the votes of the
+// reviewers are taken into account: the minimum number of reviewers set by a
+// branch policy isn't, use ListBranchPolicies to check it.
+func (c *client) PullRequestApprovalState(ctx context.Context, args PullRequestCommonArgs) (ApprovalState, error) {
+	reviewers, err := c.ListPullRequestReviewers(ctx, args, ListPullRequestReviewersOptions{})
+	if err != nil {
+		return ApprovalState{}, err
+	}
+
+	return approvalState(reviewers), nil
+}
+
+func approvalState(reviewers []Reviewer) ApprovalState {
+	var state ApprovalState
+	approvals := 0
+	for _, r := range reviewers {
+		switch {
+		case r.Vote >= VoteApprovedWithSuggestions:
+			approvals++
+		case r.Vote == VoteWaitingForAuthor:
+			state.WaitingCount++
+		case r.Vote == VoteRejected:
+			state.Rejected = true
+		}
+		if r.IsRequired && r.Vote < VoteApprovedWithSuggestions {
+			state.PendingRequired = append(state.PendingRequired, r)
+		}
+	}
+	state.Approved = approvals > 0 && !state.Rejected && state.WaitingCount == 0 && len(state.PendingRequired) == 0
+	return state
+}
+
+// RemovePullRequestReviewer removes the reviewer with the given ID from the
+// specified PR. Removing a reviewer that isn't on the PR is not an error.
+func (c *client) RemovePullRequestReviewer(ctx context.Context, args PullRequestCommonArgs, reviewerID string) error {
+	if !guidPattern.MatchString(reviewerID) {
+		return errors.Newf("invalid reviewer ID %q: must be a GUID", reviewerID)
+	}
+
+	reqURL := url.URL{Path: fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%s/reviewers/%s", args.Org, args.Project, args.RepoNameOrID, args.PullRequestID, reviewerID)}
+
+	req, err := http.NewRequest("DELETE", reqURL.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err = c.do(ctx, req, "", nil); err != nil && !isNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// UploadPullRequestAttachment uploads content as an attachment named fileName
+// to the specified PR. The returned attachment's URL can be embedded in comments,
+// e.g. as a Markdown image. Uploading an attachment with the name of an
+// existing one fails.
+func (c *client) UploadPullRequestAttachment(ctx context.Context, args PullRequestCommonArgs, fileName string, content io.Reader) (Attachment, error) {
+	// The file name is the last segment of the URL, so it must neither add
+	// segments nor remove some when the URL is resolved.
+	if fileName == "" || fileName == "." || fileName == ".." || strings.ContainsAny(fileName, "/\\") {
+		return Attachment{}, errors.Newf("invalid attachment file name %q", fileName)
+	}
+
+	queryParams := make(url.Values)
+	setAPIVersion(queryParams, pullRequestAttachmentsAPIVersion)
+
+	reqURL := url.URL{
+		Path:     fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%s/attachments/%s", args.Org, args.Project, args.RepoNameOrID, args.PullRequestID, fileName),
+		RawQuery: queryParams.Encode(),
+	}
+
+	req, err := http.NewRequest("POST", reqURL.String(), content)
+	if err != nil {
+		return Attachment{}, err
+	}
+	// The content is uploaded as is, not as JSON.
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	var attachment Attachment
+	if _, err = c.do(ctx, req, "", &attachment); err != nil {
+		return Attachment{}, err
+	}
+
+	return attachment, nil
+}
+
+// GetPullRequestProperties returns the custom properties of the specified PR.
+func (c *client) GetPullRequestProperties(ctx context.Context, args PullRequestCommonArgs) (map[string]PropertyValue, error) {
+	queryParams := make(url.Values)
+	setAPIVersion(queryParams, pullRequestPropertiesAPIVersion)
+
+	reqURL := url.URL{
+		Path:     fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%s/properties", args.Org, args.Project, args.RepoNameOrID, args.PullRequestID),
+		RawQuery: queryParams.Encode(),
+	}
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var properties PropertiesCollection
+	if _, err = c.do(ctx, req, "", &properties); err != nil {
+		return nil, err
+	}
+
+	return properties.Value, nil
+}
+
+// SetPullRequestProperties applies the JSON-Patch operations to the custom
+// properties of the specified PR, see AddPropertyOperation and
+// RemovePropertyOperation. It returns the updated properties.
+func (c *client) SetPullRequestProperties(ctx context.Context, args PullRequestCommonArgs, ops []JSONPatchOperation) (map[string]PropertyValue, error) {
+	data, err := json.Marshal(ops)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling request")
+	}
+
+	queryParams := make(url.Values)
+	setAPIVersion(queryParams, pullRequestPropertiesAPIVersion)
+
+	reqURL := url.URL{
+		Path:     fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%s/properties", args.Org, args.Project, args.RepoNameOrID, args.PullRequestID),
+		RawQuery: queryParams.Encode(),
+	}
+
+	req, err := http.NewRequest("PATCH", reqURL.String(), bytes.NewBuffer(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json-patch+json")
+
+	var properties PropertiesCollection
+	if _, err = c.do(ctx, req, "", &properties); err != nil {
+		return nil, err
+	}
+
+	return properties.Value, nil
+}
+
+// ListPullRequestThreads returns all comment threads of the specified PR. See
+// ListPullRequestThreadsOptions for how to get the positions of threads in a
+// specific iteration.
+func (c *client) ListPullRequestThreads(ctx context.Context, args PullRequestCommonArgs, opts ListPullRequestThreadsOptions) ([]PullRequestCommentResponse, error) {
+	queryParams, err := opts.queryParams()
+	if err != nil {
+		return nil, err
+	}
+	opts.RequestOptions.setQueryParams(queryParams)
+
+	reqURL := url.URL{
+		Path:     fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%s/threads", args.Org, args.Project, args.RepoNameOrID, args.PullRequestID),
+		RawQuery: queryParams.Encode(),
+	}
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var threads ListPullRequestThreadsResponse
+	if _, err = c.do(ctx, req, "", &threads); err != nil {
+		return nil, err
+	}
+
+	return threads.Value, nil
+}
+
+// ListPullRequestThreadsUpdatedSince returns the comment threads of the
+// specified PR that were published or updated after since, or that have a
+// comment that was. Azure DevOps can't filter threads by date, so all threads
+// are listed and filtered client-side: this saves callers from processing
+// unchanged threads, not the cost of listing them.
+//
+// The returned cursor is the latest time any listed thread or comment was
+// published or updated, or since if that's later, for use as since of the next
+// call.
+func (c *client) ListPullRequestThreadsUpdatedSince(ctx context.Context, args PullRequestCommonArgs, since time.Time, opts ListPullRequestThreadsOptions) (threads []PullRequestCommentResponse, cursor time.Time, err error) {
+	all, err := c.ListPullRequestThreads(ctx, args, opts)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	cursor = since
+	for _, thread := range all {
+		latest := thread.latestUpdate()
+		if latest.After(since) {
+			threads = append(threads, thread)
+		}
+		if latest.After(cursor) {
+			cursor = latest
+		}
+	}
+
+	return threads, cursor, nil
+}
+
+// ListCommentLikes returns the identities that liked the specified comment.
+func (c *client) ListCommentLikes(ctx context.Context, args PullRequestCommentArgs) ([]CreatorInfo, error) {
+	req, err := http.NewRequest("GET", commentLikesURL(args), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var likes ListCommentLikesResponse
+	if _, err = c.do(ctx, req, "", &likes); err != nil {
+		return nil, err
+	}
+
+	return likes.Value, nil
+}
+
+// LikeComment likes the specified comment as the authenticated user. Liking a
+// comment that the user already liked is not an error.
+func (c *client) LikeComment(ctx context.Context, args PullRequestCommentArgs) error {
+	req, err := http.NewRequest("POST", commentLikesURL(args), nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err = c.do(ctx, req, "", nil); err != nil {
+		var e *HTTPError
+		if errors.As(err, &e) && e.StatusCode == http.StatusConflict {
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+// UnlikeComment removes the like of the authenticated user from the specified
+// comment.
+func (c *client) UnlikeComment(ctx context.Context, args PullRequestCommentArgs) error {
+	req, err := http.NewRequest("DELETE", commentLikesURL(args), nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.do(ctx, req, "", nil)
+	return err
+}
+
+func commentLikesURL(args PullRequestCommentArgs) string {
+	reqURL := url.URL{Path: fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%s/threads/%d/comments/%d/likes", args.Org, args.Project, args.RepoNameOrID, args.PullRequestID, args.ThreadID, args.CommentID)}
+	return reqURL.String()
+}
+
+// ListPullRequestInlineComments returns the comments of all threads of the
+// specified PR, flattened and with the file and line of the thread resolved.
+// Deleted threads and comments, as well as system generated comments (e.g.
+// vote notifications), are omitted.
+func (c *client) ListPullRequestInlineComments(ctx context.Context, args PullRequestCommonArgs) ([]InlineComment, error) {
+	threads, err := c.ListPullRequestThreads(ctx, args, ListPullRequestThreadsOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var comments []InlineComment
+	for _, thread := range threads {
+		comments = append(comments, inlineComments(thread)...)
+	}
+
+	return comments, nil
+}
+
+// ListPullRequestCommentsUpdatedSince is like ListPullRequestInlineComments,
+// but only returns the comments published or updated after since, ordered by
+// that time, e.g. to poll for discussion activity that a webhook may have
+// missed. Like ListPullRequestThreadsUpdatedSince, all threads are listed and
+// filtered client-side.
+//
+// The returned cursor is the latest time any thread or comment was published
+// or updated, or since if that's later, for use as since of the next call.
+func (c *client) ListPullRequestCommentsUpdatedSince(ctx context.Context, args PullRequestCommonArgs, since time.Time) (comments []InlineComment, cursor time.Time, err error) {
+	threads, err := c.ListPullRequestThreads(ctx, args, ListPullRequestThreadsOptions{})
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	cursor = since
+	for _, thread := range threads {
+		if latest := thread.latestUpdate(); latest.After(cursor) {
+			cursor = latest
+		}
+		for _, comment := range inlineComments(thread) {
+			if comment.Comment.latestUpdate().After(since) {
+				comments = append(comments, comment)
+			}
+		}
+	}
+	sort.SliceStable(comments, func(i, j int) bool {
+		return comments[i].Comment.latestUpdate().Before(comments[j].Comment.latestUpdate())
+	})
+
+	return comments, cursor, nil
+}
+
+// inlineComments returns the comments of thread with the file and line of the
+// thread resolved, omitting deleted and system generated comments.
+func inlineComments(thread PullRequestCommentResponse) []InlineComment {
+	if thread.IsDeleted {
+		return nil
+	}
+
+	var filePath string
+	var line int
+	var side CommentSide
+	if tc := thread.ThreadContext; tc != nil {
+		filePath = tc.FilePath
+		switch {
+		case tc.RightFileStart != nil:
+			line, side = tc.RightFileStart.Line, CommentSideRight
+		case tc.LeftFileStart != nil:
+			line, side = tc.LeftFileStart.Line, CommentSideLeft
+		}
+	}
+
+	var comments []InlineComment
+	for _, comment := range thread.Comments {
+		if comment.IsDeleted || comment.CommentType == "system" {
+			continue
+		}
+		comments = append(comments, InlineComment{
+			ThreadID:   thread.ID,
+			Comment:    comment,
+			FilePath:   filePath,
+			LineNumber: line,
+			Side:       side,
+		})
+	}
+	return comments
+}
+
 // CompletePullRequest completes(merges) the specified PR, returns the updated PR.
 func (c *client) CompletePullRequest(ctx context.Context, args PullRequestCommonArgs, input PullRequestCompleteInput) (PullRequest, error) {
 	reqURL := url.URL{Path: fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pullrequests/%s", args.Org, args.Project, args.RepoNameOrID, args.PullRequestID)}
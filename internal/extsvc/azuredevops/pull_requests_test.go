@@ -2,9 +2,22 @@ package azuredevops
 
 import (
 	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
 	"github.com/sourcegraph/sourcegraph/internal/testutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestClient_CreatePullRequest(t *testing.T) {
@@ -64,7 +77,7 @@ func TestClient_GetPullRequest(t *testing.T) {
 		RepoNameOrID:  "sgtestazure",
 	}
 
-	resp, err := cli.GetPullRequest(context.Background(), args)
+	resp, err := cli.GetPullRequest(context.Background(), args, GetPullRequestOptions{})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -145,6 +158,76 @@ func TestClient_CreatePullRequestCommentThread(t *testing.T) {
 	testutil.AssertGolden(t, "testdata/golden/CreatePullRequestCommentThread.json", *update, resp)
 }
 
+func TestClient_CreatePullRequestCommentThread_Inline(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{
+			"filePath":       "/src/main.go",
+			"leftFileStart":  nil,
+			"leftFileEnd":    nil,
+			"rightFileStart": map[string]any{"line": float64(10), "offset": float64(1)},
+			"rightFileEnd":   map[string]any{"line": float64(12), "offset": float64(1)},
+		}, body["threadContext"])
+		assert.Equal(t, map[string]any{
+			"iterationContext": map[string]any{"firstComparingIteration": float64(1), "secondComparingIteration": float64(3)},
+		}, body["pullRequestThreadContext"])
+		w.Write([]byte(`{"id": 1}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	tc, err := InlineCommentContext("src/main.go", CommentSideRight, 10, 12)
+	require.NoError(t, err)
+	args := PullRequestCommonArgs{Org: "org", Project: "project", RepoNameOrID: "repo", PullRequestID: "1"}
+	input := PullRequestCommentInput{
+		Comments:         []PullRequestCommentForInput{{Content: "inline", CommentType: 1}},
+		ThreadContext:    tc,
+		IterationContext: &CommentIterationContext{FirstComparingIteration: 1, SecondComparingIteration: 3},
+	}
+	_, err = cli.CreatePullRequestCommentThread(context.Background(), args, input)
+	require.NoError(t, err)
+
+	input.IterationContext = &CommentIterationContext{FirstComparingIteration: 3, SecondComparingIteration: 1}
+	_, err = cli.CreatePullRequestCommentThread(context.Background(), args, input)
+	assert.Error(t, err)
+}
+
+func TestInlineCommentContext(t *testing.T) {
+	tc, err := InlineCommentContext("/main.go", CommentSideRight, 5, 5)
+	require.NoError(t, err)
+	assert.Equal(t, &PullRequestThreadContext{
+		FilePath:       "/main.go",
+		RightFileStart: &CommentPosition{Line: 5, Offset: 1},
+		RightFileEnd:   &CommentPosition{Line: 5, Offset: 1},
+	}, tc)
+
+	tc, err = InlineCommentContext("old.go", CommentSideLeft, 3, 7)
+	require.NoError(t, err)
+	assert.Equal(t, &PullRequestThreadContext{
+		FilePath:      "/old.go",
+		LeftFileStart: &CommentPosition{Line: 3, Offset: 1},
+		LeftFileEnd:   &CommentPosition{Line: 7, Offset: 1},
+	}, tc)
+
+	for _, invalid := range []struct {
+		path       string
+		side       CommentSide
+		start, end int
+	}{
+		{"", CommentSideRight, 1, 1},
+		{"dir/", CommentSideRight, 1, 1},
+		{"main.go", CommentSideRight, 0, 1},
+		{"main.go", CommentSideRight, 3, 2},
+		{"main.go", "both", 1, 1},
+	} {
+		_, err := InlineCommentContext(invalid.path, invalid.side, invalid.start, invalid.end)
+		assert.Error(t, err, "%+v", invalid)
+	}
+}
+
 func TestClient_CompletePullRequest(t *testing.T) {
 	cli, save := NewTestClient(t, "CompletePullRequest", *update)
 	t.Cleanup(save)
@@ -167,3 +250,789 @@ func TestClient_CompletePullRequest(t *testing.T) {
 
 	testutil.AssertGolden(t, "testdata/golden/CompletePullRequest.json", *update, resp)
 }
+
+func TestClient_ListPullRequestThreads(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/org/project/_apis/git/repositories/repo/pullrequests/1/threads", r.URL.Path)
+		assert.Equal(t, "3", r.URL.Query().Get("$iteration"))
+		assert.Equal(t, "1", r.URL.Query().Get("$baseIteration"))
+		w.Write([]byte(`{"count": 1, "value": [{"id": 1, "threadContext": {"filePath": "/main.go", "rightFileStart": {"line": 12, "offset": 1}}}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	args := PullRequestCommonArgs{Org: "org", Project: "project", RepoNameOrID: "repo", PullRequestID: "1"}
+
+	threads, err := cli.ListPullRequestThreads(ctx, args, ListPullRequestThreadsOptions{IterationID: 3, BaseIterationID: 1})
+	require.NoError(t, err)
+	require.Len(t, threads, 1)
+	assert.Equal(t, 12, threads[0].ThreadContext.RightFileStart.Line)
+
+	for _, invalid := range []ListPullRequestThreadsOptions{
+		{BaseIterationID: 1},
+		{IterationID: 2, BaseIterationID: 2},
+		{IterationID: -1},
+	} {
+		_, err := cli.ListPullRequestThreads(ctx, args, invalid)
+		assert.Error(t, err, "%+v", invalid)
+	}
+}
+
+func TestClient_GetPullRequestsBatch(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			prev := maxInFlight.Load()
+			if n <= prev || maxInFlight.CompareAndSwap(prev, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		id := strings.TrimPrefix(r.URL.Path, "/org/project/_apis/git/repositories/repo/pullrequests/")
+		if id == "3" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"pullRequestId": ` + id + `}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+	result, err := cli.GetPullRequestsBatch(context.Background(), args, []string{"5", "1", "3", "4", "2", "6"}, GetPullRequestsBatchOptions{MaxConcurrency: 2})
+	require.NoError(t, err)
+
+	var ids []int
+	for _, pr := range result.PullRequests {
+		ids = append(ids, pr.ID)
+	}
+	assert.Equal(t, []int{5, 1, 4, 2, 6}, ids)
+	require.Len(t, result.Errors, 1)
+	assert.True(t, errcode.IsNotFound(result.Errors["3"]))
+	assert.ErrorContains(t, result.Err(), "getting pull request 3")
+	assert.LessOrEqual(t, maxInFlight.Load(), int32(2))
+}
+
+func TestClient_ListPullRequestThreadsUpdatedSince(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"count": 3, "value": [
+			{"id": 1, "publishedDate": "2023-01-01T00:00:00Z", "lastUpdatedDate": "2023-01-01T00:00:00Z", "comments": [
+				{"id": 1, "publishedDate": "2023-01-01T00:00:00Z", "lastUpdatedDate": "2023-01-01T00:00:00Z"}
+			]},
+			{"id": 2, "publishedDate": "2023-01-01T00:00:00Z", "lastUpdatedDate": "2023-01-01T00:00:00Z", "comments": [
+				{"id": 1, "publishedDate": "2023-01-01T00:00:00Z", "lastUpdatedDate": "2023-01-01T00:00:00Z"},
+				{"id": 2, "publishedDate": "2023-03-01T00:00:00Z", "lastUpdatedDate": "2023-03-02T00:00:00Z"}
+			]},
+			{"id": 3, "publishedDate": "2023-02-01T00:00:00Z", "lastUpdatedDate": "2023-02-15T00:00:00Z"}
+		]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	args := PullRequestCommonArgs{Org: "org", Project: "project", RepoNameOrID: "repo", PullRequestID: "1"}
+	since := time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	threads, cursor, err := cli.ListPullRequestThreadsUpdatedSince(ctx, args, since, ListPullRequestThreadsOptions{})
+	require.NoError(t, err)
+	var ids []int
+	for _, thread := range threads {
+		ids = append(ids, thread.ID)
+	}
+	assert.Equal(t, []int{2, 3}, ids)
+	assert.Equal(t, time.Date(2023, 3, 2, 0, 0, 0, 0, time.UTC), cursor)
+
+	// Nothing changed since the cursor.
+	threads, next, err := cli.ListPullRequestThreadsUpdatedSince(ctx, args, cursor, ListPullRequestThreadsOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, threads)
+	assert.Equal(t, cursor, next)
+}
+
+func TestClient_ListPullRequestInlineComments(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/org/project/_apis/git/repositories/repo/pullrequests/1/threads", r.URL.Path)
+		w.Write([]byte(`{"count": 4, "value": [
+			{"id": 1, "comments": [{"id": 1, "content": "general", "commentType": "text", "author": {"displayName": "alice"}}]},
+			{"id": 2, "threadContext": {"filePath": "/main.go", "rightFileStart": {"line": 10, "offset": 1}, "rightFileEnd": {"line": 10, "offset": 5}}, "comments": [
+				{"id": 1, "content": "inline", "commentType": "text"},
+				{"id": 2, "parentCommentId": 1, "content": "deleted", "commentType": "text", "isDeleted": true}
+			]},
+			{"id": 3, "threadContext": {"filePath": "/old.go", "leftFileStart": {"line": 3, "offset": 1}}, "comments": [
+				{"id": 1, "content": "removed line", "commentType": "text"},
+				{"id": 2, "content": "voted", "commentType": "system"}
+			]},
+			{"id": 4, "isDeleted": true, "comments": [{"id": 1, "content": "gone", "commentType": "text"}]}
+		]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	comments, err := cli.ListPullRequestInlineComments(context.Background(), PullRequestCommonArgs{
+		Org:           "org",
+		Project:       "project",
+		RepoNameOrID:  "repo",
+		PullRequestID: "1",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []InlineComment{
+		{ThreadID: 1, Comment: PullRequestCommentForResponse{ID: 1, Content: "general", CommentType: "text", Author: CreatorInfo{DisplayName: "alice"}}},
+		{ThreadID: 2, Comment: PullRequestCommentForResponse{ID: 1, Content: "inline", CommentType: "text"}, FilePath: "/main.go", LineNumber: 10, Side: CommentSideRight},
+		{ThreadID: 3, Comment: PullRequestCommentForResponse{ID: 1, Content: "removed line", CommentType: "text"}, FilePath: "/old.go", LineNumber: 3, Side: CommentSideLeft},
+	}, comments)
+}
+
+func TestClient_ListPullRequestCommentsUpdatedSince(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"count": 3, "value": [
+			{"id": 1, "publishedDate": "2023-01-01T00:00:00Z", "lastUpdatedDate": "2023-01-01T00:00:00Z", "comments": [
+				{"id": 1, "commentType": "text", "publishedDate": "2023-01-01T00:00:00Z", "lastUpdatedDate": "2023-03-01T00:00:00Z"},
+				{"id": 2, "commentType": "text", "publishedDate": "2023-01-01T00:00:00Z", "lastUpdatedDate": "2023-01-01T00:00:00Z"}
+			]},
+			{"id": 2, "threadContext": {"filePath": "/main.go", "rightFileStart": {"line": 10, "offset": 1}}, "publishedDate": "2023-02-01T00:00:00Z", "lastUpdatedDate": "2023-02-01T00:00:00Z", "comments": [
+				{"id": 1, "commentType": "text", "publishedDate": "2023-02-01T00:00:00Z"},
+				{"id": 2, "commentType": "system", "publishedDate": "2023-04-01T00:00:00Z"}
+			]}
+		]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	args := PullRequestCommonArgs{Org: "org", Project: "project", RepoNameOrID: "repo", PullRequestID: "1"}
+	since := time.Date(2023, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	comments, cursor, err := cli.ListPullRequestCommentsUpdatedSince(ctx, args, since)
+	require.NoError(t, err)
+	type key struct {
+		thread  int
+		comment int64
+	}
+	var keys []key
+	for _, comment := range comments {
+		keys = append(keys, key{comment.ThreadID, comment.Comment.ID})
+	}
+	assert.Equal(t, []key{{2, 1}, {1, 1}}, keys)
+	assert.Equal(t, "/main.go", comments[0].FilePath)
+	// The system comment isn't returned, but still advances the cursor.
+	assert.Equal(t, time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC), cursor)
+
+	comments, next, err := cli.ListPullRequestCommentsUpdatedSince(ctx, args, cursor)
+	require.NoError(t, err)
+	assert.Empty(t, comments)
+	assert.Equal(t, cursor, next)
+}
+
+func TestClient_SetPullRequestAutoComplete(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, map[string]any{"id": "user-id"}, body["autoCompleteSetBy"])
+		assert.Equal(t, "squash", body["completionOptions"].(map[string]any)["mergeStrategy"])
+
+		w.Write([]byte(`{"pullRequestId": 1, "autoCompleteSetBy": {"id": "user-id"}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	args := PullRequestCommonArgs{Org: "org", Project: "project", RepoNameOrID: "repo", PullRequestID: "1"}
+
+	pr, err := cli.SetPullRequestAutoComplete(ctx, args, PullRequestAutoCompleteInput{
+		SetByID:           "user-id",
+		CompletionOptions: PullRequestCompletionOptions{MergeStrategy: PullRequestMergeStrategySquash},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, pr.AutoCompleteSetBy)
+	assert.Equal(t, "user-id", pr.AutoCompleteSetBy.ID)
+
+	_, err = cli.SetPullRequestAutoComplete(ctx, args, PullRequestAutoCompleteInput{})
+	assert.Error(t, err)
+
+	_, err = cli.SetPullRequestAutoComplete(ctx, args, PullRequestAutoCompleteInput{
+		SetByID:           "user-id",
+		CompletionOptions: PullRequestCompletionOptions{MergeStrategy: "yolo"},
+	})
+	assert.Error(t, err)
+}
+
+func TestClient_CreatePullRequest_DryRun(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	_, err = cli.CreatePullRequest(context.Background(), OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}, CreatePullRequestInput{
+		SourceRefName: "refs/heads/feature",
+		TargetRefName: "refs/heads/main",
+		DryRun:        true,
+	})
+	assert.ErrorIs(t, err, ErrDryRunNotSupported)
+}
+
+func TestClient_ListPullRequests(t *testing.T) {
+	creatorID := "f8d4b5a0-1b2c-4d3e-8f90-123456789abc"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/org/project/_apis/git/repositories/repo/pullrequests", r.URL.Path)
+		q := r.URL.Query()
+		assert.Equal(t, creatorID, q.Get("searchCriteria.creatorId"))
+		assert.Equal(t, "refs/heads/feature", q.Get("searchCriteria.sourceRefName"))
+		assert.Equal(t, "all", q.Get("searchCriteria.status"))
+		assert.False(t, q.Has("searchCriteria.reviewerId"))
+		assert.Equal(t, "100", q.Get("$top"))
+
+		// Return a full page first, so that a second page is requested.
+		n := listPullRequestsPageSize
+		if q.Get("$skip") != "0" {
+			assert.Equal(t, "100", q.Get("$skip"))
+			n = 1
+		}
+		var resp ListPullRequestsResponse
+		for i := 0; i < n; i++ {
+			resp.Value = append(resp.Value, PullRequest{ID: len(resp.Value) + 1})
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+	prs, err := cli.ListPullRequests(context.Background(), args, PullRequestSearchCriteria{
+		CreatorID:     creatorID,
+		SourceRefName: "refs/heads/feature",
+		Status:        PullRequestStatusAll,
+	})
+	require.NoError(t, err)
+	assert.Len(t, prs, 101)
+
+	_, err = cli.ListPullRequests(context.Background(), args, PullRequestSearchCriteria{ReviewerID: "someone"})
+	assert.Error(t, err)
+}
+
+func TestClient_ListPullRequests_ListOptions(t *testing.T) {
+	var pages []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		pages = append(pages, q.Get("$skip")+"+"+q.Get("$top"))
+		top, _ := strconv.Atoi(q.Get("$top"))
+		var resp ListPullRequestsResponse
+		for i := 0; i < top; i++ {
+			resp.Value = append(resp.Value, PullRequest{ID: i})
+		}
+		json.NewEncoder(w).Encode(resp)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+	prs, err := cli.ListPullRequests(context.Background(), args, PullRequestSearchCriteria{ListOptions: ListOptions{Top: 150, Skip: 10}})
+	require.NoError(t, err)
+	assert.Len(t, prs, 150)
+	assert.Equal(t, []string{"10+100", "110+50"}, pages)
+
+	_, err = cli.ListPullRequests(context.Background(), args, PullRequestSearchCriteria{ListOptions: ListOptions{ContinuationToken: "x"}})
+	assert.Error(t, err)
+}
+
+func TestClient_UploadPullRequestAttachment(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/org/project/_apis/git/repositories/repo/pullrequests/1/attachments/build log.txt", r.URL.Path)
+		assert.Equal(t, pullRequestAttachmentsAPIVersion, r.URL.Query().Get("api-version"))
+		assert.Equal(t, "application/octet-stream", r.Header.Get("Content-Type"))
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "\x00binary", string(body))
+
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id": 3, "displayName": "build log.txt", "url": "https://dev.azure.com/org/_apis/git/repositories/repo/pullRequests/1/attachments/build%20log.txt"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	args := PullRequestCommonArgs{Org: "org", Project: "project", RepoNameOrID: "repo", PullRequestID: "1"}
+	attachment, err := cli.UploadPullRequestAttachment(context.Background(), args, "build log.txt", strings.NewReader("\x00binary"))
+	require.NoError(t, err)
+	assert.Equal(t, Attachment{
+		ID:          3,
+		DisplayName: "build log.txt",
+		URL:         "https://dev.azure.com/org/_apis/git/repositories/repo/pullRequests/1/attachments/build%20log.txt",
+	}, attachment)
+
+	for _, fileName := range []string{"", ".", "..", "../escape", `..\escape`, "dir/file"} {
+		_, err = cli.UploadPullRequestAttachment(context.Background(), args, fileName, strings.NewReader(""))
+		assert.Error(t, err, fileName)
+	}
+}
+
+func TestClient_CommentLikes(t *testing.T) {
+	liked := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/org/project/_apis/git/repositories/repo/pullrequests/1/threads/2/comments/3/likes", r.URL.Path)
+		switch r.Method {
+		case "GET":
+			var likes ListCommentLikesResponse
+			if liked {
+				likes.Value = []CreatorInfo{{ID: "me", DisplayName: "Me"}}
+			}
+			json.NewEncoder(w).Encode(likes)
+		case "POST":
+			if liked {
+				w.WriteHeader(http.StatusConflict)
+				return
+			}
+			liked = true
+			w.WriteHeader(http.StatusNoContent)
+		case "DELETE":
+			liked = false
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	args := PullRequestCommentArgs{
+		PullRequestCommonArgs: PullRequestCommonArgs{Org: "org", Project: "project", RepoNameOrID: "repo", PullRequestID: "1"},
+		ThreadID:              2,
+		CommentID:             3,
+	}
+
+	require.NoError(t, cli.LikeComment(ctx, args))
+	// Liking again is a no-op.
+	require.NoError(t, cli.LikeComment(ctx, args))
+
+	likes, err := cli.ListCommentLikes(ctx, args)
+	require.NoError(t, err)
+	assert.Equal(t, []CreatorInfo{{ID: "me", DisplayName: "Me"}}, likes)
+
+	require.NoError(t, cli.UnlikeComment(ctx, args))
+	likes, err = cli.ListCommentLikes(ctx, args)
+	require.NoError(t, err)
+	assert.Empty(t, likes)
+}
+
+func TestClient_ListPullRequestReviewers(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/org/project/_apis/git/repositories/repo/pullrequests/1/reviewers", r.URL.Path)
+		w.Write([]byte(`{"count": 2, "value": [
+			{"id": "a", "displayName": "Alice", "vote": 10, "isRequired": true},
+			{"id": "b", "displayName": "Bob", "vote": 0, "hasDeclined": true}
+		]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	reviewers, err := cli.ListPullRequestReviewers(context.Background(), PullRequestCommonArgs{
+		Org:           "org",
+		Project:       "project",
+		RepoNameOrID:  "repo",
+		PullRequestID: "1",
+	}, ListPullRequestReviewersOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []Reviewer{
+		{ID: "a", DisplayName: "Alice", Vote: VoteApproved, IsRequired: true},
+		{ID: "b", DisplayName: "Bob", Vote: VoteNone, HasDeclined: true},
+	}, reviewers)
+}
+
+func TestClient_ListPullRequestReviewers_ResolveDisplayNames(t *testing.T) {
+	var identityRequests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/org/project/_apis/git/repositories/repo/pullrequests/1/reviewers":
+			w.Write([]byte(`{"count": 3, "value": [
+				{"id": "a", "displayName": "Alice"},
+				{"id": "b"},
+				{"id": "gone"}
+			]}`))
+		case "/org/_apis/identities":
+			identityRequests = append(identityRequests, r.URL.Query().Get("identityIds"))
+			w.Write([]byte(`{"count": 2, "value": [{"id": "b", "providerDisplayName": "Bob"}, null]}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	args := PullRequestCommonArgs{Org: "org", Project: "project", RepoNameOrID: "repo", PullRequestID: "1"}
+	want := []Reviewer{{ID: "a", DisplayName: "Alice"}, {ID: "b", DisplayName: "Bob"}, {ID: "gone"}}
+
+	reviewers, err := cli.ListPullRequestReviewers(context.Background(), args, ListPullRequestReviewersOptions{ResolveDisplayNames: true})
+	require.NoError(t, err)
+	assert.Equal(t, want, reviewers)
+
+	// Resolved names are cached.
+	reviewers, err = cli.ListPullRequestReviewers(context.Background(), args, ListPullRequestReviewersOptions{ResolveDisplayNames: true})
+	require.NoError(t, err)
+	assert.Equal(t, want, reviewers)
+	assert.Equal(t, []string{"b,gone", "gone"}, identityRequests)
+
+	reviewers, err = cli.ListPullRequestReviewers(context.Background(), args, ListPullRequestReviewersOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "", reviewers[1].DisplayName)
+	assert.Len(t, identityRequests, 2)
+}
+
+func TestIdentity_DisplayName(t *testing.T) {
+	assert.Equal(t, "Bob", Identity{ProviderDisplayName: "Bob"}.DisplayName())
+	assert.Equal(t, "Bobby", Identity{ProviderDisplayName: "Bob", CustomDisplayName: "Bobby"}.DisplayName())
+}
+
+func TestClient_PullRequestApprovalState(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/org/project/_apis/git/repositories/repo/pullrequests/1/reviewers", r.URL.Path)
+		w.Write([]byte(`{"count": 3, "value": [
+			{"id": "a", "vote": 10, "isRequired": true},
+			{"id": "b", "vote": 0, "isRequired": true},
+			{"id": "c", "vote": -5}
+		]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	state, err := cli.PullRequestApprovalState(context.Background(), PullRequestCommonArgs{
+		Org:           "org",
+		Project:       "project",
+		RepoNameOrID:  "repo",
+		PullRequestID: "1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ApprovalState{
+		WaitingCount:    1,
+		PendingRequired: []Reviewer{{ID: "b", IsRequired: true}},
+	}, state)
+}
+
+func TestApprovalState(t *testing.T) {
+	for name, tc := range map[string]struct {
+		reviewers []Reviewer
+		want      ApprovalState
+	}{
+		"no reviewers": {
+			want: ApprovalState{},
+		},
+		"approved": {
+			reviewers: []Reviewer{
+				{ID: "a", Vote: VoteApproved, IsRequired: true},
+				{ID: "b", Vote: VoteApprovedWithSuggestions},
+				{ID: "c", Vote: VoteNone},
+			},
+			want: ApprovalState{Approved: true},
+		},
+		"rejected": {
+			reviewers: []Reviewer{
+				{ID: "a", Vote: VoteApproved},
+				{ID: "b", Vote: VoteRejected},
+			},
+			want: ApprovalState{Rejected: true},
+		},
+		"optional reviewer waiting for author": {
+			reviewers: []Reviewer{
+				{ID: "a", Vote: VoteApproved, IsRequired: true},
+				{ID: "b", Vote: VoteWaitingForAuthor},
+			},
+			want: ApprovalState{WaitingCount: 1},
+		},
+		// The minimum number of reviewers of branch policies is ignored.
+		"single approval": {
+			reviewers: []Reviewer{
+				{ID: "a", Vote: VoteApproved},
+				{ID: "b", Vote: VoteNone},
+				{ID: "c", Vote: VoteNone},
+			},
+			want: ApprovalState{Approved: true},
+		},
+		"required reviewer pending": {
+			reviewers: []Reviewer{
+				{ID: "a", Vote: VoteApproved},
+				{ID: "b", Vote: VoteWaitingForAuthor, IsRequired: true},
+			},
+			want: ApprovalState{
+				WaitingCount:    1,
+				PendingRequired: []Reviewer{{ID: "b", Vote: VoteWaitingForAuthor, IsRequired: true}},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, approvalState(tc.reviewers))
+		})
+	}
+}
+
+func TestClient_RemovePullRequestReviewer(t *testing.T) {
+	const reviewerID = "fa4e907d-c16b-4a4c-9dfa-4906e5d171dd"
+	args := PullRequestCommonArgs{Org: "org", Project: "project", RepoNameOrID: "repo", PullRequestID: "1"}
+
+	for name, tc := range map[string]struct {
+		status  int
+		wantErr bool
+	}{
+		"removed":        {status: http.StatusNoContent},
+		"already absent": {status: http.StatusNotFound},
+		"error":          {status: http.StatusForbidden, wantErr: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "DELETE", r.Method)
+				assert.Equal(t, "/org/project/_apis/git/repositories/repo/pullrequests/1/reviewers/"+reviewerID, r.URL.Path)
+				w.WriteHeader(tc.status)
+			}))
+			t.Cleanup(srv.Close)
+
+			cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+			require.NoError(t, err)
+
+			err = cli.RemovePullRequestReviewer(context.Background(), args, reviewerID)
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("invalid reviewer ID", func(t *testing.T) {
+		cli, err := NewClient("test", "https://dev.azure.com", &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+		require.NoError(t, err)
+
+		for _, id := range []string{"", "user@example.com", "{" + reviewerID + "}"} {
+			assert.Error(t, cli.RemovePullRequestReviewer(context.Background(), args, id), id)
+		}
+	})
+}
+
+func TestClient_GetPullRequest_Options(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "true", r.URL.Query().Get("includeWorkItemRefs"))
+		assert.Equal(t, "true", r.URL.Query().Get("includeCommits"))
+		w.Write([]byte(`{
+			"pullRequestId": 1,
+			"labels": [{"id": "l1", "name": "bug", "active": true}],
+			"workItemRefs": [{"id": "42", "url": "https://dev.azure.com/org/_apis/wit/workItems/42"}],
+			"commits": [{"commitId": "abc"}]
+		}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	args := PullRequestCommonArgs{Org: "org", Project: "project", RepoNameOrID: "repo", PullRequestID: "1"}
+	pr, err := cli.GetPullRequest(context.Background(), args, GetPullRequestOptions{IncludeWorkItemRefs: true, IncludeCommits: true})
+	require.NoError(t, err)
+	assert.Equal(t, []Label{{ID: "l1", Name: "bug", Active: true}}, pr.Labels)
+	assert.Equal(t, []ResourceRef{{ID: "42", URL: "https://dev.azure.com/org/_apis/wit/workItems/42"}}, pr.WorkItemRefs)
+	assert.Equal(t, []Commit{{CommitID: "abc"}}, pr.Commits)
+}
+
+func TestClient_PullRequestProperties(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/org/project/_apis/git/repositories/repo/pullrequests/1/properties", r.URL.Path)
+		assert.Equal(t, pullRequestPropertiesAPIVersion, r.URL.Query().Get("api-version"))
+		if r.Method == "PATCH" {
+			assert.Equal(t, "application/json-patch+json", r.Header.Get("Content-Type"))
+			var ops []JSONPatchOperation
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&ops))
+			assert.Equal(t, []JSONPatchOperation{
+				{Op: "add", Path: "/sourcegraph.batchSpec~1id", Value: "spec-1"},
+				{Op: "remove", Path: "/old~0key"},
+			}, ops)
+		}
+		w.Write([]byte(`{"count": 1, "value": {"sourcegraph.batchSpec/id": {"$type": "System.String", "$value": "spec-1"}}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	args := PullRequestCommonArgs{Org: "org", Project: "project", RepoNameOrID: "repo", PullRequestID: "1"}
+	want := map[string]PropertyValue{"sourcegraph.batchSpec/id": {Type: "System.String", Value: "spec-1"}}
+
+	properties, err := cli.SetPullRequestProperties(ctx, args, []JSONPatchOperation{
+		AddPropertyOperation("sourcegraph.batchSpec/id", "spec-1"),
+		RemovePropertyOperation("old~key"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, want, properties)
+
+	properties, err = cli.GetPullRequestProperties(ctx, args)
+	require.NoError(t, err)
+	assert.Equal(t, want, properties)
+}
+
+func TestClient_GetPullRequestByRefs(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "active", q.Get("searchCriteria.status"))
+		assert.Equal(t, "refs/heads/main", q.Get("searchCriteria.targetRefName"))
+		switch q.Get("searchCriteria.sourceRefName") {
+		case "refs/heads/feature":
+			w.Write([]byte(`{"count": 1, "value": [{"pullRequestId": 1}]}`))
+		case "refs/heads/dup":
+			w.Write([]byte(`{"count": 2, "value": [{"pullRequestId": 2}, {"pullRequestId": 3}]}`))
+		default:
+			w.Write([]byte(`{"count": 0, "value": []}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+
+	pr, err := cli.GetPullRequestByRefs(ctx, args, "feature", "main")
+	require.NoError(t, err)
+	assert.Equal(t, 1, pr.ID)
+
+	pr, err = cli.GetPullRequestByRefs(ctx, args, "refs/heads/feature", "refs/heads/main")
+	require.NoError(t, err)
+	assert.Equal(t, 1, pr.ID)
+
+	_, err = cli.GetPullRequestByRefs(ctx, args, "missing", "main")
+	assert.True(t, errcode.IsNotFound(err))
+
+	_, err = cli.GetPullRequestByRefs(ctx, args, "dup", "main")
+	require.Error(t, err)
+	assert.False(t, errcode.IsNotFound(err))
+	assert.Contains(t, err.Error(), "2, 3")
+}
+
+func TestClient_ReactivatePullRequest(t *testing.T) {
+	status := PullRequestStatusAbandoned
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/org/project/_apis/git/repositories/repo/pullrequests/1", r.URL.Path)
+		if r.Method == "PATCH" {
+			var input PullRequestUpdateInput
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&input))
+			require.NotNil(t, input.Status)
+			assert.Equal(t, PullRequestStatusActive, *input.Status)
+			status = *input.Status
+		}
+		json.NewEncoder(w).Encode(PullRequest{ID: 1, Status: status})
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	args := PullRequestCommonArgs{Org: "org", Project: "project", RepoNameOrID: "repo", PullRequestID: "1"}
+	pr, err := cli.ReactivatePullRequest(context.Background(), args)
+	require.NoError(t, err)
+	assert.Equal(t, PullRequestStatusActive, pr.Status)
+
+	_, err = cli.ReactivatePullRequest(context.Background(), args)
+	assert.ErrorContains(t, err, "it is active, not abandoned")
+
+	status = PullRequestStatusCompleted
+	_, err = cli.ReactivatePullRequest(context.Background(), args)
+	assert.ErrorContains(t, err, "it is completed, not abandoned")
+}
+
+func TestClient_UpdatePullRequest_IfMatch(t *testing.T) {
+	etag := `"1"`
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "PATCH" {
+			if m := r.Header.Get("If-Match"); m != "" && m != etag {
+				w.WriteHeader(http.StatusPreconditionFailed)
+				return
+			}
+			etag = `"2"`
+		}
+		w.Header().Set("ETag", etag)
+		w.Write([]byte(`{"pullRequestId": 1}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	args := PullRequestCommonArgs{Org: "org", Project: "project", RepoNameOrID: "repo", PullRequestID: "1"}
+	var meta ResponseMeta
+	_, err = cli.GetPullRequest(WithResponseMeta(context.Background(), &meta), args, GetPullRequestOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, `"1"`, meta.ETag)
+
+	title := "title"
+	_, err = cli.UpdatePullRequest(context.Background(), args, PullRequestUpdateInput{Title: &title, IfMatch: meta.ETag})
+	require.NoError(t, err)
+
+	// The PR was modified since we fetched it.
+	_, err = cli.UpdatePullRequest(context.Background(), args, PullRequestUpdateInput{Title: &title, IfMatch: meta.ETag})
+	var e *PreconditionFailedError
+	assert.True(t, errors.As(err, &e))
+
+	// Without an ETag updates are unconditional.
+	_, err = cli.UpdatePullRequest(context.Background(), args, PullRequestUpdateInput{Title: &title})
+	require.NoError(t, err)
+}
+
+func TestClient_SetPullRequestDraft(t *testing.T) {
+	var bodies []map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PATCH", r.Method)
+		assert.Equal(t, "/org/project/_apis/git/repositories/repo/pullrequests/1", r.URL.Path)
+		var body map[string]any
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		bodies = append(bodies, body)
+		w.Write([]byte(`{"pullRequestId": 1, "isDraft": ` + strconv.FormatBool(body["isDraft"].(bool)) + `}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	args := PullRequestCommonArgs{Org: "org", Project: "project", RepoNameOrID: "repo", PullRequestID: "1"}
+	pr, err := cli.SetPullRequestDraft(context.Background(), args, true)
+	require.NoError(t, err)
+	assert.True(t, pr.IsDraft)
+
+	pr, err = cli.SetPullRequestDraft(context.Background(), args, false)
+	require.NoError(t, err)
+	assert.False(t, pr.IsDraft)
+
+	require.Len(t, bodies, 2)
+	assert.Equal(t, map[string]any{"id": cancelAutoCompleteID}, bodies[0]["autoCompleteSetBy"])
+	assert.NotContains(t, bodies[1], "autoCompleteSetBy")
+}
@@ -0,0 +1,97 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	// maxPushCommits is the maximum number of commits of a push we look at.
+	maxPushCommits = 1000
+	// commitChangesPageSize is the number of changes requested per page.
+	commitChangesPageSize = 1000
+)
+
+// GetPushChanges returns the changes to files made by the commits of the push
+// with the ID pushID. A path changed by several commits is returned once per
+// commit. A push that changes no files, e.g. one that only adds a merge commit
+// without changes, returns an empty slice.
+//
+// Azure DevOps doesn't list the changes of a push, so they're listed per
+// commit. Only the first maxPushCommits commits of a push are considered.
+func (c *client) GetPushChanges(ctx context.Context, args OrgProjectRepoArgs, pushID int) ([]Change, error) {
+	push, err := c.getPush(ctx, args, pushID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := []Change{}
+	for _, commit := range push.Commits {
+		commitChanges, err := c.listCommitChanges(ctx, args, commit.CommitID)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, commitChanges...)
+	}
+
+	return changes, nil
+}
+
+func (c *client) getPush(ctx context.Context, args OrgProjectRepoArgs, pushID int) (Push, error) {
+	queryParams := make(url.Values)
+	queryParams.Set("includeCommits", strconv.Itoa(maxPushCommits))
+
+	reqURL := url.URL{
+		Path:     fmt.Sprintf("%s/%s/_apis/git/repositories/%s/pushes/%d", args.Org, args.Project, args.RepoNameOrID, pushID),
+		RawQuery: queryParams.Encode(),
+	}
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return Push{}, err
+	}
+
+	var push Push
+	if _, err = c.do(ctx, req, "", &push); err != nil {
+		return Push{}, err
+	}
+
+	return push, nil
+}
+
+// listCommitChanges returns the changes commitID made compared to its first
+// parent, following pages until all changes were fetched.
+func (c *client) listCommitChanges(ctx context.Context, args OrgProjectRepoArgs, commitID string) ([]Change, error) {
+	reqURL := url.URL{Path: fmt.Sprintf("%s/%s/_apis/git/repositories/%s/commits/%s/changes", args.Org, args.Project, args.RepoNameOrID, commitID)}
+
+	var changes []Change
+	for skip := 0; ; skip += commitChangesPageSize {
+		queryParams := make(url.Values)
+		queryParams.Set("top", strconv.Itoa(commitChangesPageSize))
+		queryParams.Set("skip", strconv.Itoa(skip))
+		reqURL.RawQuery = queryParams.Encode()
+
+		req, err := http.NewRequest("GET", reqURL.String(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp CommitChangesResponse
+		if _, err = c.do(ctx, req, "", &resp); err != nil {
+			return nil, err
+		}
+		for _, change := range resp.Changes {
+			change.CommitID = commitID
+			changes = append(changes, change)
+		}
+
+		if len(resp.Changes) < commitChangesPageSize {
+			break
+		}
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,74 @@
+package azuredevops
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetPushChanges(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		const prefix = "/org/project/_apis/git/repositories/repo"
+		switch r.URL.Path {
+		case prefix + "/pushes/1":
+			assert.Equal(t, "1000", r.URL.Query().Get("includeCommits"))
+			w.Write([]byte(`{"pushId": 1, "commits": [{"commitId": "big"}, {"commitId": "small"}]}`))
+		case prefix + "/pushes/2":
+			w.Write([]byte(`{"pushId": 2, "commits": [{"commitId": "merge"}]}`))
+		case prefix + "/commits/big/changes":
+			// The first page is full, so the client has to request another.
+			var resp CommitChangesResponse
+			switch r.URL.Query().Get("skip") {
+			case "0":
+				for i := 0; i < commitChangesPageSize; i++ {
+					resp.Changes = append(resp.Changes, Change{ChangeType: "add", Item: ChangeItem{Path: fmt.Sprintf("/gen/%d.go", i)}})
+				}
+			case "1000":
+				resp.Changes = []Change{{ChangeType: "edit, rename", Item: ChangeItem{Path: "/new.go"}, SourceServerItem: "/old.go"}}
+			default:
+				t.Errorf("unexpected skip %q", r.URL.Query().Get("skip"))
+			}
+			json.NewEncoder(w).Encode(resp)
+		case prefix + "/commits/small/changes":
+			w.Write([]byte(`{"changeCounts": {"Delete": 1}, "changes": [{"item": {"gitObjectType": "blob", "path": "/gone.go"}, "changeType": "delete"}]}`))
+		case prefix + "/commits/merge/changes":
+			w.Write([]byte(`{"changeCounts": {}, "changes": []}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+
+	changes, err := cli.GetPushChanges(ctx, args, 1)
+	require.NoError(t, err)
+	require.Len(t, changes, commitChangesPageSize+2)
+	assert.Equal(t, "big", changes[0].CommitID)
+
+	renamed := changes[commitChangesPageSize]
+	assert.Equal(t, "/new.go", renamed.Item.Path)
+	assert.Equal(t, "/old.go", renamed.SourceServerItem)
+	assert.True(t, renamed.ChangeType.Has(VersionControlChangeTypeRename))
+	assert.True(t, renamed.ChangeType.Has(VersionControlChangeTypeEdit))
+	assert.False(t, renamed.ChangeType.Has(VersionControlChangeTypeAdd))
+
+	deleted := changes[commitChangesPageSize+1]
+	assert.Equal(t, Change{CommitID: "small", ChangeType: VersionControlChangeTypeDelete, Item: ChangeItem{GitObjectType: "blob", Path: "/gone.go"}}, deleted)
+
+	changes, err = cli.GetPushChanges(ctx, args, 2)
+	require.NoError(t, err)
+	assert.NotNil(t, changes)
+	assert.Empty(t, changes)
+}
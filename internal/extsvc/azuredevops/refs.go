@@ -0,0 +1,88 @@
+package azuredevops
+
+import (
+	"strings"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	branchRefPrefix = "refs/heads/"
+	tagRefPrefix    = "refs/tags/"
+)
+
+// ShortRefName returns the name of a branch or tag without the refs/heads/ or
+// refs/tags/ prefix, e.g. feature/foo for refs/heads/feature/foo. Names that
+// are already short, and other refs such as refs/pull/1/merge, are returned
+// unchanged.
+func ShortRefName(fullRef string) string {
+	if name, ok := strings.CutPrefix(fullRef, branchRefPrefix); ok {
+		return name
+	}
+	if name, ok := strings.CutPrefix(fullRef, tagRefPrefix); ok {
+		return name
+	}
+	return fullRef
+}
+
+// FullBranchRef returns the fully qualified ref of the branch with the given
+// name, e.g. refs/heads/feature/foo for feature/foo. Refs that are already
+// fully qualified, including those of tags, are returned unchanged, as is an
+// empty name.
+func FullBranchRef(short string) string {
+	return qualifyRef(short, branchRefPrefix)
+}
+
+// FullTagRef returns the fully qualified ref of the tag with the given name,
+// e.g. refs/tags/v1.0 for v1.0. Like FullBranchRef, it returns refs that are
+// already fully qualified unchanged.
+func FullTagRef(short string) string {
+	return qualifyRef(short, tagRefPrefix)
+}
+
+func qualifyRef(name, prefix string) string {
+	if name == "" || strings.HasPrefix(name, "refs/") {
+		return name
+	}
+	return prefix + name
+}
+
+// validateRefName returns the fully qualified form of name, a ref name to be
+// sent to a write API, or an error if it isn't a valid ref name. Mistakes
+// callers commonly make are normalized: a leading slash is removed, a repeated
+// refs/ or refs/heads/ prefix is collapsed, and branch names are qualified
+// with refs/heads/. Names that git would reject, see git-check-ref-format(1),
+// are not sent to Azure DevOps, which responds with confusing errors to them.
+func validateRefName(name string) (string, error) {
+	ref := strings.TrimPrefix(name, "/")
+	if ref == "" {
+		return "", errors.New("ref name must not be empty")
+	}
+	for strings.HasPrefix(ref, "refs/refs/") {
+		ref = strings.TrimPrefix(ref, "refs/")
+	}
+	for _, prefix := range []string{branchRefPrefix, tagRefPrefix} {
+		for strings.HasPrefix(ref, prefix+prefix) {
+			ref = strings.TrimPrefix(ref, prefix)
+		}
+	}
+	ref = FullBranchRef(ref)
+
+	if strings.HasSuffix(ref, "/") || strings.HasSuffix(ref, ".") {
+		return "", errors.Newf("invalid ref name %q: must not end with / or .", name)
+	}
+	if strings.Contains(ref, "..") || strings.Contains(ref, "@{") || strings.Contains(ref, "//") {
+		return "", errors.Newf("invalid ref name %q: must not contain .., @{ or //", name)
+	}
+	for _, r := range ref {
+		if r < ' ' || r == 0x7f || strings.ContainsRune(" ~^:?*[\\", r) {
+			return "", errors.Newf("invalid ref name %q: must not contain %q", name, r)
+		}
+	}
+	for _, component := range strings.Split(ref, "/") {
+		if strings.HasPrefix(component, ".") || strings.HasSuffix(component, ".lock") {
+			return "", errors.Newf("invalid ref name %q: components must not start with . or end with .lock", name)
+		}
+	}
+	return ref, nil
+}
@@ -0,0 +1,71 @@
+package azuredevops
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefNames(t *testing.T) {
+	for _, tc := range []struct {
+		ref       string
+		short     string
+		branchRef string
+		tagRef    string
+	}{
+		{ref: "main", short: "main", branchRef: "refs/heads/main", tagRef: "refs/tags/main"},
+		{ref: "refs/heads/main", short: "main", branchRef: "refs/heads/main", tagRef: "refs/heads/main"},
+		{ref: "feature/foo/bar", short: "feature/foo/bar", branchRef: "refs/heads/feature/foo/bar", tagRef: "refs/tags/feature/foo/bar"},
+		{ref: "refs/heads/feature/foo/bar", short: "feature/foo/bar", branchRef: "refs/heads/feature/foo/bar", tagRef: "refs/heads/feature/foo/bar"},
+		{ref: "refs/tags/v1.0", short: "v1.0", branchRef: "refs/tags/v1.0", tagRef: "refs/tags/v1.0"},
+		// A branch that happens to be named like a ref prefix.
+		{ref: "heads/main", short: "heads/main", branchRef: "refs/heads/heads/main", tagRef: "refs/tags/heads/main"},
+		{ref: "refs/pull/1/merge", short: "refs/pull/1/merge", branchRef: "refs/pull/1/merge", tagRef: "refs/pull/1/merge"},
+		{ref: "", short: "", branchRef: "", tagRef: ""},
+	} {
+		t.Run(tc.ref, func(t *testing.T) {
+			assert.Equal(t, tc.short, ShortRefName(tc.ref))
+			assert.Equal(t, tc.branchRef, FullBranchRef(tc.ref))
+			assert.Equal(t, tc.tagRef, FullTagRef(tc.ref))
+		})
+	}
+}
+
+func TestValidateRefName(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		want    string
+		wantErr bool
+	}{
+		{name: "main", want: "refs/heads/main"},
+		{name: "feature/foo/bar", want: "refs/heads/feature/foo/bar"},
+		{name: "refs/heads/main", want: "refs/heads/main"},
+		{name: "refs/tags/v1.0", want: "refs/tags/v1.0"},
+		{name: "/refs/heads/main", want: "refs/heads/main"},
+		{name: "refs/refs/heads/main", want: "refs/heads/main"},
+		{name: "refs/heads/refs/heads/main", want: "refs/heads/main"},
+		{name: "refs/tags/refs/tags/v1.0", want: "refs/tags/v1.0"},
+		{name: "", wantErr: true},
+		{name: "/", wantErr: true},
+		{name: "my branch", wantErr: true},
+		{name: "feature/", wantErr: true},
+		{name: "feature..x", wantErr: true},
+		{name: "feature//x", wantErr: true},
+		{name: "feature/.x", wantErr: true},
+		{name: "main.lock", wantErr: true},
+		{name: "main@{1}", wantErr: true},
+		{name: "a:b", wantErr: true},
+		{name: "tab\tname", wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := validateRefName(tc.name)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
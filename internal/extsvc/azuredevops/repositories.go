@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/sourcegraph/sourcegraph/lib/errors"
 )
@@ -27,20 +28,176 @@ func (c *client) GetRepo(ctx context.Context, args OrgProjectRepoArgs) (Reposito
 	return repo, nil
 }
 
-func (c *client) ListRepositoriesByProjectOrOrg(ctx context.Context, args ListRepositoriesByProjectOrOrgArgs) ([]Repository, error) {
+// GetRepositorySize returns the size of the repository in bytes, e.g. to
+// decide whether to mirror it. Azure DevOps reports the compressed size of the
+// objects it stores, which is close to but not exactly the size of a mirror
+// clone. If the instance doesn't report sizes, the size is unknown and 0 is
+// returned with exact set to false.
+func (c *client) GetRepositorySize(ctx context.Context, args OrgProjectRepoArgs) (size int64, exact bool, err error) {
+	repo, err := c.GetRepo(ctx, args)
+	if err != nil {
+		return 0, false, err
+	}
+	if repo.Size == nil {
+		return 0, false, nil
+	}
+	return *repo.Size, true, nil
+}
+
+// FindRepositoryByName returns the repository of the organization org named
+// repoName in any of its projects, e.g. to resolve a clone URL that lacks the
+// project. Names are compared case-insensitively, like Azure DevOps does. If
+// no repository has the name, a *NotFoundError is returned, and if
+// repositories of several projects do, an *AmbiguousRepositoryError.
+func (c *client) FindRepositoryByName(ctx context.Context, org, repoName string) (Repository, error) {
+	repos, err := c.ListRepositoriesByProjectOrOrg(ctx, ListRepositoriesByProjectOrOrgArgs{ProjectOrOrgName: org})
+	if err != nil {
+		return Repository{}, err
+	}
+
+	var candidates []Repository
+	for _, repo := range repos {
+		if strings.EqualFold(repo.Name, repoName) {
+			candidates = append(candidates, repo)
+		}
+	}
+
+	switch len(candidates) {
+	case 0:
+		return Repository{}, &NotFoundError{Scope: "repository", Err: errors.Newf("no repository named %q in organization %q", repoName, org)}
+	case 1:
+		return candidates[0], nil
+	default:
+		return Repository{}, &AmbiguousRepositoryError{Name: repoName, Candidates: candidates}
+	}
+}
+
+// ListRepositoriesPage returns the repositories of a project or organization.
+// The endpoint isn't paginated and returns every repository in a single
+// response, so the page always holds all of them and HasMore is false.
+func (c *client) ListRepositoriesPage(ctx context.Context, args ListRepositoriesByProjectOrOrgArgs) (RepositoriesPage, error) {
 	reqURL := url.URL{Path: fmt.Sprintf("%s/_apis/git/repositories", args.ProjectOrOrgName)}
 
 	req, err := http.NewRequest("GET", reqURL.String(), nil)
 	if err != nil {
-		return nil, err
+		return RepositoriesPage{}, err
 	}
 
 	var repos ListRepositoriesResponse
 	if _, err = c.do(ctx, req, "", &repos); err != nil {
+		return RepositoriesPage{}, err
+	}
+
+	return RepositoriesPage{Repositories: repos.Value}, nil
+}
+
+// ListRepositoriesByProjectOrOrg returns all repositories of a project or
+// organization.
+//
+// Deprecated: Use ListRepositoriesPage.
+func (c *client) ListRepositoriesByProjectOrOrg(ctx context.Context, args ListRepositoriesByProjectOrOrgArgs) ([]Repository, error) {
+	page, err := c.ListRepositoriesPage(ctx, args)
+	if err != nil {
 		return nil, err
 	}
+	return page.Repositories, nil
+}
+
+// ListRepositoriesByProjectsOrOrgs returns the repositories of each of the given
+// projects or organizations, each in the form ListRepositoriesByProjectOrOrgArgs
+// expects. A scope that can't be listed, e.g. because the token lost access to
+// it, doesn't fail the others: its error is recorded in the Errors of the
+// result instead. The returned error is only set if ctx is done.
+func (c *client) ListRepositoriesByProjectsOrOrgs(ctx context.Context, projectsOrOrgs []string) (ListRepositoriesResult, error) {
+	var result ListRepositoriesResult
+	for _, name := range projectsOrOrgs {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		repos, err := c.ListRepositoriesByProjectOrOrg(ctx, ListRepositoriesByProjectOrOrgArgs{ProjectOrOrgName: name})
+		if err != nil {
+			if result.Errors == nil {
+				result.Errors = make(map[string]error)
+			}
+			result.Errors[name] = err
+			continue
+		}
+		result.Repositories = append(result.Repositories, repos...)
+	}
+
+	return result, nil
+}
+
+// DiffRepositories lists the current repositories of the given project or
+// organization and compares their IDs to knownRepoIDs, e.g. the repositories
+// seen by the last sync. It returns the IDs of repositories that are new, in
+// the order they were listed, and of those that no longer exist, in the order
+// they were given.
+func (c *client) DiffRepositories(ctx context.Context, args ListRepositoriesByProjectOrOrgArgs, knownRepoIDs []string) (added, removed []string, err error) {
+	repos, err := c.ListRepositoriesByProjectOrOrg(ctx, args)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	return repos.Value, nil
+	known := make(map[string]struct{}, len(knownRepoIDs))
+	for _, id := range knownRepoIDs {
+		known[id] = struct{}{}
+	}
+
+	current := make(map[string]struct{}, len(repos))
+	for _, repo := range repos {
+		current[repo.ID] = struct{}{}
+		if _, ok := known[repo.ID]; !ok {
+			added = append(added, repo.ID)
+		}
+	}
+
+	for _, id := range knownRepoIDs {
+		if _, ok := current[id]; !ok {
+			removed = append(removed, id)
+			// Report duplicates in knownRepoIDs only once.
+			current[id] = struct{}{}
+		}
+	}
+
+	return added, removed, nil
+}
+
+// UpdateRepository updates the name and/or default branch of the specified
+// repository, returns the updated repository. If the new name is already taken
+// an *AlreadyExistsError is returned.
+func (c *client) UpdateRepository(ctx context.Context, args OrgProjectRepoArgs, input UpdateRepositoryInput) (Repository, error) {
+	if input.DefaultBranch != nil {
+		ref, err := validateRefName(*input.DefaultBranch)
+		if err != nil {
+			return Repository{}, err
+		}
+		input.DefaultBranch = &ref
+	}
+
+	data, err := json.Marshal(&input)
+	if err != nil {
+		return Repository{}, errors.Wrap(err, "marshalling request")
+	}
+
+	reqURL := url.URL{Path: fmt.Sprintf("%s/%s/_apis/git/repositories/%s", args.Org, args.Project, args.RepoNameOrID)}
+
+	req, err := http.NewRequest("PATCH", reqURL.String(), bytes.NewBuffer(data))
+	if err != nil {
+		return Repository{}, err
+	}
+
+	var repo Repository
+	if _, err = c.do(WithIfMatch(ctx, input.IfMatch), req, "", &repo); err != nil {
+		var e *HTTPError
+		if errors.As(err, &e) && e.StatusCode == http.StatusConflict {
+			return Repository{}, &AlreadyExistsError{Err: err}
+		}
+		return Repository{}, err
+	}
+
+	return repo, nil
 }
 
 func (c *client) ForkRepository(ctx context.Context, org string, input ForkRepositoryInput) (Repository, error) {
@@ -64,40 +221,163 @@ func (c *client) ForkRepository(ctx context.Context, org string, input ForkRepos
 	return repo, nil
 }
 
-func (c *client) GetRepositoryBranch(ctx context.Context, args OrgProjectRepoArgs, branchName string) (Ref, error) {
-	var allRefs []Ref
-	continuationToken := ""
+// ListRefs returns the refs of a repository matching opts, following
+// continuation tokens until all refs or opts.Top refs were fetched.
+// opts.Skip is not supported.
+func (c *client) ListRefs(ctx context.Context, args OrgProjectRepoArgs, opts ListRefsOptions) ([]Ref, error) {
 	queryParams := make(url.Values)
+	if opts.Filter != "" {
+		queryParams.Set("filter", opts.Filter)
+	}
+	if opts.FilterContains != "" {
+		queryParams.Set("filterContains", opts.FilterContains)
+	}
+	if opts.PeelTags {
+		queryParams.Set("peelTags", "true")
+	}
+	if opts.IncludeStatuses || opts.LatestStatusesOnly {
+		queryParams.Set("includeStatuses", "true")
+	}
+	if opts.LatestStatusesOnly {
+		queryParams.Set("latestStatusesOnly", "true")
+	}
+
+	return listPages[Ref](ctx, c, fmt.Sprintf("%s/%s/_apis/git/repositories/%s/refs", args.Org, args.Project, args.RepoNameOrID), queryParams, opts.ListOptions)
+}
+
+func (c *client) GetRepositoryBranch(ctx context.Context, args OrgProjectRepoArgs, branchName string) (Ref, error) {
 	// The filter here by branch name is only a substring match, so we aren't guaranteed to only get one result.
-	queryParams.Set("filter", fmt.Sprintf("heads/%s", branchName))
-	reqURL := url.URL{Path: fmt.Sprintf("%s/%s/_apis/git/repositories/%s/refs", args.Org, args.Project, args.RepoNameOrID)}
-	for {
-		if continuationToken != "" {
-			queryParams.Set("continuationToken", continuationToken)
+	allRefs, err := c.ListRefs(ctx, args, ListRefsOptions{Filter: strings.TrimPrefix(FullBranchRef(branchName), "refs/")})
+	if err != nil {
+		return Ref{}, err
+	}
+
+	for _, ref := range allRefs {
+		if ref.Name == FullBranchRef(branchName) {
+			return ref, nil
 		}
-		reqURL.RawQuery = queryParams.Encode()
-		req, err := http.NewRequest("GET", reqURL.String(), nil)
-		if err != nil {
-			return Ref{}, err
+	}
+
+	return Ref{}, &BranchNotFoundError{Name: branchName}
+}
+
+// ListBranchesWithStats returns the branches of a repository with their tip
+// commit and how many commits they are ahead and behind opts.BaseVersion,
+// following continuation tokens until all branches or opts.Top branches were
+// fetched. opts.Skip is not supported.
+func (c *client) ListBranchesWithStats(ctx context.Context, args OrgProjectRepoArgs, opts ListBranchesWithStatsOptions) ([]BranchStat, error) {
+	queryParams := make(url.Values)
+	setVersionDescriptor(queryParams, "baseVersionDescriptor", opts.BaseVersion)
+
+	return listPages[BranchStat](ctx, c, fmt.Sprintf("%s/%s/_apis/git/repositories/%s/stats/branches", args.Org, args.Project, args.RepoNameOrID), queryParams, opts.ListOptions)
+}
+
+// DeleteBranch deletes the branch with the given name (without refs/heads/).
+// Deleting a branch that doesn't exist is not an error, but deleting a branch
+// of a repository that doesn't exist is. If the branch is updated concurrently
+// a *RefUpdateError is returned whose result IsStale.
+func (c *client) DeleteBranch(ctx context.Context, args OrgProjectRepoArgs, branchName string) error {
+	ref, err := c.GetRepositoryBranch(ctx, args, branchName)
+	if err != nil {
+		var branchNotFound *BranchNotFoundError
+		if errors.As(err, &branchNotFound) {
+			return nil
 		}
+		return err
+	}
+
+	results, err := c.UpdateRefs(ctx, args, []RefUpdate{{
+		Name:        ref.Name,
+		OldObjectID: ref.CommitSHA,
+		NewObjectID: ZeroObjectID,
+	}})
+	if err != nil {
+		return err
+	}
+	if len(results) != 1 {
+		return errors.Newf("expected 1 ref update result, got %d", len(results))
+	}
 
-		var refs ListRefsResponse
-		continuationToken, err = c.do(ctx, req, "", &refs)
+	result := results[0]
+	if result.Success || result.UpdateStatus == RefUpdateStatusSucceededNonExistentRef {
+		return nil
+	}
+	return &RefUpdateError{Result: result}
+}
+
+// ZeroObjectID is the object ID of a ref that doesn't exist, see RefUpdate.
+const ZeroObjectID = "0000000000000000000000000000000000000000"
+
+// UpdateRefs creates, updates or deletes refs of the repository. The returned
+// results are in the same order as updates. An update failing, e.g. because
+// the ref was changed concurrently (see RefUpdateResult.IsStale), is not an
+// error: callers must check RefUpdateResult.Success.
+func (c *client) UpdateRefs(ctx context.Context, args OrgProjectRepoArgs, updates []RefUpdate) ([]RefUpdateResult, error) {
+	updates = append([]RefUpdate(nil), updates...)
+	for i := range updates {
+		name, err := validateRefName(updates[i].Name)
 		if err != nil {
-			return Ref{}, err
+			return nil, err
 		}
-		allRefs = append(allRefs, refs.Value...)
+		updates[i].Name = name
+	}
 
-		if continuationToken == "" {
-			break
-		}
+	data, err := json.Marshal(updates)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling request")
 	}
 
-	for _, ref := range allRefs {
-		if ref.Name == fmt.Sprintf("refs/heads/%s", branchName) {
-			return ref, nil
-		}
+	reqURL := url.URL{Path: fmt.Sprintf("%s/%s/_apis/git/repositories/%s/refs", args.Org, args.Project, args.RepoNameOrID)}
+
+	req, err := http.NewRequest("POST", reqURL.String(), bytes.NewBuffer(data))
+	if err != nil {
+		return nil, err
+	}
+
+	var results ListRefUpdateResultsResponse
+	if _, err = c.do(ctx, req, "", &results); err != nil {
+		return nil, err
 	}
 
-	return Ref{}, errors.Newf("branch %q not found", branchName)
+	return results.Value, nil
+}
+
+// BranchNotFoundError is returned when a requested branch does not exist.
+type BranchNotFoundError struct {
+	Name string
+}
+
+func (e *BranchNotFoundError) Error() string {
+	return fmt.Sprintf("branch %q not found", e.Name)
+}
+
+func (e *BranchNotFoundError) NotFound() bool {
+	return true
+}
+
+// AmbiguousRepositoryError is returned by FindRepositoryByName when
+// repositories of several projects have the requested name.
+type AmbiguousRepositoryError struct {
+	Name       string
+	Candidates []Repository
+}
+
+func (e *AmbiguousRepositoryError) Error() string {
+	projects := make([]string, len(e.Candidates))
+	for i, repo := range e.Candidates {
+		projects[i] = repo.Project.Name
+	}
+	return fmt.Sprintf("repository name %q is ambiguous, it exists in the projects %s", e.Name, strings.Join(projects, ", "))
+}
+
+// RefUpdateError is returned when a ref update was not applied.
+type RefUpdateError struct {
+	Result RefUpdateResult
+}
+
+func (e *RefUpdateError) Error() string {
+	if e.Result.CustomMessage != "" {
+		return fmt.Sprintf("updating ref %q failed: %s: %s", e.Result.Name, e.Result.UpdateStatus, e.Result.CustomMessage)
+	}
+	return fmt.Sprintf("updating ref %q failed: %s", e.Result.Name, e.Result.UpdateStatus)
 }
@@ -2,9 +2,17 @@ package azuredevops
 
 import (
 	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/httpcli"
 	"github.com/sourcegraph/sourcegraph/internal/testutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestClient_GetRepository(t *testing.T) {
@@ -25,6 +33,40 @@ func TestClient_GetRepository(t *testing.T) {
 	testutil.AssertGolden(t, "testdata/golden/GetRepository.json", *update, resp)
 }
 
+func TestClient_GetRepositorySize(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/org/project/_apis/git/repositories/sized":
+			w.Write([]byte(`{"id": "1", "name": "sized", "size": 1048576}`))
+		case "/org/project/_apis/git/repositories/empty":
+			w.Write([]byte(`{"id": "2", "name": "empty", "size": 0}`))
+		default:
+			w.Write([]byte(`{"id": "3", "name": "unsized"}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		repo  string
+		size  int64
+		exact bool
+	}{
+		{repo: "sized", size: 1048576, exact: true},
+		{repo: "empty", size: 0, exact: true},
+		{repo: "unsized", size: 0, exact: false},
+	} {
+		t.Run(tc.repo, func(t *testing.T) {
+			size, exact, err := cli.GetRepositorySize(context.Background(), OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: tc.repo})
+			require.NoError(t, err)
+			assert.Equal(t, tc.size, size)
+			assert.Equal(t, tc.exact, exact)
+		})
+	}
+}
+
 func TestClient_ListRepositoriesByProjectOrOrg(t *testing.T) {
 	cli, save := NewTestClient(t, "ListRepositoriesByProjectOrOrg", *update)
 	t.Cleanup(save)
@@ -41,6 +83,56 @@ func TestClient_ListRepositoriesByProjectOrOrg(t *testing.T) {
 	testutil.AssertGolden(t, "testdata/golden/ListProjects.json", *update, resp)
 }
 
+func TestClient_ListRepositoriesPage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/org/project/_apis/git/repositories", r.URL.Path)
+		w.Write([]byte(`{"count": 2, "value": [{"id": "1", "name": "a"}, {"id": "2", "name": "b"}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	page, err := cli.ListRepositoriesPage(context.Background(), ListRepositoriesByProjectOrOrgArgs{ProjectOrOrgName: "org/project"})
+	require.NoError(t, err)
+	assert.Equal(t, RepositoriesPage{Repositories: []Repository{{ID: "1", Name: "a"}, {ID: "2", Name: "b"}}}, page)
+	assert.False(t, page.HasMore)
+}
+
+func TestClient_ListRepositoriesByProjectsOrOrgs(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/org/a/_apis/git/repositories":
+			w.Write([]byte(`{"count": 1, "value": [{"id": "1", "name": "a"}]}`))
+		case "/org/b/_apis/git/repositories":
+			w.WriteHeader(http.StatusForbidden)
+		case "/org/c/_apis/git/repositories":
+			w.Write([]byte(`{"count": 2, "value": [{"id": "2", "name": "c1"}, {"id": "3", "name": "c2"}]}`))
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	result, err := cli.ListRepositoriesByProjectsOrOrgs(context.Background(), []string{"org/a", "org/b", "org/c"})
+	require.NoError(t, err)
+	assert.Equal(t, []Repository{{ID: "1", Name: "a"}, {ID: "2", Name: "c1"}, {ID: "3", Name: "c2"}}, result.Repositories)
+	require.Len(t, result.Errors, 1)
+
+	var httpErr *HTTPError
+	require.True(t, errors.As(result.Errors["org/b"], &httpErr))
+	assert.Equal(t, http.StatusForbidden, httpErr.StatusCode)
+	assert.ErrorContains(t, result.Err(), `listing repositories of "org/b"`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = cli.ListRepositoriesByProjectsOrOrgs(ctx, []string{"org/a"})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
 func TestClient_ForkRepository(t *testing.T) {
 	cli, save := NewTestClient(t, "ForkRepository", *update)
 	t.Cleanup(save)
@@ -83,3 +175,267 @@ func TestClient_GetRepositoryBranch(t *testing.T) {
 
 	testutil.AssertGolden(t, "testdata/golden/GetRepositoryBranch.json", *update, resp)
 }
+
+func TestClient_ListRefs(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/org/project/_apis/git/repositories/repo/refs", r.URL.Path)
+		q := r.URL.Query()
+		assert.Equal(t, "tags/", q.Get("filter"))
+		assert.Equal(t, "v1", q.Get("filterContains"))
+		assert.Equal(t, "true", q.Get("peelTags"))
+		assert.Equal(t, "true", q.Get("includeStatuses"))
+		assert.Equal(t, "true", q.Get("latestStatusesOnly"))
+
+		if q.Get("continuationToken") == "" {
+			w.Header().Set(continuationTokenHeader, "next")
+			w.Write([]byte(`{"value": [{"name": "refs/tags/v1.0", "objectId": "tag", "peeledObjectId": "commit", "statuses": [{"id": 1, "state": "succeeded"}]}]}`))
+			return
+		}
+		w.Write([]byte(`{"value": [{"name": "refs/tags/v1.1", "objectId": "commit2"}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	refs, err := cli.ListRefs(context.Background(), OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}, ListRefsOptions{
+		Filter:             "tags/",
+		FilterContains:     "v1",
+		PeelTags:           true,
+		LatestStatusesOnly: true,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []Ref{
+		{Name: "refs/tags/v1.0", CommitSHA: "tag", PeeledCommitSHA: "commit", Statuses: []PullRequestBuildStatus{{ID: 1, State: PullRequestBuildStatusStateSucceeded}}},
+		{Name: "refs/tags/v1.1", CommitSHA: "commit2"},
+	}, refs)
+}
+
+func TestClient_ListBranchesWithStats(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/org/project/_apis/git/repositories/repo/stats/branches", r.URL.Path)
+		q := r.URL.Query()
+		assert.Equal(t, "develop", q.Get("baseVersionDescriptor.version"))
+		assert.Equal(t, "branch", q.Get("baseVersionDescriptor.versionType"))
+
+		if q.Get("continuationToken") == "" {
+			w.Header().Set(continuationTokenHeader, "next")
+			w.Write([]byte(`{"count": 1, "value": [{"name": "develop", "commit": {"commitId": "a"}, "aheadCount": 0, "behindCount": 0, "isBaseVersion": true}]}`))
+			return
+		}
+		w.Write([]byte(`{"count": 1, "value": [{"name": "feature", "commit": {"commitId": "b"}, "aheadCount": 2, "behindCount": 5}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+	stats, err := cli.ListBranchesWithStats(context.Background(), args, ListBranchesWithStatsOptions{BaseVersion: &GitVersionDescriptor{Version: "develop", VersionType: GitVersionTypeBranch}})
+	require.NoError(t, err)
+	assert.Equal(t, []BranchStat{
+		{Name: "develop", Commit: Commit{CommitID: "a"}, IsBaseVersion: true},
+		{Name: "feature", Commit: Commit{CommitID: "b"}, AheadCount: 2, BehindCount: 5},
+	}, stats)
+}
+
+func TestClient_UpdateRepository(t *testing.T) {
+	ctx := context.Background()
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+
+	t.Run("only sends set fields", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "PATCH", r.Method)
+			assert.Equal(t, "/org/project/_apis/git/repositories/repo", r.URL.Path)
+			body, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"defaultBranch":"refs/heads/main"}`, string(body))
+
+			w.Write([]byte(`{"name":"repo","defaultBranch":"refs/heads/main"}`))
+		}))
+		t.Cleanup(srv.Close)
+
+		cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+		require.NoError(t, err)
+
+		defaultBranch := "refs/heads/main"
+		repo, err := cli.UpdateRepository(ctx, args, UpdateRepositoryInput{DefaultBranch: &defaultBranch})
+		require.NoError(t, err)
+		assert.Equal(t, "refs/heads/main", repo.DefaultBranch)
+	})
+
+	t.Run("name conflict", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusConflict)
+		}))
+		t.Cleanup(srv.Close)
+
+		cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+		require.NoError(t, err)
+
+		name := "taken"
+		_, err = cli.UpdateRepository(ctx, args, UpdateRepositoryInput{Name: &name})
+		var e *AlreadyExistsError
+		assert.True(t, errors.As(err, &e))
+	})
+}
+
+func TestClient_FindRepositoryByName(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/org/_apis/git/repositories", r.URL.Path)
+		w.Write([]byte(`{"count": 4, "value": [
+			{"id": "1", "name": "frontend", "project": {"name": "web"}},
+			{"id": "2", "name": "Backend", "project": {"name": "api"}},
+			{"id": "3", "name": "tools", "project": {"name": "web"}},
+			{"id": "4", "name": "tools", "project": {"name": "api"}}
+		]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	repo, err := cli.FindRepositoryByName(ctx, "org", "backend")
+	require.NoError(t, err)
+	assert.Equal(t, "2", repo.ID)
+
+	_, err = cli.FindRepositoryByName(ctx, "org", "missing")
+	assert.True(t, isNotFound(err))
+
+	_, err = cli.FindRepositoryByName(ctx, "org", "tools")
+	var e *AmbiguousRepositoryError
+	require.True(t, errors.As(err, &e))
+	assert.Len(t, e.Candidates, 2)
+	assert.EqualError(t, err, `repository name "tools" is ambiguous, it exists in the projects web, api`)
+}
+
+func TestClient_DiffRepositories(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/org/project/_apis/git/repositories", r.URL.Path)
+		w.Write([]byte(`{"count": 3, "value": [{"id": "a"}, {"id": "c"}, {"id": "d"}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	added, removed, err := cli.DiffRepositories(context.Background(), ListRepositoriesByProjectOrOrgArgs{ProjectOrOrgName: "org/project"}, []string{"b", "a", "b", "e"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"c", "d"}, added)
+	assert.Equal(t, []string{"b", "e"}, removed)
+}
+
+func TestClient_UpdateRefs(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/org/project/_apis/git/repositories/repo/refs", r.URL.Path)
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `[
+			{"name": "refs/heads/a", "oldObjectId": "0000000000000000000000000000000000000000", "newObjectId": "abc"},
+			{"name": "refs/heads/b", "oldObjectId": "old", "newObjectId": "abc"}
+		]`, string(body))
+		w.Write([]byte(`{"count": 2, "value": [
+			{"name": "refs/heads/a", "newObjectId": "abc", "success": true, "updateStatus": "succeeded"},
+			{"name": "refs/heads/b", "newObjectId": "abc", "success": false, "updateStatus": "staleOldObjectId"}
+		]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+	results, err := cli.UpdateRefs(context.Background(), args, []RefUpdate{
+		{Name: "refs/heads/a", OldObjectID: ZeroObjectID, NewObjectID: "abc"},
+		{Name: "refs/heads/b", OldObjectID: "old", NewObjectID: "abc"},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	assert.True(t, results[0].Success)
+	assert.Equal(t, RefUpdateStatusSucceeded, results[0].UpdateStatus)
+	assert.False(t, results[0].IsStale())
+
+	assert.False(t, results[1].Success)
+	assert.True(t, results[1].IsStale())
+	assert.False(t, results[1].IsRejected())
+}
+
+func TestClient_DeleteBranch(t *testing.T) {
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+
+	newServer := func(t *testing.T, refs string, result string) *httptest.Server {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/org/project/_apis/git/repositories/repo/refs", r.URL.Path)
+			switch r.Method {
+			case "GET":
+				w.Write([]byte(refs))
+			case "POST":
+				body, err := io.ReadAll(r.Body)
+				require.NoError(t, err)
+				assert.JSONEq(t, `[{"name": "refs/heads/feature", "oldObjectId": "abc", "newObjectId": "0000000000000000000000000000000000000000"}]`, string(body))
+				w.Write([]byte(result))
+			}
+		}))
+		t.Cleanup(srv.Close)
+		return srv
+	}
+	const featureRefs = `{"count": 2, "value": [{"name": "refs/heads/feature", "objectId": "abc"}, {"name": "refs/heads/feature-2", "objectId": "def"}]}`
+
+	t.Run("deleted", func(t *testing.T) {
+		srv := newServer(t, featureRefs, `{"value": [{"name": "refs/heads/feature", "success": true, "updateStatus": "succeeded"}]}`)
+		cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+		require.NoError(t, err)
+
+		assert.NoError(t, cli.DeleteBranch(context.Background(), args, "feature"))
+	})
+
+	t.Run("already deleted", func(t *testing.T) {
+		srv := newServer(t, `{"count": 0, "value": []}`, "")
+		cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+		require.NoError(t, err)
+
+		assert.NoError(t, cli.DeleteBranch(context.Background(), args, "feature"))
+	})
+
+	t.Run("missing repository", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "GET", r.Method)
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"message": "TF401019: The Git repository with name or identifier repo does not exist or you do not have permissions for the operation you are attempting."}`))
+		}))
+		t.Cleanup(srv.Close)
+		cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+		require.NoError(t, err)
+
+		err = cli.DeleteBranch(context.Background(), args, "feature")
+		assert.Error(t, err)
+		var branchNotFound *BranchNotFoundError
+		assert.False(t, errors.As(err, &branchNotFound))
+	})
+
+	t.Run("stale", func(t *testing.T) {
+		srv := newServer(t, featureRefs, `{"value": [{"name": "refs/heads/feature", "success": false, "updateStatus": "staleOldObjectId"}]}`)
+		cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+		require.NoError(t, err)
+
+		err = cli.DeleteBranch(context.Background(), args, "feature")
+		var refErr *RefUpdateError
+		require.True(t, errors.As(err, &refErr))
+		assert.True(t, refErr.Result.IsStale())
+	})
+}
+
+func TestClient_UpdateRefs_InvalidName(t *testing.T) {
+	cli, err := NewClient("test", "https://dev.azure.com", &auth.BasicAuth{Username: "test", Password: "test"}, httpcli.DoerFunc(func(*http.Request) (*http.Response, error) {
+		t.Fatal("unexpected request")
+		return nil, nil
+	}))
+	require.NoError(t, err)
+
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+	_, err = cli.UpdateRefs(context.Background(), args, []RefUpdate{{Name: "my branch", OldObjectID: ZeroObjectID, NewObjectID: "abc"}})
+	assert.ErrorContains(t, err, "invalid ref name")
+}
@@ -0,0 +1,72 @@
+package azuredevops
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const defaultResourceAreasCacheTTL = time.Hour
+
+// serviceResourceAreas maps the services of resolveHost to the name of a
+// resource area served from the same host.
+var serviceResourceAreas = map[string]string{
+	serviceVSSPS:     "graph",
+	serviceAlmSearch: "search",
+	serviceVSAEX:     "memberentitlementmanagement",
+	serviceAudit:     "audit",
+}
+
+// GetResourceAreas returns the base URLs of the resource areas of the
+// instance, keyed by lower-cased area name, e.g. "graph". The result is cached
+// by the client, and so is a failure other than the context being done: some
+// servers and proxies don't serve resource areas at all.
+func (c *client) GetResourceAreas(ctx context.Context) (map[string]string, error) {
+	if areas, ok := c.resourceAreas.Get(""); ok {
+		return areas, nil
+	}
+	if err, ok := c.resourceAreasErr.Get(""); ok {
+		return nil, err
+	}
+
+	queryParams := make(url.Values)
+	setAPIVersion(queryParams, resourceAreasAPIVersion)
+	reqURL := url.URL{Path: "_apis/resourceAreas", RawQuery: queryParams.Encode()}
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ListResourceAreasResponse
+	if _, err = c.do(ctx, req, "", &resp); err != nil {
+		if ctx.Err() == nil {
+			c.resourceAreasErr.Set("", err)
+		}
+		return nil, err
+	}
+
+	areas := make(map[string]string, len(resp.Value))
+	for _, area := range resp.Value {
+		areas[strings.ToLower(area.Name)] = area.LocationURL
+	}
+	c.resourceAreas.Set("", areas)
+
+	return areas, nil
+}
+
+// resourceAreaHost returns the scheme and host serving service according to
+// the resource areas of the instance, or false if they can't be determined.
+func (c *client) resourceAreaHost(ctx context.Context, service string) (scheme, host string, ok bool) {
+	areas, err := c.GetResourceAreas(withoutAPIVersion(ctx))
+	if err != nil {
+		return "", "", false
+	}
+	location, err := url.Parse(areas[serviceResourceAreas[service]])
+	if err != nil || location.Host == "" {
+		return "", "", false
+	}
+	return location.Scheme, location.Host, true
+}
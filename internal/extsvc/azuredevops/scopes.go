@@ -0,0 +1,71 @@
+package azuredevops
+
+import (
+	"net/http"
+	"strings"
+)
+
+// scopeRule maps the requests whose path after _apis/ starts with prefix to
+// the scopes they need, as documented in the REST API reference.
+type scopeRule struct {
+	prefix string
+	// read is needed by GET requests, write by all others.
+	read, write string
+}
+
+// scopeRules are matched in order, so more specific prefixes come first.
+var scopeRules = []scopeRule{
+	{prefix: "git/repositories/*/pullrequests/*/statuses", read: "Code (Read)", write: "Code (Status)"},
+	{prefix: "git/repositories/*/commitsbatch", read: "Code (Read)", write: "Code (Read)"},
+	{prefix: "git/repositories/*/", read: "Code (Read)", write: "Code (Write)"},
+	// Creating, forking, updating and deleting repositories.
+	{prefix: "git/repositories", read: "Code (Read)", write: "Code (Manage)"},
+	{prefix: "policy/", read: "Code (Read)", write: "Code (Read & write)"},
+	{prefix: "pipelines", read: "Build (Read)", write: "Build (Read & execute)"},
+	{prefix: "build/", read: "Build (Read)", write: "Build (Read & execute)"},
+	{prefix: "projects", read: "Project and Team (Read)", write: "Project and Team (Read & write)"},
+	{prefix: "hooks/", read: "Service Hooks (Read)", write: "Service Hooks (Read & write)"},
+	{prefix: "audit/", read: "Audit Log (Read)", write: "Audit Log (Read)"},
+	{prefix: "securitynamespaces", read: "Security (Manage)", write: "Security (Manage)"},
+	{prefix: "identities", read: "Identity (Read)", write: "Identity (Read)"},
+	{prefix: "profile/", read: "User Profile (Read)", write: "User Profile (Write)"},
+	{prefix: "accounts", read: "User Profile (Read)", write: "User Profile (Read)"},
+}
+
+// requiredScope returns the scope a request with method to path needs, or ""
+// if we don't know.
+func requiredScope(method, path string) string {
+	_, apiPath, ok := strings.Cut(path, "/_apis/")
+	if !ok {
+		return ""
+	}
+
+	for _, rule := range scopeRules {
+		if !matchScopePrefix(rule.prefix, apiPath) {
+			continue
+		}
+		if method == http.MethodGet || method == http.MethodHead {
+			return rule.read
+		}
+		return rule.write
+	}
+	return ""
+}
+
+// matchScopePrefix reports whether path starts with prefix, where * in prefix
+// matches a single path segment.
+func matchScopePrefix(prefix, path string) bool {
+	for prefix != "" {
+		p, prefixRest, more := strings.Cut(prefix, "/")
+		if !more {
+			// The last element of prefix may be a partial segment.
+			return strings.HasPrefix(path, p)
+		}
+		segment, pathRest, ok := strings.Cut(path, "/")
+		if !ok || (p != "*" && p != segment) {
+			return false
+		}
+		prefix, path = prefixRest, pathRest
+	}
+	return true
+}
@@ -0,0 +1,61 @@
+package azuredevops
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequiredScope(t *testing.T) {
+	for _, tc := range []struct {
+		method string
+		path   string
+		want   string
+	}{
+		{"GET", "/org/project/_apis/git/repositories/repo/items", "Code (Read)"},
+		{"POST", "/org/project/_apis/git/repositories/repo/pullrequests", "Code (Write)"},
+		{"PATCH", "/org/project/_apis/git/repositories/repo/pullrequests/1", "Code (Write)"},
+		{"POST", "/org/project/_apis/git/repositories/repo/commitsbatch", "Code (Read)"},
+		{"POST", "/org/project/_apis/git/repositories/repo/pullrequests/1/statuses", "Code (Status)"},
+		{"GET", "/org/project/_apis/git/repositories", "Code (Read)"},
+		{"POST", "/org/_apis/git/repositories", "Code (Manage)"},
+		{"PATCH", "/org/project/_apis/git/repositories/repo", "Code (Manage)"},
+		{"POST", "/org/project/_apis/pipelines/1/runs", "Build (Read & execute)"},
+		{"GET", "/org/_apis/projects/project", "Project and Team (Read)"},
+		{"POST", "/org/_apis/hooks/subscriptions", "Service Hooks (Read & write)"},
+		{"GET", "/org/_apis/connectionData", ""},
+		{"GET", "/org/project/_git/repo", ""},
+	} {
+		t.Run(tc.method+" "+tc.path, func(t *testing.T) {
+			assert.Equal(t, tc.want, requiredScope(tc.method, tc.path))
+		})
+	}
+}
+
+func TestClient_MissingScope(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	_, err = cli.CreatePullRequest(context.Background(), OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}, CreatePullRequestInput{SourceRefName: "feature", TargetRefName: "main"})
+	var e *MissingScopeError
+	require.True(t, errors.As(err, &e))
+	assert.Equal(t, "Code (Write)", e.Scope)
+	assert.Contains(t, err.Error(), "this operation requires the 'Code (Write)' scope")
+	assert.True(t, errcode.IsForbidden(err))
+
+	var httpErr *HTTPError
+	require.True(t, errors.As(err, &httpErr))
+	assert.Equal(t, http.StatusForbidden, httpErr.StatusCode)
+}
@@ -0,0 +1,106 @@
+package azuredevops
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// searchCriteria builds the searchCriteria.* query parameters taken by the
+// list endpoints, so that they're validated and encoded the same way
+// everywhere. Zero values are omitted. The first invalid value is remembered
+// and returned by encode, so calls can be chained without checking errors in
+// between.
+type searchCriteria struct {
+	values url.Values
+	err    error
+}
+
+func newSearchCriteria() *searchCriteria {
+	return &searchCriteria{values: make(url.Values)}
+}
+
+func (s *searchCriteria) set(name, value string) {
+	if value != "" {
+		s.values.Set("searchCriteria."+name, value)
+	}
+}
+
+func (s *searchCriteria) fail(err error) *searchCriteria {
+	if s.err == nil {
+		s.err = err
+	}
+	return s
+}
+
+// str sets name to value.
+func (s *searchCriteria) str(name, value string) *searchCriteria {
+	s.set(name, value)
+	return s
+}
+
+// guid sets name to value, which must be a GUID.
+func (s *searchCriteria) guid(name, value string) *searchCriteria {
+	if value != "" && !guidPattern.MatchString(value) {
+		return s.fail(errors.Newf("invalid %s %q: must be a GUID", name, value))
+	}
+	s.set(name, value)
+	return s
+}
+
+// enum sets name to value, which valid reports whether it is a known value.
+func (s *searchCriteria) enum(name, value string, valid func() bool) *searchCriteria {
+	if value != "" && !valid() {
+		return s.fail(errors.Newf("invalid %s %q", name, value))
+	}
+	s.set(name, value)
+	return s
+}
+
+// date sets name to t in UTC, as Azure DevOps rejects or misinterprets dates
+// with other offsets.
+func (s *searchCriteria) date(name string, t time.Time) *searchCriteria {
+	if !t.IsZero() {
+		s.set(name, t.UTC().Format(time.RFC3339))
+	}
+	return s
+}
+
+// dateRange sets fromName and toName to from and to, which must be in order
+// if both are set.
+func (s *searchCriteria) dateRange(fromName string, from time.Time, toName string, to time.Time) *searchCriteria {
+	if !from.IsZero() && !to.IsZero() && from.After(to) {
+		return s.fail(errors.Newf("invalid date range: %s %s is after %s %s", fromName, from.Format(time.RFC3339), toName, to.Format(time.RFC3339)))
+	}
+	return s.date(fromName, from).date(toName, to)
+}
+
+// int sets name to n if it is positive.
+func (s *searchCriteria) int(name string, n int) *searchCriteria {
+	if n < 0 {
+		return s.fail(errors.Newf("invalid %s %d: must not be negative", name, n))
+	}
+	if n > 0 {
+		s.set(name, strconv.Itoa(n))
+	}
+	return s
+}
+
+// version sets name.version and name.versionType to v.
+func (s *searchCriteria) version(name string, v *GitVersionDescriptor) *searchCriteria {
+	if v == nil {
+		return s
+	}
+	return s.str(name+".version", v.Version).
+		enum(name+".versionType", string(v.VersionType), v.VersionType.valid)
+}
+
+// encode returns the query parameters, or the first invalid value.
+func (s *searchCriteria) encode() (url.Values, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return s.values, nil
+}
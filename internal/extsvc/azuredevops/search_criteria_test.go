@@ -0,0 +1,52 @@
+package azuredevops
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchCriteria(t *testing.T) {
+	// Dates are always sent in UTC.
+	local := time.FixedZone("CEST", 2*60*60)
+	values, err := newSearchCriteria().
+		str("author", "alice").
+		str("committer", "").
+		guid("creatorId", "0a1b2c3d-4e5f-6789-abcd-ef0123456789").
+		dateRange("fromDate", time.Date(2023, 5, 1, 12, 0, 0, 0, local), "toDate", time.Time{}).
+		version("itemVersion", &GitVersionDescriptor{Version: "main", VersionType: GitVersionTypeBranch}).
+		enum("status", string(PullRequestStatusActive), PullRequestStatusActive.valid).
+		int("$top", 10).
+		int("$skip", 0).
+		encode()
+	require.NoError(t, err)
+	assert.Equal(t, url.Values{
+		"searchCriteria.author":                  {"alice"},
+		"searchCriteria.creatorId":               {"0a1b2c3d-4e5f-6789-abcd-ef0123456789"},
+		"searchCriteria.fromDate":                {"2023-05-01T10:00:00Z"},
+		"searchCriteria.itemVersion.version":     {"main"},
+		"searchCriteria.itemVersion.versionType": {"branch"},
+		"searchCriteria.status":                  {"active"},
+		"searchCriteria.$top":                    {"10"},
+	}, values)
+
+	for name, s := range map[string]*searchCriteria{
+		"guid":         newSearchCriteria().guid("reviewerId", "alice"),
+		"enum":         newSearchCriteria().enum("status", "open", PullRequestStatus("open").valid),
+		"version type": newSearchCriteria().version("itemVersion", &GitVersionDescriptor{Version: "x", VersionType: "sha"}),
+		"date range":   newSearchCriteria().dateRange("fromDate", time.Now(), "toDate", time.Now().Add(-time.Hour)),
+		"negative int": newSearchCriteria().int("$top", -1),
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := s.encode()
+			assert.Error(t, err)
+		})
+	}
+
+	// The first error is kept.
+	_, err = newSearchCriteria().guid("creatorId", "a").guid("reviewerId", "b").encode()
+	assert.ErrorContains(t, err, "creatorId")
+}
@@ -0,0 +1,211 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/sourcegraph/conc/pool"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// SecurityNamespaceGitRepositories is the name of the security namespace that
+// holds the permissions of Git repositories.
+const SecurityNamespaceGitRepositories = "Git Repositories"
+
+// GitRepositoriesPermissionRead is the bit of the permission to read a
+// repository in SecurityNamespaceGitRepositories.
+const GitRepositoriesPermissionRead = 2
+
+// Security namespaces are built into Azure DevOps and rarely change, but are
+// cached for a limited number of organizations.
+const (
+	defaultSecurityNamespacesCacheTTL = time.Hour
+	maxSecurityNamespacesCacheEntries = 100
+)
+
+// Bounds of ListAccessibleRepositoriesOptions.MaxConcurrency.
+const (
+	defaultAccessibleRepositoriesConcurrency = 4
+	maxAccessibleRepositoriesConcurrency     = 16
+)
+
+// ListAccessibleRepositories returns the repositories of org that the identity
+// with the given subject descriptor, e.g. "aad.N2Q2...", can read, directly
+// or through the groups it is a member of. Permissions granted at the
+// organization or project level are inherited by the repositories and taken
+// into account; a deny overrides any allow.
+//
+// This is expensive: besides listing the repositories and resolving the
+// group memberships of the identity, it looks up the ACL of every repository,
+// with at most opts.MaxConcurrency lookups at a time. Use
+// opts.MaxRepositories to bound the number of lookups. The namespace ID is
+// cached by the client, and the project IDs are taken from the repositories.
+func (c *client) ListAccessibleRepositories(ctx context.Context, org, subjectDescriptor string, opts ListAccessibleRepositoriesOptions) ([]Repository, error) {
+	concurrency := opts.MaxConcurrency
+	if concurrency <= 0 {
+		concurrency = defaultAccessibleRepositoriesConcurrency
+	}
+	if concurrency > maxAccessibleRepositoriesConcurrency {
+		concurrency = maxAccessibleRepositoriesConcurrency
+	}
+
+	descriptors, err := c.identityDescriptors(ctx, org, subjectDescriptor)
+	if err != nil {
+		return nil, errors.Wrap(err, "resolving group memberships")
+	}
+	namespaceID, err := c.GetSecurityNamespaceID(ctx, org, SecurityNamespaceGitRepositories)
+	if err != nil {
+		return nil, err
+	}
+	repos, err := c.ListRepositoriesByProjectOrOrg(ctx, ListRepositoriesByProjectOrOrgArgs{ProjectOrOrgName: org})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing repositories")
+	}
+	if opts.MaxRepositories > 0 && len(repos) > opts.MaxRepositories {
+		repos = repos[:opts.MaxRepositories]
+	}
+
+	readable := make([]bool, len(repos))
+	p := pool.New().WithContext(ctx).WithCancelOnError().WithFirstError().WithMaxGoroutines(concurrency)
+	for i, repo := range repos {
+		i, repo := i, repo
+		p.Go(func(ctx context.Context) error {
+			acls, err := c.listAccessControlLists(ctx, org, namespaceID, fmt.Sprintf("repoV2/%s/%s", repo.Project.ID, repo.ID), descriptors)
+			if err != nil {
+				return errors.Wrapf(err, "listing access control lists of repository %s", repo.Name)
+			}
+			readable[i] = hasPermission(acls, GitRepositoriesPermissionRead)
+			return nil
+		})
+	}
+	if err := p.Wait(); err != nil {
+		return nil, err
+	}
+
+	var accessible []Repository
+	for i, repo := range repos {
+		if readable[i] {
+			accessible = append(accessible, repo)
+		}
+	}
+	return accessible, nil
+}
+
+// identityDescriptors returns the descriptor of the identity with the given
+// subject descriptor and those of all groups it is a member of, directly or
+// transitively.
+func (c *client) identityDescriptors(ctx context.Context, org, subjectDescriptor string) ([]string, error) {
+	queryParams := make(url.Values)
+	queryParams.Set("subjectDescriptors", subjectDescriptor)
+	queryParams.Set("queryMembership", "ExpandedUp")
+	identities, err := c.queryIdentities(ctx, org, queryParams)
+	if err != nil {
+		return nil, err
+	}
+	if len(identities) == 0 || identities[0].Descriptor == "" {
+		return nil, &NotFoundError{Scope: "identity", Err: errors.Newf("no identity with subject descriptor %q", subjectDescriptor)}
+	}
+
+	return append([]string{identities[0].Descriptor}, identities[0].MemberOf...), nil
+}
+
+// listAccessControlLists returns the ACL of token in the namespace, limited to
+// the entries of descriptors and including the permissions inherited from
+// parent tokens.
+func (c *client) listAccessControlLists(ctx context.Context, org, namespaceID, token string, descriptors []string) ([]AccessControlList, error) {
+	queryParams := make(url.Values)
+	queryParams.Set("token", token)
+	queryParams.Set("descriptors", strings.Join(descriptors, ","))
+	queryParams.Set("includeExtendedInfo", "true")
+	queryParams.Set("recurse", "false")
+
+	reqURL := url.URL{
+		Path:     fmt.Sprintf("%s/_apis/accesscontrollists/%s", org, namespaceID),
+		RawQuery: queryParams.Encode(),
+	}
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp listResponse[AccessControlList]
+	if _, err = c.do(ctx, req, "", &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Value, nil
+}
+
+// hasPermission reports whether the entries of acls, combined, allow the
+// permission bit and none of them deny it.
+func hasPermission(acls []AccessControlList, bit int) bool {
+	allowed := false
+	for _, acl := range acls {
+		for _, ace := range acl.AcesDictionary {
+			allow, deny := ace.Allow, ace.Deny
+			if info := ace.ExtendedInfo; info != nil {
+				allow, deny = info.EffectiveAllow, info.EffectiveDeny
+			}
+			if deny&bit != 0 {
+				return false
+			}
+			if allow&bit != 0 {
+				allowed = true
+			}
+		}
+	}
+	return allowed
+}
+
+// GetSecurityNamespaceID returns the ID of the security namespace with the
+// given name in org, e.g. SecurityNamespaceGitRepositories, as needed by the
+// ACL APIs. The names are matched case-insensitively. The namespaces of an
+// organization rarely change, so they're cached for an hour for up to 100
+// organizations. Once 100 organizations are cached, the one closest to expiry
+// is evicted to make room for the next.
+func (c *client) GetSecurityNamespaceID(ctx context.Context, org, name string) (string, error) {
+	namespaces, err := c.securityNamespaceIDs(ctx, org)
+	if err != nil {
+		return "", err
+	}
+
+	id, ok := namespaces[strings.ToLower(name)]
+	if !ok {
+		return "", &NotFoundError{Scope: "security namespace", Err: errors.Newf("no security namespace named %q", name)}
+	}
+	return id, nil
+}
+
+// securityNamespaceIDs returns the IDs of the security namespaces of org by
+// lower-cased name.
+func (c *client) securityNamespaceIDs(ctx context.Context, org string) (map[string]string, error) {
+	if namespaces, ok := c.securityNamespaces.Get(org); ok {
+		return namespaces, nil
+	}
+
+	reqURL := url.URL{Path: fmt.Sprintf("%s/_apis/securitynamespaces", org)}
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ListSecurityNamespacesResponse
+	if _, err = c.do(ctx, req, "", &resp); err != nil {
+		return nil, err
+	}
+
+	namespaces := make(map[string]string, len(resp.Value))
+	for _, ns := range resp.Value {
+		namespaces[strings.ToLower(ns.Name)] = ns.NamespaceID
+	}
+	c.securityNamespaces.Set(org, namespaces)
+
+	return namespaces, nil
+}
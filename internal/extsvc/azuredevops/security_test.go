@@ -0,0 +1,109 @@
+package azuredevops
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/errcode"
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_GetSecurityNamespaceID(t *testing.T) {
+	var numRequests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		numRequests++
+		assert.Equal(t, "/org/_apis/securitynamespaces", r.URL.Path)
+		w.Write([]byte(`{"count": 2, "value": [
+			{"namespaceId": "2e9eb7ed-3c0a-47d4-87c1-0ffdd275fd87", "name": "Git Repositories", "displayName": "Git Repositories"},
+			{"namespaceId": "52d39943-cb85-4d7f-8fa8-c6baac873819", "name": "Project", "displayName": "Project"}
+		]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	id, err := cli.GetSecurityNamespaceID(ctx, "org", SecurityNamespaceGitRepositories)
+	require.NoError(t, err)
+	assert.Equal(t, "2e9eb7ed-3c0a-47d4-87c1-0ffdd275fd87", id)
+
+	id, err = cli.GetSecurityNamespaceID(ctx, "org", "project")
+	require.NoError(t, err)
+	assert.Equal(t, "52d39943-cb85-4d7f-8fa8-c6baac873819", id)
+
+	_, err = cli.GetSecurityNamespaceID(ctx, "org", "Missing")
+	assert.True(t, errcode.IsNotFound(err))
+
+	assert.Equal(t, 1, numRequests)
+
+	// The namespaces are fetched again once expired.
+	cli.(*client).securityNamespaces.now = func() time.Time { return time.Now().Add(defaultSecurityNamespacesCacheTTL) }
+	_, err = cli.GetSecurityNamespaceID(ctx, "org", SecurityNamespaceGitRepositories)
+	require.NoError(t, err)
+	assert.Equal(t, 2, numRequests)
+}
+
+func TestClient_ListAccessibleRepositories(t *testing.T) {
+	const (
+		user  = "Microsoft.IdentityModel.Claims.ClaimsIdentity;alice"
+		group = "Microsoft.TeamFoundation.Identity;S-1-9-contributors"
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/org/_apis/identities":
+			assert.Equal(t, "aad.alice", r.URL.Query().Get("subjectDescriptors"))
+			assert.Equal(t, "ExpandedUp", r.URL.Query().Get("queryMembership"))
+			w.Write([]byte(`{"count": 1, "value": [{"id": "a", "descriptor": "` + user + `", "memberOf": ["` + group + `"]}]}`))
+		case "/org/_apis/securitynamespaces":
+			w.Write([]byte(`{"count": 1, "value": [{"namespaceId": "ns", "name": "Git Repositories"}]}`))
+		case "/org/_apis/git/repositories":
+			w.Write([]byte(`{"count": 4, "value": [
+				{"id": "r1", "name": "allowed-to-group", "project": {"id": "p"}},
+				{"id": "r2", "name": "denied-to-user", "project": {"id": "p"}},
+				{"id": "r3", "name": "no-entries", "project": {"id": "p"}},
+				{"id": "r4", "name": "not-evaluated", "project": {"id": "p"}}
+			]}`))
+		case "/org/_apis/accesscontrollists/ns":
+			q := r.URL.Query()
+			assert.Equal(t, user+","+group, q.Get("descriptors"))
+			assert.Equal(t, "true", q.Get("includeExtendedInfo"))
+			switch q.Get("token") {
+			case "repoV2/p/r1":
+				w.Write([]byte(`{"count": 1, "value": [{"token": "repoV2/p/r1", "acesDictionary": {
+					"` + group + `": {"descriptor": "` + group + `", "allow": 0, "deny": 0, "extendedInfo": {"effectiveAllow": 6, "inheritedAllow": 6}}
+				}}]}`))
+			case "repoV2/p/r2":
+				w.Write([]byte(`{"count": 1, "value": [{"token": "repoV2/p/r2", "acesDictionary": {
+					"` + group + `": {"descriptor": "` + group + `", "allow": 2},
+					"` + user + `": {"descriptor": "` + user + `", "deny": 2, "extendedInfo": {"effectiveDeny": 2}}
+				}}]}`))
+			case "repoV2/p/r3":
+				w.Write([]byte(`{"count": 0, "value": []}`))
+			default:
+				t.Errorf("unexpected token %q", q.Get("token"))
+			}
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	repos, err := cli.ListAccessibleRepositories(context.Background(), "org", "aad.alice", ListAccessibleRepositoriesOptions{MaxRepositories: 3})
+	require.NoError(t, err)
+	var names []string
+	for _, repo := range repos {
+		names = append(names, repo.Name)
+	}
+	assert.Equal(t, []string{"allowed-to-group"}, names)
+}
@@ -0,0 +1,102 @@
+package azuredevops
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+const (
+	// subscriptionPublisherID is the publisher of all Git and pull request
+	// events.
+	subscriptionPublisherID = "tfs"
+	// subscriptionConsumerID and subscriptionConsumerActionID deliver events
+	// as POST requests to consumerInputs.url.
+	subscriptionConsumerID       = "webHooks"
+	subscriptionConsumerActionID = "httpRequest"
+)
+
+// EnsureSubscription returns the service hook subscription in input.Org that
+// delivers input.EventType to input.URL, creating it if it doesn't exist yet.
+// Existing subscriptions are matched on event type and URL only, their other
+// inputs are not compared or updated.
+//
+// Two concurrent calls can both create a subscription, so callers should
+// serialize provisioning of the same webhook.
+func (c *client) EnsureSubscription(ctx context.Context, input EnsureSubscriptionInput) (*Subscription, error) {
+	if input.EventType == "" || input.URL == "" {
+		return nil, errors.New("event type and URL are required")
+	}
+
+	subscriptions, err := c.listSubscriptions(ctx, input.Org, input.EventType)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range subscriptions {
+		if s.EventType == string(input.EventType) && s.ConsumerInputs["url"] == input.URL {
+			return &s, nil
+		}
+	}
+
+	consumerInputs := make(map[string]string, len(input.ConsumerInputs)+1)
+	for k, v := range input.ConsumerInputs {
+		consumerInputs[k] = v
+	}
+	consumerInputs["url"] = input.URL
+
+	return c.createSubscription(ctx, input.Org, Subscription{
+		PublisherID:      subscriptionPublisherID,
+		EventType:        string(input.EventType),
+		ConsumerID:       subscriptionConsumerID,
+		ConsumerActionID: subscriptionConsumerActionID,
+		PublisherInputs:  input.PublisherInputs,
+		ConsumerInputs:   consumerInputs,
+	})
+}
+
+func (c *client) listSubscriptions(ctx context.Context, org string, eventType AzureDevOpsEvent) ([]Subscription, error) {
+	queryParams := make(url.Values)
+	queryParams.Set("publisherId", subscriptionPublisherID)
+	queryParams.Set("consumerId", subscriptionConsumerID)
+	queryParams.Set("eventType", string(eventType))
+
+	reqURL := url.URL{Path: fmt.Sprintf("%s/_apis/hooks/subscriptions", org), RawQuery: queryParams.Encode()}
+
+	req, err := http.NewRequest("GET", reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp ListSubscriptionsResponse
+	if _, err = c.do(ctx, req, "", &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Value, nil
+}
+
+func (c *client) createSubscription(ctx context.Context, org string, subscription Subscription) (*Subscription, error) {
+	data, err := json.Marshal(subscription)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshalling request")
+	}
+
+	reqURL := url.URL{Path: fmt.Sprintf("%s/_apis/hooks/subscriptions", org)}
+
+	req, err := http.NewRequest("POST", reqURL.String(), bytes.NewBuffer(data))
+	if err != nil {
+		return nil, err
+	}
+
+	var created Subscription
+	if _, err = c.do(ctx, req, "", &created); err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
@@ -0,0 +1,79 @@
+package azuredevops
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_EnsureSubscription(t *testing.T) {
+	var created []Subscription
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/org/_apis/hooks/subscriptions", r.URL.Path)
+		switch r.Method {
+		case "GET":
+			assert.Equal(t, "tfs", r.URL.Query().Get("publisherId"))
+			assert.Equal(t, "webHooks", r.URL.Query().Get("consumerId"))
+			assert.Equal(t, "git.pullrequest.merged", r.URL.Query().Get("eventType"))
+			w.Write([]byte(`{"count": 2, "value": [
+				{"id": "1", "eventType": "git.pullrequest.merged", "consumerInputs": {"url": "https://other.example.com/hook"}},
+				{"id": "2", "eventType": "git.pullrequest.merged", "consumerInputs": {"url": "https://sourcegraph.example.com/hook", "basicAuthPassword": "********"}}
+			]}`))
+		case "POST":
+			var s Subscription
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&s))
+			created = append(created, s)
+			s.ID = "3"
+			json.NewEncoder(w).Encode(s)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("existing", func(t *testing.T) {
+		s, err := cli.EnsureSubscription(ctx, EnsureSubscriptionInput{
+			Org:       "org",
+			EventType: PullRequestMergedEventType,
+			URL:       "https://sourcegraph.example.com/hook",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "2", s.ID)
+		assert.Empty(t, created)
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		s, err := cli.EnsureSubscription(ctx, EnsureSubscriptionInput{
+			Org:             "org",
+			EventType:       PullRequestMergedEventType,
+			URL:             "https://new.example.com/hook",
+			PublisherInputs: map[string]string{"projectId": "p"},
+			ConsumerInputs:  map[string]string{"httpHeaders": "X-Token:secret", "url": "ignored"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "3", s.ID)
+		require.Len(t, created, 1)
+		assert.Equal(t, Subscription{
+			PublisherID:      "tfs",
+			EventType:        "git.pullrequest.merged",
+			ConsumerID:       "webHooks",
+			ConsumerActionID: "httpRequest",
+			PublisherInputs:  map[string]string{"projectId": "p"},
+			ConsumerInputs:   map[string]string{"httpHeaders": "X-Token:secret", "url": "https://new.example.com/hook"},
+		}, created[0])
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		_, err := cli.EnsureSubscription(ctx, EnsureSubscriptionInput{Org: "org", EventType: PullRequestMergedEventType})
+		assert.Error(t, err)
+	})
+}
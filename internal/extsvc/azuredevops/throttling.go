@@ -0,0 +1,267 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/internal/timeutil"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+)
+
+// ErrRateLimited is returned instead of waiting for the rate limit if the wait
+// would exceed the deadline of a context created with WithPartialResults.
+// Methods paginating through results return the results fetched so far along
+// with it.
+var ErrRateLimited = errors.New("azuredevops: rate limited until after the context deadline")
+
+type partialResultsKey struct{}
+
+// WithPartialResults returns a context that makes requests fail fast with
+// ErrRateLimited, rather than block, if the client would have to wait for the
+// rate limit beyond the deadline of ctx. This allows interactive callers to
+// show partial results of paginated methods instead of blocking. Without a
+// deadline on ctx it has no effect.
+func WithPartialResults(ctx context.Context) context.Context {
+	return context.WithValue(ctx, partialResultsKey{}, true)
+}
+
+func partialResults(ctx context.Context) bool {
+	ok, _ := ctx.Value(partialResultsKey{}).(bool)
+	return ok
+}
+
+type interactiveKey struct{}
+
+// WithInteractive returns a context that marks requests made using it as
+// made on behalf of a user waiting for the result, e.g. when testing a code
+// host connection. Such requests don't queue up behind background requests on
+// the internal rate limiter of the code host. Instead, they are limited by a
+// small separate limiter per code host (see interactiveRateLimit), so they are
+// sent on top of the configured rate limit, within a budget that is small
+// enough to not matter to it.
+//
+// The rate limits Azure DevOps signals still apply to interactive requests,
+// since ignoring them would only get them rejected.
+func WithInteractive(ctx context.Context) context.Context {
+	return context.WithValue(ctx, interactiveKey{}, true)
+}
+
+func interactive(ctx context.Context) bool {
+	ok, _ := ctx.Value(interactiveKey{}).(bool)
+	return ok
+}
+
+const (
+	// interactiveRateLimit and interactiveBurst bound the requests made with
+	// WithInteractive per code host.
+	interactiveRateLimit = rate.Limit(1)
+	interactiveBurst     = 10
+)
+
+// interactiveRateLimiters holds the rate limiters of interactive requests by
+// URN, so that they are shared by all clients of a code host like the limiters
+// of ratelimit.DefaultRegistry.
+var interactiveRateLimiters sync.Map
+
+func interactiveRateLimiter(urn string) *ratelimit.InstrumentedLimiter {
+	l, _ := interactiveRateLimiters.LoadOrStore(urn, ratelimit.NewInstrumentedLimiter(urn+":interactive", rate.NewLimiter(interactiveRateLimit, interactiveBurst)))
+	return l.(*ratelimit.InstrumentedLimiter)
+}
+
+// ResponseMeta holds the activity ID and throttling information Azure DevOps
+// returned with a response: the activity ID is read from the X-VSS-E2EID
+// header, and the throttling information from the Retry-After,
+// X-RateLimit-Resource, X-RateLimit-Delay, X-RateLimit-Limit and
+// X-RateLimit-Remaining headers. Azure DevOps sends the X-RateLimit headers on
+// successful responses too, once a client starts to use up its share of
+// resources (TSTUs), before rejecting requests with a 429.
+//
+// See https://learn.microsoft.com/en-us/azure/devops/integrate/concepts/rate-limits.
+type ResponseMeta struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// E2EID is the activity ID of the request, which Azure support asks for
+	// when investigating issues.
+	E2EID string
+	// ETag is the ETag header of the response, if any, to be passed to
+	// WithIfMatch.
+	ETag string
+	// ContinuationToken is the token of the next page of a list endpoint
+	// paging with continuation tokens, see ListOptions.
+	ContinuationToken string
+	// RetryAfter is the time to wait before sending the next request, or zero
+	// if none was requested.
+	RetryAfter time.Duration
+	// Delay is how long Azure DevOps delayed the request because of
+	// throttling, or zero if it wasn't delayed.
+	Delay time.Duration
+	// Resource is the throttled resource, e.g. "ATCPU" or "ReleaseManagementService".
+	Resource string
+	// Limit and Remaining are the size of the throttling window and what is
+	// left of it. They are -1 if the response didn't include them.
+	Limit     int
+	Remaining int
+}
+
+// Throttled returns true if the response signalled that the client is being
+// throttled.
+func (m ResponseMeta) Throttled() bool {
+	return m.RetryAfter > 0 || m.Delay > 0
+}
+
+type responseMetaKey struct{}
+
+// WithResponseMeta returns a context that makes the client record the
+// metadata of the responses to requests made using it in meta.
+// If several requests are made, e.g. by a method paginating through results,
+// meta holds the information of the last one.
+func WithResponseMeta(ctx context.Context, meta *ResponseMeta) context.Context {
+	return context.WithValue(ctx, responseMetaKey{}, meta)
+}
+
+func parseResponseMeta(resp *http.Response) ResponseMeta {
+	meta := ResponseMeta{
+		StatusCode:        resp.StatusCode,
+		E2EID:             resp.Header.Get(e2eIDHeader),
+		ETag:              resp.Header.Get("ETag"),
+		ContinuationToken: resp.Header.Get(continuationTokenHeader),
+		Resource:          resp.Header.Get("X-RateLimit-Resource"),
+		Limit:             -1,
+		Remaining:         -1,
+	}
+	if v, err := strconv.ParseFloat(resp.Header.Get("Retry-After"), 64); err == nil && v > 0 {
+		meta.RetryAfter = time.Duration(v * float64(time.Second))
+	}
+	if v, err := strconv.ParseFloat(resp.Header.Get("X-RateLimit-Delay"), 64); err == nil && v > 0 {
+		meta.Delay = time.Duration(v * float64(time.Second))
+	}
+	if v, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Limit")); err == nil {
+		meta.Limit = v
+	}
+	if v, err := strconv.Atoi(resp.Header.Get("X-RateLimit-Remaining")); err == nil {
+		meta.Remaining = v
+	}
+	return meta
+}
+
+// observeResponse records the throttling information of resp in ctx, if
+// requested, and slows down subsequent requests if Azure DevOps signalled that
+// it is throttling the client.
+func (c *client) observeResponse(ctx context.Context, resp *http.Response) {
+	meta := parseResponseMeta(resp)
+	if m, ok := ctx.Value(responseMetaKey{}).(*ResponseMeta); ok && m != nil {
+		*m = meta
+	}
+
+	// Retry-After is already taken into account by the external rate limiter.
+	// A delay means Azure DevOps had to hold back the request, so we back off
+	// by the same amount to not get rejected in the future.
+	if meta.Delay > 0 {
+		until := c.now().Add(meta.Delay).UnixNano()
+		for {
+			current := c.throttledUntil.Load()
+			if current >= until || c.throttledUntil.CompareAndSwap(current, until) {
+				break
+			}
+		}
+	}
+}
+
+// throttleDelay returns how long to wait before sending the next request.
+func (c *client) throttleDelay() time.Duration {
+	until := c.throttledUntil.Load()
+	if until == 0 {
+		return 0
+	}
+	if d := time.Unix(0, until).Sub(c.now()); d > 0 {
+		return d
+	}
+	return 0
+}
+
+// waitForRateLimits blocks until the rate limits allow sending the next
+// request. See WithPartialResults for how ctx can make it return ErrRateLimited
+// instead, and WithInteractive for how it can skip the queue.
+func (c *client) waitForRateLimits(ctx context.Context) error {
+	partial := partialResults(ctx)
+
+	limiter := c.internalRateLimiter
+	if interactive(ctx) {
+		limiter = c.interactiveRateLimiter
+	}
+	if err := limiter.Wait(ctx); err != nil {
+		// The limiter returns an error right away if the wait would exceed the
+		// deadline.
+		if partial && ctx.Err() == nil {
+			return ErrRateLimited
+		}
+		return err
+	}
+
+	if !c.waitForRateLimit {
+		return nil
+	}
+
+	if deadline, ok := ctx.Deadline(); partial && ok {
+		_, _, retry, _ := c.externalRateLimiter.Get()
+		wait := c.throttleDelay()
+		if retry > wait {
+			wait = retry
+		}
+		if c.now().Add(wait).After(deadline) {
+			return ErrRateLimited
+		}
+	}
+
+	_ = c.externalRateLimiter.WaitForRateLimit(ctx, 1)
+	if d := c.throttleDelay(); d > 0 {
+		timeutil.SleepWithContext(ctx, d)
+	}
+	// The waits return early if ctx is done, in which case the request must
+	// not be sent anymore.
+	return ctx.Err()
+}
+
+// RetryAbortedError is returned if ctx is done while waiting to retry a rate
+// limited request. Unlike a request that was still rate limited after all
+// retries, which fails with the *HTTPError of the last response, it matches
+// both the error of ctx and that *HTTPError with errors.Is and errors.As.
+type RetryAbortedError struct {
+	// Err is the error of the context.
+	Err error
+	// Last is the error of the last response, which was rate limited.
+	Last *HTTPError
+}
+
+func (e *RetryAbortedError) Error() string {
+	return fmt.Sprintf("retrying rate limited request aborted: %v, last response: %v", e.Err, e.Last)
+}
+
+func (e *RetryAbortedError) Unwrap() error {
+	return errors.Append(e.Err, e.Last)
+}
+
+// retryAborted closes the body of the rate limited resp and returns the
+// error for the retry of req being aborted because ctx is done.
+func retryAborted(ctx context.Context, req *http.Request, resp *http.Response) error {
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	return &RetryAbortedError{
+		Err: ctx.Err(),
+		Last: &HTTPError{
+			Method:     req.Method,
+			URL:        req.URL,
+			StatusCode: resp.StatusCode,
+			Body:       body,
+			E2EID:      resp.Header.Get(e2eIDHeader),
+		},
+	}
+}
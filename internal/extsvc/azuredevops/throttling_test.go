@@ -0,0 +1,197 @@
+package azuredevops
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
+	"github.com/sourcegraph/sourcegraph/internal/ratelimit"
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+func TestClient_Throttling(t *testing.T) {
+	throttle := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if throttle {
+			w.Header().Set("X-RateLimit-Resource", "ATCPU")
+			w.Header().Set("X-RateLimit-Delay", "1.5")
+			w.Header().Set("X-RateLimit-Limit", "200")
+			w.Header().Set("X-RateLimit-Remaining", "10")
+		}
+		w.Write([]byte(`{}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+	c := cli.(*client)
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	var meta ResponseMeta
+	ctx := WithResponseMeta(context.Background(), &meta)
+
+	_, err = cli.GetProject(ctx, "org", "project")
+	require.NoError(t, err)
+	assert.Equal(t, ResponseMeta{StatusCode: http.StatusOK, Limit: -1, Remaining: -1}, meta)
+	assert.False(t, meta.Throttled())
+	assert.Zero(t, c.throttleDelay())
+
+	throttle = true
+	_, err = cli.GetProject(ctx, "org", "project")
+	require.NoError(t, err)
+	assert.Equal(t, ResponseMeta{
+		StatusCode: http.StatusOK,
+		Delay:      1500 * time.Millisecond,
+		Resource:   "ATCPU",
+		Limit:      200,
+		Remaining:  10,
+	}, meta)
+	assert.True(t, meta.Throttled())
+
+	// The next request is held back for as long as Azure DevOps delayed the
+	// last one.
+	assert.Equal(t, 1500*time.Millisecond, c.throttleDelay())
+	now = now.Add(time.Second)
+	assert.Equal(t, 500*time.Millisecond, c.throttleDelay())
+	now = now.Add(time.Second)
+	assert.Zero(t, c.throttleDelay())
+}
+
+func TestParseResponseMeta(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header: http.Header{
+			"Retry-After":           {"30"},
+			"X-Ratelimit-Remaining": {"0"},
+		},
+	}
+	assert.Equal(t, ResponseMeta{
+		StatusCode: http.StatusTooManyRequests,
+		RetryAfter: 30 * time.Second,
+		Limit:      -1,
+		Remaining:  0,
+	}, parseResponseMeta(resp))
+}
+
+func TestWithPartialResults(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("continuationToken") == "" {
+			w.Header().Set(continuationTokenHeader, "next")
+			w.Write([]byte(`{"count": 1, "value": [{"id": 1}]}`))
+			return
+		}
+		w.Write([]byte(`{"count": 1, "value": [{"id": 2}]}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	newClient := func(t *testing.T) *client {
+		cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+		require.NoError(t, err)
+		c := cli.(*client)
+		// Allows a single request per hour.
+		c.internalRateLimiter = ratelimit.NewInstrumentedLimiter("test", rate.NewLimiter(rate.Every(time.Hour), 1))
+		return c
+	}
+
+	args := OrgProjectRepoArgs{Org: "org", Project: "project", RepoNameOrID: "repo"}
+
+	t.Run("partial results", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		t.Cleanup(cancel)
+
+		policies, err := newClient(t).ListBranchPolicies(WithPartialResults(ctx), args, "refs/heads/main")
+		assert.ErrorIs(t, err, ErrRateLimited)
+		assert.Equal(t, []PolicyConfiguration{{ID: 1}}, policies)
+	})
+
+	t.Run("without partial results", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		t.Cleanup(cancel)
+
+		policies, err := newClient(t).ListBranchPolicies(ctx, args, "refs/heads/main")
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, ErrRateLimited)
+		assert.Nil(t, policies)
+	})
+
+	t.Run("throttled", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		t.Cleanup(cancel)
+
+		c := newClient(t)
+		c.throttledUntil.Store(time.Now().Add(time.Minute).UnixNano())
+
+		_, err := c.GetProject(WithPartialResults(ctx), "org", "project")
+		assert.ErrorIs(t, err, ErrRateLimited)
+	})
+}
+
+func TestWithInteractive(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": "id", "name": "project"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+	c := cli.(*client)
+	// The shared limiter is used up by background requests.
+	c.internalRateLimiter = ratelimit.NewInstrumentedLimiter("test", rate.NewLimiter(rate.Every(time.Hour), 1))
+	c.interactiveRateLimiter = ratelimit.NewInstrumentedLimiter("test:interactive", rate.NewLimiter(rate.Every(time.Hour), 2))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	_, err = c.GetProject(ctx, "org", "project")
+	require.NoError(t, err)
+	_, err = c.GetProject(WithPartialResults(ctx), "org", "project")
+	assert.ErrorIs(t, err, ErrRateLimited)
+
+	// Interactive requests have a budget of their own.
+	for i := 0; i < 2; i++ {
+		_, err = c.GetProject(WithInteractive(ctx), "org", "project")
+		require.NoError(t, err)
+	}
+	_, err = c.GetProject(WithPartialResults(WithInteractive(ctx)), "org", "project")
+	assert.ErrorIs(t, err, ErrRateLimited)
+
+	assert.Same(t, interactiveRateLimiter("urn"), interactiveRateLimiter("urn"))
+}
+
+func TestClient_RetryAborted(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"message": "slow down"}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err = cli.GetProject(ctx, "org", "project")
+	// The wait for Retry-After is cut short rather than finishing the sleep.
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.Equal(t, 1, requests)
+
+	var e *RetryAbortedError
+	require.True(t, errors.As(err, &e), "got %v", err)
+	assert.True(t, errors.Is(err, context.Canceled))
+	var httpErr *HTTPError
+	require.True(t, errors.As(err, &httpErr))
+	assert.Equal(t, http.StatusTooManyRequests, httpErr.StatusCode)
+	assert.Contains(t, string(httpErr.Body), "slow down")
+}
@@ -1,8 +1,11 @@
 package azuredevops
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -21,11 +24,78 @@ var (
 	PullRequestStatusAbandoned PullRequestStatus = "abandoned"
 	PullRequestStatusCompleted PullRequestStatus = "completed"
 	PullRequestStatusNotSet    PullRequestStatus = "notSet"
+	// PullRequestStatusAll is only used to search PRs of any status.
+	PullRequestStatusAll PullRequestStatus = "all"
 
+	PullRequestMergeStatusNotSet           PullRequestMergeStatus = "notSet"
+	PullRequestMergeStatusQueued           PullRequestMergeStatus = "queued"
+	PullRequestMergeStatusConflicts        PullRequestMergeStatus = "conflicts"
+	PullRequestMergeStatusSucceeded        PullRequestMergeStatus = "succeeded"
+	PullRequestMergeStatusRejectedByPolicy PullRequestMergeStatus = "rejectedByPolicy"
+	PullRequestMergeStatusFailure          PullRequestMergeStatus = "failure"
+	// PullRequestMergeStatusUnknown is used for merge statuses this package
+	// doesn't know about.
+	PullRequestMergeStatusUnknown PullRequestMergeStatus = "unknown"
+
+	PullRequestMergeFailureTypeNone PullRequestMergeFailureType = "none"
+	// PullRequestMergeFailureTypeUnknown is set by Azure DevOps for failures
+	// it doesn't classify, and used for failure types this package doesn't
+	// know about.
+	PullRequestMergeFailureTypeUnknown PullRequestMergeFailureType = "unknown"
+	// PullRequestMergeFailureTypeCaseSensitive means the merge failed
+	// because of paths that only differ in case.
+	PullRequestMergeFailureTypeCaseSensitive PullRequestMergeFailureType = "caseSensitive"
+	// PullRequestMergeFailureTypeObjectTooLarge means the merge failed
+	// because an object exceeded the size limit.
+	PullRequestMergeFailureTypeObjectTooLarge PullRequestMergeFailureType = "objectTooLarge"
+
+	// The merge strategies are the values of GitPullRequestMergeStrategy, see
+	// https://learn.microsoft.com/en-us/rest/api/azure/devops/git/pull-requests/update#gitpullrequestmergestrategy.
 	PullRequestMergeStrategySquash        PullRequestMergeStrategy = "squash"
 	PullRequestMergeStrategyRebase        PullRequestMergeStrategy = "rebase"
 	PullRequestMergeStrategyRebaseMerge   PullRequestMergeStrategy = "rebaseMerge"
-	PullRequestMergeStrategyNoFastForward PullRequestMergeStrategy = "notFastForward"
+	PullRequestMergeStrategyNoFastForward PullRequestMergeStrategy = "noFastForward"
+
+	CommentSideLeft  CommentSide = "left"
+	CommentSideRight CommentSide = "right"
+
+	GitVersionTypeBranch GitVersionType = "branch"
+	GitVersionTypeCommit GitVersionType = "commit"
+	GitVersionTypeTag    GitVersionType = "tag"
+
+	RefUpdateStatusSucceeded                      RefUpdateStatus = "succeeded"
+	RefUpdateStatusForcePushRequired              RefUpdateStatus = "forcePushRequired"
+	RefUpdateStatusStaleOldObjectID               RefUpdateStatus = "staleOldObjectId"
+	RefUpdateStatusInvalidRefName                 RefUpdateStatus = "invalidRefName"
+	RefUpdateStatusUnprocessed                    RefUpdateStatus = "unprocessed"
+	RefUpdateStatusUnresolvableToCommit           RefUpdateStatus = "unresolvableToCommit"
+	RefUpdateStatusWritePermissionRequired        RefUpdateStatus = "writePermissionRequired"
+	RefUpdateStatusManageNotePermissionRequired   RefUpdateStatus = "manageNotePermissionRequired"
+	RefUpdateStatusCreateBranchPermissionRequired RefUpdateStatus = "createBranchPermissionRequired"
+	RefUpdateStatusCreateTagPermissionRequired    RefUpdateStatus = "createTagPermissionRequired"
+	RefUpdateStatusRejectedByPlugin               RefUpdateStatus = "rejectedByPlugin"
+	RefUpdateStatusLocked                         RefUpdateStatus = "locked"
+	RefUpdateStatusRefNameConflict                RefUpdateStatus = "refNameConflict"
+	RefUpdateStatusRejectedByPolicy               RefUpdateStatus = "rejectedByPolicy"
+	RefUpdateStatusSucceededNonExistentRef        RefUpdateStatus = "succeededNonExistentRef"
+	RefUpdateStatusSucceededCorruptRef            RefUpdateStatus = "succeededCorruptRef"
+
+	PipelineRunStateUnknown    PipelineRunState = "unknown"
+	PipelineRunStateInProgress PipelineRunState = "inProgress"
+	PipelineRunStateCanceling  PipelineRunState = "canceling"
+	PipelineRunStateCompleted  PipelineRunState = "completed"
+
+	PipelineRunResultUnknown   PipelineRunResult = "unknown"
+	PipelineRunResultSucceeded PipelineRunResult = "succeeded"
+	PipelineRunResultFailed    PipelineRunResult = "failed"
+	PipelineRunResultCanceled  PipelineRunResult = "canceled"
+
+	// RecursionLevelNone only returns the item at the scope path.
+	RecursionLevelNone RecursionLevel = "none"
+	// RecursionLevelOneLevel returns the item and its direct children.
+	RecursionLevelOneLevel RecursionLevel = "oneLevel"
+	// RecursionLevelFull returns the item and all of its descendants.
+	RecursionLevelFull RecursionLevel = "full"
 )
 
 type Org struct {
@@ -45,12 +115,97 @@ type OrgProjectRepoArgs struct {
 	RepoNameOrID string
 }
 
+// QueryAuditLogInput selects the audit log entries of an organization returned
+// by QueryAuditLog.
+type QueryAuditLogInput struct {
+	Org string
+	// StartTime and EndTime bound the returned entries. If unset, the API
+	// defaults to the last 7 days.
+	StartTime time.Time
+	EndTime   time.Time
+	// BatchSize is the maximum number of entries returned per page.
+	BatchSize int
+}
+
+type AuditLogQueryResult struct {
+	ContinuationToken        string          `json:"continuationToken"`
+	HasMore                  bool            `json:"hasMore"`
+	DecoratedAuditLogEntries []AuditLogEntry `json:"decoratedAuditLogEntries"`
+}
+
+type AuditLogEntry struct {
+	ID               string         `json:"id"`
+	CorrelationID    string         `json:"correlationId"`
+	ActivityID       string         `json:"activityId"`
+	ActorDisplayName string         `json:"actorDisplayName"`
+	ActorUPN         string         `json:"actorUPN"`
+	ActorUserID      string         `json:"actorUserId"`
+	ActionID         string         `json:"actionId"`
+	Area             string         `json:"area"`
+	Category         string         `json:"category"`
+	Details          string         `json:"details"`
+	Data             map[string]any `json:"data"`
+	IPAddress        string         `json:"ipAddress"`
+	ProjectID        string         `json:"projectId"`
+	ProjectName      string         `json:"projectName"`
+	ScopeDisplayName string         `json:"scopeDisplayName"`
+	Timestamp        time.Time      `json:"timestamp"`
+}
+
+func (e *AuditLogEntry) UnmarshalJSON(data []byte) error {
+	type auditLogEntry AuditLogEntry
+	aux := struct {
+		*auditLogEntry
+		Timestamp azureTime `json:"timestamp"`
+	}{auditLogEntry: (*auditLogEntry)(e)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	e.Timestamp = aux.Timestamp.Time
+	return nil
+}
+
 // ListRepositoriesByProjectOrOrgArgs defines options to be set on the ListRepositories methods' calls.
 type ListRepositoriesByProjectOrOrgArgs struct {
 	// Should be in the form of 'org/project' for projects and 'org' for orgs.
 	ProjectOrOrgName string
 }
 
+// ListRepositoriesResult is the result of listing the repositories of several
+// projects or organizations.
+type ListRepositoriesResult struct {
+	// Repositories are the repositories of all scopes that could be listed.
+	Repositories []Repository
+	// Errors maps the scopes that could not be listed to their error.
+	Errors map[string]error
+}
+
+// Err returns the errors of all scopes that could not be listed, or nil.
+func (r ListRepositoriesResult) Err() error {
+	names := make([]string, 0, len(r.Errors))
+	for name := range r.Errors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var err error
+	for _, name := range names {
+		err = errors.Append(err, errors.Wrapf(r.Errors[name], "listing repositories of %q", name))
+	}
+	return err
+}
+
+// UpdateRepositoryInput defines the repository properties to update. Only
+// non-nil fields are sent.
+type UpdateRepositoryInput struct {
+	Name *string `json:"name,omitempty"`
+	// DefaultBranch must be a fully qualified ref name, e.g. refs/heads/main.
+	DefaultBranch *string `json:"defaultBranch,omitempty"`
+
+	// IfMatch, if set, is sent as the If-Match header, see WithIfMatch.
+	IfMatch string `json:"-"`
+}
+
 type ForkRepositoryInput struct {
 	Name             string                              `json:"name"`
 	Project          ForkRepositoryInputProject          `json:"project"`
@@ -66,20 +221,390 @@ type ForkRepositoryInputProject struct {
 	ID string `json:"id"`
 }
 
+// RepositoriesPage is a page of repositories returned by ListRepositoriesPage.
+type RepositoriesPage struct {
+	Repositories []Repository
+	// ContinuationToken is the token of the next page, if HasMore is true.
+	ContinuationToken string
+	// HasMore is true if there are more repositories to fetch with
+	// ContinuationToken.
+	HasMore bool
+}
+
 type ListRepositoriesResponse struct {
 	Value []Repository `json:"value"`
 	Count int          `json:"count"`
 }
 
+func (r *ListRepositoriesResponse) setRawJSON(data []byte) error {
+	var raw struct {
+		Value []json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw.Value) != len(r.Value) {
+		return errors.Errorf("mismatched number of raw repositories: got %d, want %d", len(raw.Value), len(r.Value))
+	}
+	for i := range r.Value {
+		r.Value[i].RawJSON = raw.Value[i]
+	}
+	return nil
+}
+
 type ListRefsResponse struct {
 	Value []Ref `json:"value"`
 	Count int   `json:"count"`
 }
 
+// BranchStat is a branch compared to a base version, see
+// ListBranchesWithStats.
+type BranchStat struct {
+	// Name is the name of the branch, without the refs/heads/ prefix.
+	Name        string `json:"name"`
+	Commit      Commit `json:"commit"`
+	AheadCount  int    `json:"aheadCount"`
+	BehindCount int    `json:"behindCount"`
+	// IsBaseVersion is true for the branch that was compared against.
+	IsBaseVersion bool `json:"isBaseVersion"`
+}
+
+// ListBranchesWithStatsOptions configures ListBranchesWithStats.
+type ListBranchesWithStatsOptions struct {
+	// BaseVersion is the version branches are compared against, the default
+	// branch if nil.
+	BaseVersion *GitVersionDescriptor
+
+	ListOptions
+}
+
 type Ref struct {
 	Name      string      `json:"name"`
 	CommitSHA string      `json:"objectId"`
 	Creator   CreatorInfo `json:"creator"`
+	// PeeledCommitSHA is the commit an annotated tag points to, in which case
+	// CommitSHA is the SHA of the tag object. It is only set if requested with
+	// ListRefsOptions.PeelTags.
+	PeeledCommitSHA string `json:"peeledObjectId,omitempty"`
+	// Statuses are the statuses of the commit, only set if requested with
+	// ListRefsOptions.IncludeStatuses.
+	Statuses []PullRequestBuildStatus `json:"statuses,omitempty"`
+}
+
+// ListRefsOptions configures ListRefs.
+type ListRefsOptions struct {
+	// Filter only lists refs starting with the given prefix without refs/,
+	// e.g. heads/ for branches.
+	Filter string
+	// FilterContains only lists refs whose name contains the given string.
+	FilterContains string
+	// PeelTags sets Ref.PeeledCommitSHA of annotated tags.
+	PeelTags bool
+	// IncludeStatuses sets Ref.Statuses. With LatestStatusesOnly, only the
+	// latest status of each status context is included.
+	IncludeStatuses    bool
+	LatestStatusesOnly bool
+
+	ListOptions
+}
+
+// RefUpdate describes an update of a ref from OldObjectID to NewObjectID. Use
+// ZeroObjectID as OldObjectID to create a ref and as NewObjectID to delete it.
+type RefUpdate struct {
+	Name        string `json:"name"`
+	OldObjectID string `json:"oldObjectId"`
+	NewObjectID string `json:"newObjectId"`
+}
+
+type ListRefUpdateResultsResponse struct {
+	Value []RefUpdateResult `json:"value"`
+	Count int               `json:"count"`
+}
+
+// RefUpdateResult is the outcome of a RefUpdate. Azure DevOps applies ref
+// updates individually, so some updates of a request can succeed while others
+// fail.
+type RefUpdateResult struct {
+	Name         string          `json:"name"`
+	OldObjectID  string          `json:"oldObjectId"`
+	NewObjectID  string          `json:"newObjectId"`
+	RepositoryID string          `json:"repositoryId"`
+	Success      bool            `json:"success"`
+	UpdateStatus RefUpdateStatus `json:"updateStatus"`
+	IsLocked     bool            `json:"isLocked"`
+	// RejectedBy is the name of the plugin or policy that rejected the update,
+	// if any.
+	RejectedBy    string `json:"rejectedBy"`
+	CustomMessage string `json:"customMessage"`
+}
+
+// IsStale returns true if the update was rejected because the ref no longer
+// pointed to OldObjectID. The update can be retried with the current object ID
+// of the ref.
+func (r RefUpdateResult) IsStale() bool {
+	return r.UpdateStatus == RefUpdateStatusStaleOldObjectID
+}
+
+// IsRejected returns true if a plugin or policy rejected the update.
+func (r RefUpdateResult) IsRejected() bool {
+	return r.UpdateStatus == RefUpdateStatusRejectedByPlugin || r.UpdateStatus == RefUpdateStatusRejectedByPolicy
+}
+
+// RefUpdateStatus is the status of a RefUpdateResult.
+type RefUpdateStatus string
+
+type ListCommitsResponse struct {
+	Value []Commit `json:"value"`
+	Count int      `json:"count"`
+}
+
+type Commit struct {
+	CommitID         string      `json:"commitId"`
+	Author           GitUserDate `json:"author"`
+	Committer        GitUserDate `json:"committer"`
+	Comment          string      `json:"comment"`
+	CommentTruncated bool        `json:"commentTruncated"`
+	Parents          []string    `json:"parents"`
+	URL              string      `json:"url"`
+	RemoteURL        string      `json:"remoteUrl"`
+	Links            Links       `json:"_links,omitempty"`
+}
+
+// Push is a push to a repository. Commits are only included up to the number
+// requested.
+type Push struct {
+	PushID   int         `json:"pushId"`
+	Date     time.Time   `json:"date"`
+	PushedBy CreatorInfo `json:"pushedBy"`
+	Commits  []Commit    `json:"commits"`
+	URL      string      `json:"url"`
+}
+
+func (p *Push) UnmarshalJSON(data []byte) error {
+	type push Push
+	aux := struct {
+		*push
+		Date azureTime `json:"date"`
+	}{push: (*push)(p)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	p.Date = aux.Date.Time
+	return nil
+}
+
+// VersionControlChangeType is the kind of a change. A change can be of several
+// kinds, e.g. "edit, rename" for a file that was renamed and edited.
+type VersionControlChangeType string
+
+const (
+	VersionControlChangeTypeAdd    VersionControlChangeType = "add"
+	VersionControlChangeTypeEdit   VersionControlChangeType = "edit"
+	VersionControlChangeTypeRename VersionControlChangeType = "rename"
+	VersionControlChangeTypeDelete VersionControlChangeType = "delete"
+)
+
+// Has reports whether the change is of kind t.
+func (c VersionControlChangeType) Has(t VersionControlChangeType) bool {
+	for _, kind := range strings.Split(string(c), ",") {
+		if VersionControlChangeType(strings.TrimSpace(kind)) == t {
+			return true
+		}
+	}
+	return false
+}
+
+// Change is a change of a file or folder made by a commit.
+type Change struct {
+	// CommitID is the commit that made the change. It is set by the client.
+	CommitID   string                   `json:"-"`
+	ChangeType VersionControlChangeType `json:"changeType"`
+	Item       ChangeItem               `json:"item"`
+	// SourceServerItem is the previous path of renamed items.
+	SourceServerItem string `json:"sourceServerItem,omitempty"`
+}
+
+type ChangeItem struct {
+	ObjectID         string `json:"objectId"`
+	OriginalObjectID string `json:"originalObjectId,omitempty"`
+	GitObjectType    string `json:"gitObjectType"`
+	Path             string `json:"path"`
+	IsFolder         bool   `json:"isFolder,omitempty"`
+	URL              string `json:"url"`
+}
+
+type CommitChangesResponse struct {
+	ChangeCounts map[string]int `json:"changeCounts"`
+	Changes      []Change       `json:"changes"`
+}
+
+type GitUserDate struct {
+	Name  string    `json:"name"`
+	Email string    `json:"email"`
+	Date  time.Time `json:"date"`
+}
+
+func (d *GitUserDate) UnmarshalJSON(data []byte) error {
+	type gitUserDate GitUserDate
+	aux := struct {
+		*gitUserDate
+		Date azureTime `json:"date"`
+	}{gitUserDate: (*gitUserDate)(d)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	d.Date = aux.Date.Time
+	return nil
+}
+
+// azureTime is a time.Time that decodes the timestamp formats returned by Azure
+// DevOps:
+//
+//   - ISO-8601 with up to 7 fractional second digits and a Z or numeric offset,
+//     e.g. 2023-03-01T10:11:12.1234567Z
+//   - the same without a time zone, which Azure DevOps Server returns for some
+//     fields and which is in UTC
+//   - null, an empty string or 0001-01-01T00:00:00 for unset timestamps, which
+//     all decode to the zero time
+//
+// Models keep time.Time fields and decode them through azureTime in their
+// UnmarshalJSON methods, so that callers don't have to deal with the wrapper.
+type azureTime struct {
+	time.Time
+}
+
+func (t *azureTime) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return errors.Wrap(err, "decoding timestamp")
+	}
+	if s == "" {
+		t.Time = time.Time{}
+		return nil
+	}
+
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999"} {
+		parsed, err := time.Parse(layout, s)
+		if err == nil {
+			if parsed.IsZero() {
+				parsed = time.Time{}
+			}
+			t.Time = parsed
+			return nil
+		}
+	}
+	return errors.Newf("invalid timestamp %q", s)
+}
+
+// ptr returns nil for a missing or unset timestamp, and the time otherwise.
+func (t *azureTime) ptr() *time.Time {
+	if t == nil || t.IsZero() {
+		return nil
+	}
+	return &t.Time
+}
+
+// QueryCommitsCriteria is the request body of the commitsbatch endpoint.
+type QueryCommitsCriteria struct {
+	// IDs restricts the result to the commits with the given SHAs.
+	IDs            []string              `json:"ids,omitempty"`
+	ItemVersion    *GitVersionDescriptor `json:"itemVersion,omitempty"`
+	CompareVersion *GitVersionDescriptor `json:"compareVersion,omitempty"`
+	ItemPath       string                `json:"itemPath,omitempty"`
+	Author         string                `json:"author,omitempty"`
+	Top            int                   `json:"$top,omitempty"`
+	Skip           int                   `json:"$skip,omitempty"`
+}
+
+// ListCommitsCriteria are the search criteria of ListCommits. Zero values are
+// omitted from the search.
+type ListCommitsCriteria struct {
+	// Author and Committer match the name or email of the commit author or
+	// committer.
+	Author    string
+	Committer string
+	// FromDate and ToDate bound the commit date, inclusively.
+	FromDate time.Time
+	ToDate   time.Time
+	// ItemVersion is the version to list commits from, the default branch if
+	// unset. If CompareVersion is set too, only commits reachable from
+	// ItemVersion but not from CompareVersion are listed.
+	ItemVersion    *GitVersionDescriptor
+	CompareVersion *GitVersionDescriptor
+	// ItemPath limits the search to commits touching the given path.
+	ItemPath string
+
+	ListOptions
+}
+
+// ListCommitsByProjectOptions configures ListCommitsByProject.
+type ListCommitsByProjectOptions struct {
+	// FromDate and ToDate bound the commit date, inclusively. FromDate is
+	// required, an unset ToDate means now.
+	FromDate time.Time
+	ToDate   time.Time
+	// TopPerRepository is the maximum number of commits listed per repository,
+	// 100 if unset.
+	TopPerRepository int
+	// MaxConcurrency is the maximum number of repositories listed at the same
+	// time, 4 if unset.
+	MaxConcurrency int
+
+	RequestOptions
+}
+
+// ProjectCommit is a commit returned by ListCommitsByProject along with the
+// repository it belongs to.
+type ProjectCommit struct {
+	Repository Repository
+	Commit
+}
+
+type GitVersionType string
+
+func (t GitVersionType) valid() bool {
+	switch t {
+	case GitVersionTypeBranch, GitVersionTypeCommit, GitVersionTypeTag:
+		return true
+	}
+	return false
+}
+
+type GitVersionDescriptor struct {
+	Version     string         `json:"version"`
+	VersionType GitVersionType `json:"versionType,omitempty"`
+}
+
+// Item is a file or folder in a Git repository, as returned by the items API.
+type Item struct {
+	ObjectID      string `json:"objectId"`
+	GitObjectType string `json:"gitObjectType"`
+	CommitID      string `json:"commitId"`
+	Path          string `json:"path"`
+	IsFolder      bool   `json:"isFolder"`
+	// Content is only set if the content was requested, and only for files.
+	Content string `json:"content"`
+	URL     string `json:"url"`
+}
+
+// RecursionLevel determines how deep ListItems lists the items below its scope.
+type RecursionLevel string
+
+// ListItemsOptions configures ListItems.
+type ListItemsOptions struct {
+	// ScopePath is the folder to list the items of, defaults to the root.
+	ScopePath string
+	// RecursionLevel defaults to RecursionLevelOneLevel.
+	RecursionLevel RecursionLevel
+	// Version is the version to list the items at, the default branch if nil.
+	Version *GitVersionDescriptor
+
+	RequestOptions
 }
 
 type CreatePullRequestInput struct {
@@ -91,6 +616,9 @@ type CreatePullRequestInput struct {
 	ForkSource        *ForkRef                      `json:"forkSource"`
 	IsDraft           bool                          `json:"isDraft"`
 	CompletionOptions *PullRequestCompletionOptions `json:"completionOptions"`
+	// DryRun is not supported for creating PRs, CreatePullRequest returns
+	// ErrDryRunNotSupported if it is set.
+	DryRun bool `json:"-"`
 }
 
 type ForkRef struct {
@@ -112,8 +640,182 @@ type Reviewer struct {
 	HasDeclined bool   `json:"hasDeclined"`
 	IsRequired  bool   `json:"isRequired"`
 	UniqueName  string `json:"uniqueName"`
+	DisplayName string `json:"displayName"`
+}
+
+// Possible values of Reviewer.Vote.
+const (
+	VoteApproved                = 10
+	VoteApprovedWithSuggestions = 5
+	VoteNone                    = 0
+	VoteWaitingForAuthor        = -5
+	VoteRejected                = -10
+)
+
+type ListPullRequestReviewersResponse struct {
+	Value []Reviewer `json:"value"`
+	Count int        `json:"count"`
+}
+
+// ApprovalState summarizes the votes of the reviewers of a PR.
+type ApprovalState struct {
+	// Approved is true if every required reviewer approved (with or without
+	// suggestions), at least one reviewer approved and nobody rejected or is
+	// waiting for the author. A minimum number of reviewers required by a
+	// branch policy is not checked: a single approval is enough.
+	Approved bool
+	// Rejected is true if at least one reviewer rejected the PR.
+	Rejected bool
+	// WaitingCount is the number of reviewers waiting for the author.
+	WaitingCount int
+	// PendingRequired are the required reviewers that haven't approved yet.
+	PendingRequired []Reviewer
+}
+
+// GetPullRequestOptions configures which related resources GetPullRequest
+// embeds into the returned PR, saving separate requests. Reviewers and labels
+// are always included by the API.
+type GetPullRequestOptions struct {
+	// IncludeWorkItemRefs sets PullRequest.WorkItemRefs to the work items
+	// linked to the PR.
+	IncludeWorkItemRefs bool
+	// IncludeCommits sets PullRequest.Commits to the commits of the PR.
+	IncludeCommits bool
+
+	RequestOptions
+}
+
+// GetPullRequestsBatchOptions configures GetPullRequestsBatch.
+type GetPullRequestsBatchOptions struct {
+	// MaxConcurrency is the maximum number of PRs fetched at the same time,
+	// 4 if unset and at most 16.
+	MaxConcurrency int
+
+	GetPullRequestOptions
+}
+
+// GetPullRequestsBatchResult is the result of GetPullRequestsBatch.
+type GetPullRequestsBatchResult struct {
+	// PullRequests are the PRs that could be fetched, in the order of their
+	// IDs.
+	PullRequests []PullRequest
+	// Errors maps the IDs of the PRs that could not be fetched to their error.
+	Errors map[string]error
+}
+
+// Err returns the errors of all PRs that could not be fetched, or nil.
+func (r GetPullRequestsBatchResult) Err() error {
+	ids := make([]string, 0, len(r.Errors))
+	for id := range r.Errors {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var err error
+	for _, id := range ids {
+		err = errors.Append(err, errors.Wrapf(r.Errors[id], "getting pull request %s", id))
+	}
+	return err
+}
+
+// PullRequestSearchCriteria are the search criteria of ListPullRequests. Zero
+// values are omitted from the search.
+type PullRequestSearchCriteria struct {
+	// CreatorID and ReviewerID are the GUIDs of the identity that created the
+	// PRs or is a reviewer of them. The ID of the authenticated user is the
+	// UserID returned by InspectCredentials, or on Azure DevOps Services the ID
+	// of the profile returned by GetAuthorizedProfile.
+	CreatorID  string
+	ReviewerID string
+	// Status defaults to active PRs. Use "all" for PRs of any status.
+	Status PullRequestStatus
+	// SourceRefName and TargetRefName are full ref names, e.g.
+	// refs/heads/main.
+	SourceRefName string
+	TargetRefName string
+
+	ListOptions
+}
+
+type ListPullRequestsResponse struct {
+	Value []PullRequest `json:"value"`
+	Count int           `json:"count"`
+}
+
+// ListPullRequestReviewersOptions configures ListPullRequestReviewers.
+type ListPullRequestReviewersOptions struct {
+	// ResolveDisplayNames looks up the display names of reviewers that are
+	// returned without one with the identities API. Names are cached by the
+	// client.
+	ResolveDisplayNames bool
+
+	RequestOptions
+}
+
+// Label is a tag attached to a PR.
+type Label struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Active bool   `json:"active"`
+	URL    string `json:"url"`
+}
+
+// ResourceRef references another resource, e.g. a work item.
+type ResourceRef struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+}
+
+// PropertiesCollection is a set of custom properties, e.g. of a PR.
+type PropertiesCollection struct {
+	Count int                      `json:"count"`
+	Value map[string]PropertyValue `json:"value"`
+}
+
+// PropertyValue is the value of a custom property along with its .NET type,
+// e.g. System.String.
+type PropertyValue struct {
+	Type  string `json:"$type"`
+	Value any    `json:"$value"`
+}
+
+// JSONPatchOperation is an operation of a JSON-Patch (RFC 6902) document.
+type JSONPatchOperation struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	From  string `json:"from,omitempty"`
+	Value any    `json:"value"`
 }
 
+// MarshalJSON omits the value of remove operations, which have none. Other
+// operations always have one, even if it is the zero value, e.g. false.
+func (o JSONPatchOperation) MarshalJSON() ([]byte, error) {
+	type operation JSONPatchOperation
+	if o.Op != "remove" {
+		return json.Marshal(operation(o))
+	}
+	return json.Marshal(struct {
+		Op   string `json:"op"`
+		Path string `json:"path"`
+		From string `json:"from,omitempty"`
+	}{Op: o.Op, Path: o.Path, From: o.From})
+}
+
+// AddPropertyOperation returns a JSON-Patch operation that sets the custom
+// property key to value.
+func AddPropertyOperation(key string, value any) JSONPatchOperation {
+	return JSONPatchOperation{Op: "add", Path: "/" + jsonPointerEscaper.Replace(key), Value: value}
+}
+
+// RemovePropertyOperation returns a JSON-Patch operation that removes the
+// custom property key.
+func RemovePropertyOperation(key string) JSONPatchOperation {
+	return JSONPatchOperation{Op: "remove", Path: "/" + jsonPointerEscaper.Replace(key)}
+}
+
+// jsonPointerEscaper escapes a key for use in a JSON pointer (RFC 6901).
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
 type PullRequestCommonArgs struct {
 	PullRequestID string
 	Org           string
@@ -121,27 +823,120 @@ type PullRequestCommonArgs struct {
 	RepoNameOrID  string
 }
 
+// PullRequestCommentArgs identify a comment in a comment thread of a PR.
+type PullRequestCommentArgs struct {
+	PullRequestCommonArgs
+	ThreadID  int
+	CommentID int64
+}
+
 type PullRequest struct {
-	Repository            Repository        `json:"repository"`
-	ID                    int               `json:"pullRequestId"`
-	CodeReviewID          int               `json:"codeReviewId"`
-	Status                PullRequestStatus `json:"status"`
-	CreationDate          time.Time         `json:"creationDate"`
-	Title                 string            `json:"title"`
-	Description           string            `json:"description"`
-	CreatedBy             CreatorInfo       `json:"createdBy"`
-	SourceRefName         string            `json:"sourceRefName"`
-	TargetRefName         string            `json:"targetRefName"`
-	MergeStatus           string            `json:"mergeStatus"`
-	MergeID               string            `json:"mergeId"`
-	LastMergeSourceCommit PullRequestCommit `json:"lastMergeSourceCommit"`
-	LastMergeTargetCommit PullRequestCommit `json:"lastMergeTargetCommit"`
-	SupportsIterations    bool              `json:"supportsIterations"`
-	ArtifactID            string            `json:"artifactId"`
-	Reviewers             []Reviewer        `json:"reviewers"`
-	ForkSource            *ForkRef          `json:"forkSource"`
-	URL                   string            `json:"url"`
-	IsDraft               bool              `json:"isDraft"`
+	Repository   Repository        `json:"repository"`
+	ID           int               `json:"pullRequestId"`
+	CodeReviewID int               `json:"codeReviewId"`
+	Status       PullRequestStatus `json:"status"`
+	CreationDate time.Time         `json:"creationDate"`
+	// ClosedDate is only set once the PR is completed or abandoned.
+	ClosedDate            *time.Time             `json:"closedDate,omitempty"`
+	Title                 string                 `json:"title"`
+	Description           string                 `json:"description"`
+	CreatedBy             CreatorInfo            `json:"createdBy"`
+	SourceRefName         string                 `json:"sourceRefName"`
+	TargetRefName         string                 `json:"targetRefName"`
+	MergeStatus           PullRequestMergeStatus `json:"mergeStatus"`
+	MergeID               string                 `json:"mergeId"`
+	LastMergeSourceCommit PullRequestCommit      `json:"lastMergeSourceCommit"`
+	LastMergeTargetCommit PullRequestCommit      `json:"lastMergeTargetCommit"`
+	SupportsIterations    bool                   `json:"supportsIterations"`
+	ArtifactID            string                 `json:"artifactId"`
+	Reviewers             []Reviewer             `json:"reviewers"`
+	ForkSource            *ForkRef               `json:"forkSource"`
+	URL                   string                 `json:"url"`
+	IsDraft               bool                   `json:"isDraft"`
+	// AutoCompleteSetBy is set if the PR is going to be completed
+	// automatically once all policies pass.
+	AutoCompleteSetBy *CreatorInfo                  `json:"autoCompleteSetBy"`
+	CompletionOptions *PullRequestCompletionOptions `json:"completionOptions"`
+	// CompletionQueueTime is when the PR was queued to be completed, i.e.
+	// merged into its target, once its policies passed. Azure DevOps doesn't
+	// tell the position of the PR in the queue.
+	CompletionQueueTime *time.Time `json:"completionQueueTime,omitempty"`
+	// MergeFailureType and MergeFailureMessage describe why the last merge
+	// failed, see MergeFailureReason.
+	MergeFailureType    PullRequestMergeFailureType `json:"mergeFailureType,omitempty"`
+	MergeFailureMessage string                      `json:"mergeFailureMessage,omitempty"`
+	Links               Links                       `json:"_links,omitempty"`
+	// Labels are always returned by the API.
+	Labels []Label `json:"labels,omitempty"`
+	// WorkItemRefs and Commits are only set if requested with
+	// GetPullRequestOptions.
+	WorkItemRefs []ResourceRef `json:"workItemRefs,omitempty"`
+	Commits      []Commit      `json:"commits,omitempty"`
+
+	// RawJSON is the raw response body, only set if the client is configured to
+	// capture it with SetCaptureRawJSON.
+	RawJSON json.RawMessage `json:"-"`
+}
+
+func (p *PullRequest) UnmarshalJSON(data []byte) error {
+	type pullRequest PullRequest
+	aux := struct {
+		*pullRequest
+		CreationDate        azureTime  `json:"creationDate"`
+		ClosedDate          *azureTime `json:"closedDate"`
+		CompletionQueueTime *azureTime `json:"completionQueueTime"`
+	}{pullRequest: (*pullRequest)(p)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	p.CreationDate = aux.CreationDate.Time
+	p.ClosedDate = aux.ClosedDate.ptr()
+	p.CompletionQueueTime = aux.CompletionQueueTime.ptr()
+	return nil
+}
+
+// Completing returns true if the PR is on its way to be completed: it is active
+// and either queued for completion or waiting for its policies to pass with
+// auto-complete set. Poll GetPullRequest to follow its progress.
+//
+// The merge status isn't taken into account: a queued merge is the preview
+// merge Azure DevOps computes on every push, which doesn't complete the PR.
+func (p PullRequest) Completing() bool {
+	if p.Status != PullRequestStatusActive {
+		return false
+	}
+	return p.CompletionQueueTime != nil || p.AutoCompleteSetBy != nil
+}
+
+// MergeFailureReason returns a human readable explanation of why the last
+// attempt to merge the PR failed, including the message of Azure DevOps if it
+// gave one, or "" if it didn't fail.
+func (p PullRequest) MergeFailureReason() string {
+	var reason string
+	switch {
+	case p.MergeStatus == PullRequestMergeStatusConflicts:
+		reason = "the source branch has conflicts with the target branch"
+	case p.MergeStatus == PullRequestMergeStatusRejectedByPolicy:
+		reason = "the merge was rejected by a branch policy"
+	case p.MergeFailureType == PullRequestMergeFailureTypeCaseSensitive:
+		reason = "the merge would create paths that only differ in case"
+	case p.MergeFailureType == PullRequestMergeFailureTypeObjectTooLarge:
+		reason = "the merge contains an object that exceeds the size limit"
+	case p.MergeStatus == PullRequestMergeStatusFailure || p.MergeFailureType == PullRequestMergeFailureTypeUnknown || p.MergeFailureMessage != "":
+		reason = "the merge failed"
+	default:
+		return ""
+	}
+
+	if p.MergeFailureMessage != "" {
+		reason += ": " + p.MergeFailureMessage
+	}
+	return reason
+}
+
+func (p *PullRequest) setRawJSON(data []byte) error {
+	p.RawJSON = data
+	return nil
 }
 
 type PullRequestCommit struct {
@@ -158,12 +953,95 @@ type PullRequestUpdateInput struct {
 	TargetRefName         *string                       `json:"targetRefName"`
 	IsDraft               *bool                         `json:"isDraft"`
 	CompletionOptions     *PullRequestCompletionOptions `json:"completionOptions"`
+	AutoCompleteSetBy     *IdentityRefInput             `json:"autoCompleteSetBy,omitempty"`
 	// ADO does not seem to support updating Source ref name, only TargetRefName which needs to be explicitly enabled.
+
+	// IfMatch, if set, is sent as the If-Match header, see WithIfMatch.
+	IfMatch string `json:"-"`
+}
+
+// IdentityRefInput references an identity by ID in request bodies.
+type IdentityRefInput struct {
+	ID string `json:"id"`
+}
+
+type PullRequestAutoCompleteInput struct {
+	// SetByID is the ID of the identity that sets auto-complete, usually the
+	// authenticated user. Required.
+	SetByID           string
+	CompletionOptions PullRequestCompletionOptions
 }
 
 type PullRequestStatus string
+
+func (s PullRequestStatus) valid() bool {
+	switch s {
+	case PullRequestStatusActive, PullRequestStatusAbandoned, PullRequestStatusCompleted, PullRequestStatusNotSet, PullRequestStatusAll:
+		return true
+	}
+	return false
+}
+
+// PullRequestMergeStatus is the status of the last attempt to merge the source
+// of a PR into its target.
+type PullRequestMergeStatus string
+
+func (s *PullRequestMergeStatus) UnmarshalJSON(data []byte) error {
+	var status string
+	if err := json.Unmarshal(data, &status); err != nil {
+		return err
+	}
+	switch PullRequestMergeStatus(status) {
+	case "", PullRequestMergeStatusNotSet, PullRequestMergeStatusQueued, PullRequestMergeStatusConflicts,
+		PullRequestMergeStatusSucceeded, PullRequestMergeStatusRejectedByPolicy, PullRequestMergeStatusFailure:
+		*s = PullRequestMergeStatus(status)
+	default:
+		*s = PullRequestMergeStatusUnknown
+	}
+	return nil
+}
+
+// CanMerge reports whether the source merged into the target without issues.
+// Policies may still prevent completing the PR.
+func (s PullRequestMergeStatus) CanMerge() bool {
+	return s == PullRequestMergeStatusSucceeded
+}
+
+// HasConflicts reports whether merging the source into the target failed due
+// to conflicts.
+func (s PullRequestMergeStatus) HasConflicts() bool {
+	return s == PullRequestMergeStatusConflicts
+}
+
+// PullRequestMergeFailureType classifies why merging the source of a PR into
+// its target failed.
+type PullRequestMergeFailureType string
+
+func (t *PullRequestMergeFailureType) UnmarshalJSON(data []byte) error {
+	var failureType string
+	if err := json.Unmarshal(data, &failureType); err != nil {
+		return err
+	}
+	switch PullRequestMergeFailureType(failureType) {
+	case "", PullRequestMergeFailureTypeNone, PullRequestMergeFailureTypeUnknown,
+		PullRequestMergeFailureTypeCaseSensitive, PullRequestMergeFailureTypeObjectTooLarge:
+		*t = PullRequestMergeFailureType(failureType)
+	default:
+		*t = PullRequestMergeFailureTypeUnknown
+	}
+	return nil
+}
+
 type PullRequestMergeStrategy string
 
+func (s PullRequestMergeStrategy) valid() bool {
+	switch s {
+	case PullRequestMergeStrategySquash, PullRequestMergeStrategyRebase, PullRequestMergeStrategyRebaseMerge, PullRequestMergeStrategyNoFastForward:
+		return true
+	}
+	return false
+}
+
 type PullRequestMergeOptions struct {
 	ConflictAuthorshipCommits  *bool `json:"conflictAuthorshipCommits"`
 	DetectRenameFalsePositives *bool `json:"detectRenameFalsePositives"`
@@ -184,14 +1062,185 @@ type PullRequestCompletionOptions struct {
 
 type PullRequestCommentInput struct {
 	Comments []PullRequestCommentForInput `json:"Comments"`
+	// ThreadContext attaches the thread to lines of a file, see
+	// InlineCommentContext. It is nil for general comments.
+	ThreadContext *PullRequestThreadContext `json:"threadContext,omitempty"`
+	// IterationContext is the diff the lines of ThreadContext refer to. If
+	// nil, Azure DevOps assumes the diff of the latest iteration against the
+	// target branch, which puts the comment on the wrong line if the file
+	// changed since the iteration the lines were taken from.
+	IterationContext *CommentIterationContext `json:"-"`
+}
+
+// MarshalJSON nests IterationContext the way the threads endpoint expects it.
+func (i PullRequestCommentInput) MarshalJSON() ([]byte, error) {
+	type input PullRequestCommentInput
+	body := struct {
+		input
+		PullRequestThreadContext *pullRequestThreadContext `json:"pullRequestThreadContext,omitempty"`
+	}{input: input(i)}
+	if i.IterationContext != nil {
+		body.PullRequestThreadContext = &pullRequestThreadContext{IterationContext: *i.IterationContext}
+	}
+	return json.Marshal(body)
 }
 
+// CommentIterationContext is the diff between two iterations of a PR that a
+// comment thread is placed on. The lines of the left side of a
+// PullRequestThreadContext refer to FirstComparingIteration, those of the right
+// side to SecondComparingIteration. To comment on the changes of the whole PR,
+// FirstComparingIteration is 1 and SecondComparingIteration the latest
+// iteration.
+type CommentIterationContext struct {
+	FirstComparingIteration  int `json:"firstComparingIteration"`
+	SecondComparingIteration int `json:"secondComparingIteration"`
+}
+
+type pullRequestThreadContext struct {
+	IterationContext CommentIterationContext `json:"iterationContext"`
+}
+
+// Attachment is a file uploaded to a PR with UploadPullRequestAttachment.
+type Attachment struct {
+	ID          int         `json:"id"`
+	DisplayName string      `json:"displayName"`
+	URL         string      `json:"url"`
+	Author      CreatorInfo `json:"author"`
+	CreatedDate time.Time   `json:"createdDate"`
+}
+
+func (a *Attachment) UnmarshalJSON(data []byte) error {
+	type attachment Attachment
+	aux := struct {
+		*attachment
+		CreatedDate azureTime `json:"createdDate"`
+	}{attachment: (*attachment)(a)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	a.CreatedDate = aux.CreatedDate.Time
+	return nil
+}
+
+// PullRequestCommentResponse is a comment thread on a pull request.
 type PullRequestCommentResponse struct {
 	ID            int                             `json:"id"`
 	Comments      []PullRequestCommentForResponse `json:"Comments"`
 	PublishedDate time.Time                       `json:"publishedDate"`
-	LastUpdatedOn time.Time                       `json:"lastUpdatedOn"`
+	LastUpdatedOn time.Time                       `json:"lastUpdatedDate"`
 	IsDeleted     bool                            `json:"isDeleted"`
+	Status        string                          `json:"status"`
+	// ThreadContext is nil for general comments that are not attached to a file.
+	ThreadContext *PullRequestThreadContext `json:"threadContext"`
+}
+
+func (t *PullRequestCommentResponse) UnmarshalJSON(data []byte) error {
+	type pullRequestCommentResponse PullRequestCommentResponse
+	aux := struct {
+		*pullRequestCommentResponse
+		PublishedDate azureTime `json:"publishedDate"`
+		LastUpdatedOn azureTime `json:"lastUpdatedDate"`
+	}{pullRequestCommentResponse: (*pullRequestCommentResponse)(t)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	t.PublishedDate = aux.PublishedDate.Time
+	t.LastUpdatedOn = aux.LastUpdatedOn.Time
+	return nil
+}
+
+// ListPullRequestThreadsOptions configures ListPullRequestThreads.
+//
+// A thread is anchored to the lines of the iteration it was created on. If
+// IterationID is set, Azure DevOps tracks the thread through later pushes and
+// returns the ThreadContext adjusted to the lines of the diff between the
+// iterations BaseIterationID (left side) and IterationID (right side), so
+// that comments land on the right line even after force-pushes.
+type ListPullRequestThreadsOptions struct {
+	// IterationID is the iteration of the right side of the diff, e.g. the
+	// latest iteration.
+	IterationID int
+	// BaseIterationID is the iteration of the left side of the diff. If 0, the
+	// left side is the target branch. It requires IterationID to be set.
+	BaseIterationID int
+
+	RequestOptions
+}
+
+func (o ListPullRequestThreadsOptions) queryParams() (url.Values, error) {
+	queryParams := make(url.Values)
+	if o.IterationID < 0 || o.BaseIterationID < 0 {
+		return nil, errors.New("iteration IDs must not be negative")
+	}
+	if o.BaseIterationID != 0 {
+		if o.IterationID == 0 {
+			return nil, errors.New("a base iteration requires an iteration")
+		}
+		if o.BaseIterationID >= o.IterationID {
+			return nil, errors.Newf("base iteration %d must be before iteration %d", o.BaseIterationID, o.IterationID)
+		}
+		queryParams.Set("$baseIteration", strconv.Itoa(o.BaseIterationID))
+	}
+	if o.IterationID != 0 {
+		queryParams.Set("$iteration", strconv.Itoa(o.IterationID))
+	}
+	return queryParams, nil
+}
+
+// latestUpdate returns the latest time the thread or one of its comments was
+// published or updated.
+func (t PullRequestCommentResponse) latestUpdate() time.Time {
+	latest := t.PublishedDate
+	times := []time.Time{t.LastUpdatedOn}
+	for _, comment := range t.Comments {
+		times = append(times, comment.PublishedDate, comment.LastUpdatedOn)
+	}
+	for _, ts := range times {
+		if ts.After(latest) {
+			latest = ts
+		}
+	}
+	return latest
+}
+
+type ListPullRequestThreadsResponse struct {
+	Value []PullRequestCommentResponse `json:"value"`
+	Count int                          `json:"count"`
+}
+
+// ListCommentLikesResponse lists the identities that liked a comment.
+type ListCommentLikesResponse struct {
+	Value []CreatorInfo `json:"value"`
+	Count int           `json:"count"`
+}
+
+// PullRequestThreadContext describes the file and line range a comment thread
+// is attached to. The left side is the target (old) version of the file, the
+// right side the source (new) version.
+type PullRequestThreadContext struct {
+	FilePath       string           `json:"filePath"`
+	LeftFileStart  *CommentPosition `json:"leftFileStart"`
+	LeftFileEnd    *CommentPosition `json:"leftFileEnd"`
+	RightFileStart *CommentPosition `json:"rightFileStart"`
+	RightFileEnd   *CommentPosition `json:"rightFileEnd"`
+}
+
+// CommentPosition is a 1-based line and character offset in a file.
+type CommentPosition struct {
+	Line   int `json:"line"`
+	Offset int `json:"offset"`
+}
+
+type CommentSide string
+
+// InlineComment is a single pull request comment with its thread context
+// resolved. FilePath, LineNumber and Side are empty for general comments.
+type InlineComment struct {
+	ThreadID   int
+	Comment    PullRequestCommentForResponse
+	FilePath   string
+	LineNumber int
+	Side       CommentSide
 }
 
 type PullRequestCommentForInput struct {
@@ -200,11 +1249,38 @@ type PullRequestCommentForInput struct {
 	CommentType     int    `json:"commentType"`
 }
 type PullRequestCommentForResponse struct {
-	ID            int64     `json:"id"`
-	PublishedDate time.Time `json:"publishedDate"`
-	LastUpdatedOn time.Time `json:"lastUpdatedOn"`
-	Content       string    `json:"content"`
-	CommentType   string    `json:"commentType"`
+	ID              int64       `json:"id"`
+	ParentCommentID int64       `json:"parentCommentId"`
+	Author          CreatorInfo `json:"author"`
+	PublishedDate   time.Time   `json:"publishedDate"`
+	LastUpdatedOn   time.Time   `json:"lastUpdatedDate"`
+	Content         string      `json:"content"`
+	CommentType     string      `json:"commentType"`
+	IsDeleted       bool        `json:"isDeleted"`
+}
+
+func (c *PullRequestCommentForResponse) UnmarshalJSON(data []byte) error {
+	type pullRequestCommentForResponse PullRequestCommentForResponse
+	aux := struct {
+		*pullRequestCommentForResponse
+		PublishedDate azureTime `json:"publishedDate"`
+		LastUpdatedOn azureTime `json:"lastUpdatedDate"`
+	}{pullRequestCommentForResponse: (*pullRequestCommentForResponse)(c)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	c.PublishedDate = aux.PublishedDate.Time
+	c.LastUpdatedOn = aux.LastUpdatedOn.Time
+	return nil
+}
+
+// latestUpdate returns the time the comment was last updated, or published if
+// it wasn't updated since.
+func (c PullRequestCommentForResponse) latestUpdate() time.Time {
+	if c.LastUpdatedOn.After(c.PublishedDate) {
+		return c.LastUpdatedOn
+	}
+	return c.PublishedDate
 }
 
 type PullRequestStatuses struct {
@@ -221,18 +1297,184 @@ type PullRequestBuildStatus struct {
 	CreatedBy    CreatorInfo            `json:"createdBy"`
 }
 
+func (s *PullRequestBuildStatus) UnmarshalJSON(data []byte) error {
+	type pullRequestBuildStatus PullRequestBuildStatus
+	aux := struct {
+		*pullRequestBuildStatus
+		CreationDate azureTime `json:"creationDate"`
+		UpdateDate   azureTime `json:"updatedDate"`
+	}{pullRequestBuildStatus: (*pullRequestBuildStatus)(s)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	s.CreationDate = aux.CreationDate.Time
+	s.UpdateDate = aux.UpdateDate.Time
+	return nil
+}
+
 type PullRequestStatusState string
 
+type ListPolicyConfigurationsResponse struct {
+	Value []PolicyConfiguration `json:"value"`
+	Count int                   `json:"count"`
+}
+
+type PolicyConfiguration struct {
+	ID         int        `json:"id"`
+	Type       PolicyType `json:"type"`
+	Revision   int        `json:"revision"`
+	IsEnabled  bool       `json:"isEnabled"`
+	IsBlocking bool       `json:"isBlocking"`
+	IsDeleted  bool       `json:"isDeleted"`
+	// Settings depend on the policy type, use the typed accessors such as
+	// MinimumReviewersSettings to decode them.
+	Settings json.RawMessage `json:"settings"`
+	URL      string          `json:"url"`
+}
+
+type PolicyType struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+}
+
+// PolicyEvaluation is the state of a policy configuration evaluated for a PR.
+type PolicyEvaluation struct {
+	EvaluationID  string                 `json:"evaluationId"`
+	ArtifactID    string                 `json:"artifactId"`
+	Status        PolicyEvaluationStatus `json:"status"`
+	Configuration PolicyConfiguration    `json:"configuration"`
+	StartedDate   *time.Time             `json:"startedDate,omitempty"`
+	CompletedDate *time.Time             `json:"completedDate,omitempty"`
+	// Context depends on the policy type, e.g. it references the build of a
+	// build policy.
+	Context json.RawMessage `json:"context,omitempty"`
+}
+
+func (e *PolicyEvaluation) UnmarshalJSON(data []byte) error {
+	type policyEvaluation PolicyEvaluation
+	aux := struct {
+		*policyEvaluation
+		StartedDate   *azureTime `json:"startedDate"`
+		CompletedDate *azureTime `json:"completedDate"`
+	}{policyEvaluation: (*policyEvaluation)(e)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	e.StartedDate = aux.StartedDate.ptr()
+	e.CompletedDate = aux.CompletedDate.ptr()
+	return nil
+}
+
+// Blocking returns true if the evaluated policy is blocking and keeps the PR
+// from being completed, because it was rejected or is still pending.
+func (e PolicyEvaluation) Blocking() bool {
+	if !e.Configuration.IsEnabled || !e.Configuration.IsBlocking {
+		return false
+	}
+	return e.Status != PolicyEvaluationStatusApproved && e.Status != PolicyEvaluationStatusNotApplicable
+}
+
+type PolicyEvaluationStatus string
+
+const (
+	PolicyEvaluationStatusQueued        PolicyEvaluationStatus = "queued"
+	PolicyEvaluationStatusRunning       PolicyEvaluationStatus = "running"
+	PolicyEvaluationStatusApproved      PolicyEvaluationStatus = "approved"
+	PolicyEvaluationStatusRejected      PolicyEvaluationStatus = "rejected"
+	PolicyEvaluationStatusNotApplicable PolicyEvaluationStatus = "notApplicable"
+	PolicyEvaluationStatusBroken        PolicyEvaluationStatus = "broken"
+)
+
+type PolicyScope struct {
+	RepositoryID string `json:"repositoryId,omitempty"`
+	RefName      string `json:"refName,omitempty"`
+	// MatchKind is either "exact" or "prefix", compared case-insensitively.
+	MatchKind string `json:"matchKind,omitempty"`
+}
+
+type MinimumReviewersPolicySettings struct {
+	MinimumApproverCount       int           `json:"minimumApproverCount"`
+	CreatorVoteCounts          bool          `json:"creatorVoteCounts"`
+	AllowDownvotes             bool          `json:"allowDownvotes"`
+	ResetOnSourcePush          bool          `json:"resetOnSourcePush"`
+	BlockLastPusherVote        bool          `json:"blockLastPusherVote"`
+	RequireVoteOnLastIteration bool          `json:"requireVoteOnLastIteration"`
+	Scope                      []PolicyScope `json:"scope"`
+}
+
+type RequiredReviewersPolicySettings struct {
+	RequiredReviewerIDs  []string      `json:"requiredReviewerIds"`
+	MinimumApproverCount int           `json:"minimumApproverCount"`
+	CreatorVoteCounts    bool          `json:"creatorVoteCounts"`
+	FilenamePatterns     []string      `json:"filenamePatterns,omitempty"`
+	Message              string        `json:"message,omitempty"`
+	Scope                []PolicyScope `json:"scope"`
+}
+
+// RequiredReviewersPolicyInput configures CreateOrUpdateRequiredReviewersPolicy.
+type RequiredReviewersPolicyInput struct {
+	// ID is the ID of the configuration to update. If 0, a new configuration
+	// is created.
+	ID         int
+	IsEnabled  bool
+	IsBlocking bool
+	// Settings needs at least one reviewer and one scope. The RepositoryID of
+	// each scope must be set, and the RefName of exact scopes may also be a
+	// branch name without refs/heads/.
+	Settings RequiredReviewersPolicySettings
+}
+
+// RequiredReviewer is a reviewer that a required reviewers policy adds to PRs
+// automatically.
+type RequiredReviewer struct {
+	// ID is the ID of the user or group.
+	ID string
+	// PolicyID is the ID of the policy configuration requiring the reviewer.
+	PolicyID int
+	// IsBlocking is true if the PR can't be completed without the approval of
+	// the reviewer.
+	IsBlocking bool
+	// FilenamePatterns limit the policy to PRs changing matching paths. If
+	// empty, the policy applies to all PRs.
+	FilenamePatterns []string
+	// Scope is the repositories and branches the policy applies to.
+	Scope []PolicyScope
+}
+
+type BuildPolicySettings struct {
+	BuildDefinitionID       int           `json:"buildDefinitionId"`
+	DisplayName             string        `json:"displayName"`
+	QueueOnSourceUpdateOnly bool          `json:"queueOnSourceUpdateOnly"`
+	ManualQueueOnly         bool          `json:"manualQueueOnly"`
+	ValidDuration           float64       `json:"validDuration"`
+	FilenamePatterns        []string      `json:"filenamePatterns,omitempty"`
+	Scope                   []PolicyScope `json:"scope"`
+}
+
 type Repository struct {
-	ID         string  `json:"id"`
-	Name       string  `json:"name"`
-	CloneURL   string  `json:"remoteURL"`
-	APIURL     string  `json:"url"`
-	SSHURL     string  `json:"sshUrl"`
-	WebURL     string  `json:"webUrl"`
-	IsDisabled bool    `json:"isDisabled"`
-	IsFork     bool    `json:"isFork"`
-	Project    Project `json:"project"`
+	ID            string  `json:"id"`
+	Name          string  `json:"name"`
+	CloneURL      string  `json:"remoteURL"`
+	APIURL        string  `json:"url"`
+	SSHURL        string  `json:"sshUrl"`
+	WebURL        string  `json:"webUrl"`
+	DefaultBranch string  `json:"defaultBranch"`
+	IsDisabled    bool    `json:"isDisabled"`
+	IsFork        bool    `json:"isFork"`
+	Project       Project `json:"project"`
+	Links         Links   `json:"_links,omitempty"`
+	// Size is the compressed size of the repository in bytes. Older versions
+	// of Azure DevOps Server don't report it.
+	Size *int64 `json:"size,omitempty"`
+
+	// RawJSON is the raw response body, only set if the client is configured to
+	// capture it with SetCaptureRawJSON.
+	RawJSON json.RawMessage `json:"-"`
+}
+
+func (p *Repository) setRawJSON(data []byte) error {
+	p.RawJSON = data
+	return nil
 }
 
 type Project struct {
@@ -242,6 +1484,15 @@ type Project struct {
 	Revision   int    `json:"revision"`
 	Visibility string `json:"visibility"`
 	URL        string `json:"url"`
+
+	// RawJSON is the raw response body, only set if the client is configured to
+	// capture it with SetCaptureRawJSON.
+	RawJSON json.RawMessage `json:"-"`
+}
+
+func (p *Project) setRawJSON(data []byte) error {
+	p.RawJSON = data
+	return nil
 }
 
 func (p Repository) GetOrganization() (string, error) {
@@ -262,6 +1513,89 @@ func (p Repository) Namespace() string {
 	return p.Project.Name
 }
 
+// WebLink returns the URL of the repository in the web UI.
+func (p Repository) WebLink() string {
+	if href := p.Links.Href("web"); href != "" {
+		return href
+	}
+	return p.WebURL
+}
+
+// FileWebURL returns the URL of the file at path and version in the web UI,
+// highlighting the given line if it is positive. If version is nil, the URL
+// points to the default branch. It returns an empty string if the web URL of
+// the repository is unknown or invalid.
+func (p Repository) FileWebURL(path string, version *GitVersionDescriptor, line int) string {
+	webLink := p.WebLink()
+	if webLink == "" {
+		return ""
+	}
+	u, err := url.Parse(webLink)
+	if err != nil {
+		return ""
+	}
+
+	// The web URL may already carry query parameters, which are kept.
+	q := u.Query()
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	q.Set("path", path)
+	if version != nil && version.Version != "" {
+		q.Set("version", version.webPrefix()+version.Version)
+	}
+	if line > 0 {
+		q.Set("line", strconv.Itoa(line))
+		q.Set("lineEnd", strconv.Itoa(line+1))
+		q.Set("lineStartColumn", "1")
+		q.Set("lineEndColumn", "1")
+		q.Set("lineStyle", "plain")
+		q.Set("_a", "contents")
+	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// webPrefix returns the prefix the web UI uses to denote the version type in
+// URLs: GB for branches (the default), GC for commits and GT for tags.
+func (v GitVersionDescriptor) webPrefix() string {
+	switch v.VersionType {
+	case GitVersionTypeCommit:
+		return "GC"
+	case GitVersionTypeTag:
+		return "GT"
+	default:
+		return "GB"
+	}
+}
+
+// WebLink returns the URL of the pull request in the web UI. The API usually
+// doesn't include a web link for pull requests, in which case it's derived
+// from the web URL of the repository.
+func (p PullRequest) WebLink() string {
+	if href := p.Links.Href("web"); href != "" {
+		return href
+	}
+	if p.Repository.WebURL == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s/pullrequest/%d", strings.TrimSuffix(p.Repository.WebURL, "/"), p.ID)
+}
+
+// Links are the hypermedia links to related resources included in some
+// responses, keyed by relation, e.g. "self", "web" or "commits".
+type Links map[string]Link
+
+type Link struct {
+	Href string `json:"href"`
+}
+
+// Href returns the URL of the given relation, or an empty string if the
+// relation is not included.
+func (l Links) Href(rel string) string {
+	return l[rel].Href
+}
+
 type Profile struct {
 	ID           string    `json:"id"`
 	DisplayName  string    `json:"displayName"`
@@ -270,6 +1604,68 @@ type Profile struct {
 	PublicAlias  string    `json:"publicAlias"`
 }
 
+func (p *Profile) UnmarshalJSON(data []byte) error {
+	type profile Profile
+	aux := struct {
+		*profile
+		LastChanged azureTime `json:"timestamp"`
+	}{profile: (*profile)(p)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	p.LastChanged = aux.LastChanged.Time
+	return nil
+}
+
+// Identity is a user or group as returned by the identities API.
+type Identity struct {
+	ID                  string `json:"id"`
+	ProviderDisplayName string `json:"providerDisplayName"`
+	CustomDisplayName   string `json:"customDisplayName,omitempty"`
+	IsActive            bool   `json:"isActive"`
+	// Descriptor identifies the identity to the security APIs.
+	Descriptor string `json:"descriptor,omitempty"`
+	// MemberOf are the descriptors of the groups the identity is a member
+	// of, only set if the memberships were queried.
+	MemberOf []string `json:"memberOf,omitempty"`
+}
+
+// DisplayName returns the custom display name of the identity if it has one,
+// and its display name at the identity provider otherwise.
+func (i Identity) DisplayName() string {
+	if i.CustomDisplayName != "" {
+		return i.CustomDisplayName
+	}
+	return i.ProviderDisplayName
+}
+
+// User is a user identity resolved by ResolveCommitAuthors.
+type User struct {
+	ID          string
+	DisplayName string
+	// MailAddress is the email address the user was resolved by.
+	MailAddress string
+}
+
+type ListIdentitiesResponse struct {
+	Count int         `json:"count"`
+	Value []*Identity `json:"value"`
+}
+
+// ConnectionData is returned by the connectionData endpoint of an
+// organization.
+type ConnectionData struct {
+	AuthenticatedUser ConnectionDataIdentity `json:"authenticatedUser"`
+	AuthorizedUser    ConnectionDataIdentity `json:"authorizedUser"`
+	InstanceID        string                 `json:"instanceId"`
+}
+
+type ConnectionDataIdentity struct {
+	ID                  string `json:"id"`
+	Descriptor          string `json:"descriptor"`
+	ProviderDisplayName string `json:"providerDisplayName"`
+}
+
 type CreatorInfo struct {
 	ID          string `json:"id"`
 	DisplayName string `json:"displayName"`
@@ -278,10 +1674,280 @@ type CreatorInfo struct {
 	ImageURL    string `json:"imageUrl"`
 }
 
+// rawJSONCapturer is implemented by result types that can retain the raw JSON
+// they were decoded from.
+type rawJSONCapturer interface {
+	setRawJSON(data []byte) error
+}
+
+type ListPipelinesResponse struct {
+	Value []Pipeline `json:"value"`
+	Count int        `json:"count"`
+}
+
+// ListPipelinesOptions configures ListPipelines.
+type ListPipelinesOptions struct {
+	ListOptions
+}
+
+// Pipeline is a YAML pipeline. Pipelines are a separate API from classic
+// build definitions.
+type Pipeline struct {
+	ID       int    `json:"id"`
+	Revision int    `json:"revision"`
+	Name     string `json:"name"`
+	Folder   string `json:"folder"`
+	URL      string `json:"url"`
+	Links    Links  `json:"_links,omitempty"`
+}
+
+const (
+	// BuildRepositoryTypeTfsGit is the type of Azure Repos Git repositories.
+	BuildRepositoryTypeTfsGit = "TfsGit"
+	// BuildRepositoryTypeGitHub is the type of GitHub repositories connected
+	// through a service connection, whose repository ID is "owner/name".
+	BuildRepositoryTypeGitHub = "GitHub"
+)
+
+// ListBuildDefinitionsOptions configures ListBuildDefinitions.
+type ListBuildDefinitionsOptions struct {
+	// RepositoryID limits the definitions to those building the repository.
+	RepositoryID string
+	// RepositoryType is the type of the repository RepositoryID, e.g.
+	// BuildRepositoryTypeGitHub. Defaults to BuildRepositoryTypeTfsGit.
+	RepositoryType string
+
+	ListOptions
+}
+
+// BuildDefinition is a YAML pipeline or a classic build definition, as
+// returned by the build API. The ID of a YAML pipeline is the ID of its
+// definition.
+type BuildDefinition struct {
+	ID         int                       `json:"id"`
+	Name       string                    `json:"name"`
+	Path       string                    `json:"path"`
+	Revision   int                       `json:"revision"`
+	Repository BuildDefinitionRepository `json:"repository"`
+	Triggers   []BuildTrigger            `json:"triggers,omitempty"`
+}
+
+type BuildDefinitionRepository struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	DefaultBranch string `json:"defaultBranch"`
+}
+
+// BuildTrigger is a trigger of a build definition, e.g. of type
+// continuousIntegration or pullRequest.
+type BuildTrigger struct {
+	TriggerType string `json:"triggerType"`
+	// BranchFilters are branches prefixed with + to include or - to exclude
+	// them, e.g. +refs/heads/main. They are empty if the trigger is
+	// configured in the YAML file of a pipeline rather than in the
+	// definition.
+	BranchFilters []string `json:"branchFilters,omitempty"`
+}
+
+// TriggerBranches returns the branches included by the branch filters of the
+// continuous integration triggers of the definition, which may contain
+// wildcards, e.g. refs/heads/releases/*. Triggers configured in YAML files
+// are not taken into account.
+func (d BuildDefinition) TriggerBranches() []string {
+	var branches []string
+	for _, t := range d.Triggers {
+		if t.TriggerType != "continuousIntegration" {
+			continue
+		}
+		for _, f := range t.BranchFilters {
+			if strings.HasPrefix(f, "-") {
+				continue
+			}
+			branches = append(branches, strings.TrimPrefix(f, "+"))
+		}
+	}
+	return branches
+}
+
+// RunPipelineInput configures a run of a pipeline.
+type RunPipelineInput struct {
+	PipelineID int
+	// RefName is the ref of the pipeline's repository to run the pipeline
+	// for, e.g. refs/heads/main. Defaults to the default branch.
+	RefName string
+	// Variables are passed to the run. They must be settable at queue time.
+	Variables map[string]string
+	// TemplateParameters are the runtime parameters of the pipeline.
+	TemplateParameters map[string]string
+	// DryRun validates the run, including expanding templates, without
+	// queueing it.
+	DryRun bool
+}
+
+type PipelineRunState string
+
+type PipelineRunResult string
+
+// PipelineRun is a run of a YAML pipeline. Result is only set once State is
+// PipelineRunStateCompleted.
+type PipelineRun struct {
+	ID           int               `json:"id"`
+	Name         string            `json:"name"`
+	State        PipelineRunState  `json:"state"`
+	Result       PipelineRunResult `json:"result"`
+	CreatedDate  time.Time         `json:"createdDate"`
+	FinishedDate *time.Time        `json:"finishedDate"`
+	Pipeline     Pipeline          `json:"pipeline"`
+	URL          string            `json:"url"`
+	Links        Links             `json:"_links,omitempty"`
+	// FinalYAML is only set for dry runs.
+	FinalYAML string `json:"finalYaml,omitempty"`
+}
+
+func (r *PipelineRun) UnmarshalJSON(data []byte) error {
+	type pipelineRun PipelineRun
+	aux := struct {
+		*pipelineRun
+		CreatedDate  azureTime  `json:"createdDate"`
+		FinishedDate *azureTime `json:"finishedDate"`
+	}{pipelineRun: (*pipelineRun)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.CreatedDate = aux.CreatedDate.Time
+	r.FinishedDate = aux.FinishedDate.ptr()
+	return nil
+}
+
+// SecurityNamespace is a set of permissions that can be granted on a kind of
+// resource, e.g. Git repositories.
+type SecurityNamespace struct {
+	NamespaceID string `json:"namespaceId"`
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName"`
+}
+
+// AccessControlList is the list of access control entries of a token, e.g. of
+// a repository, in a security namespace.
+type AccessControlList struct {
+	Token              string `json:"token"`
+	InheritPermissions bool   `json:"inheritPermissions"`
+	// AcesDictionary maps identity descriptors to their entry.
+	AcesDictionary map[string]AccessControlEntry `json:"acesDictionary"`
+}
+
+// AccessControlEntry is the permissions an identity is allowed and denied,
+// as bit masks of the permissions of the namespace.
+type AccessControlEntry struct {
+	Descriptor string `json:"descriptor"`
+	Allow      int    `json:"allow"`
+	Deny       int    `json:"deny"`
+	// ExtendedInfo is only set if it was requested.
+	ExtendedInfo *AceExtendedInfo `json:"extendedInfo,omitempty"`
+}
+
+// AceExtendedInfo are the permissions of an access control entry including
+// those it inherits from the parent tokens.
+type AceExtendedInfo struct {
+	EffectiveAllow int `json:"effectiveAllow"`
+	EffectiveDeny  int `json:"effectiveDeny"`
+	InheritedAllow int `json:"inheritedAllow"`
+	InheritedDeny  int `json:"inheritedDeny"`
+}
+
+// ListAccessibleRepositoriesOptions configures ListAccessibleRepositories.
+type ListAccessibleRepositoriesOptions struct {
+	// MaxRepositories is the maximum number of repositories evaluated, in the
+	// order they are listed. If 0, all repositories are evaluated.
+	MaxRepositories int
+	// MaxConcurrency is the maximum number of repositories evaluated at the
+	// same time, 4 if unset and at most 16.
+	MaxConcurrency int
+}
+
+type ListSecurityNamespacesResponse struct {
+	Count int                 `json:"count"`
+	Value []SecurityNamespace `json:"value"`
+}
+
+// ResourceArea is a group of APIs served from the same base URL.
+type ResourceArea struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	LocationURL string `json:"locationUrl"`
+}
+
+type ListResourceAreasResponse struct {
+	Count int            `json:"count"`
+	Value []ResourceArea `json:"value"`
+}
+
+// BuildArtifact is an artifact published by a build.
+type BuildArtifact struct {
+	ID       int                   `json:"id"`
+	Name     string                `json:"name"`
+	Source   string                `json:"source"`
+	Resource BuildArtifactResource `json:"resource"`
+}
+
+type BuildArtifactResource struct {
+	// Type is e.g. Container for artifacts stored by Azure DevOps or FilePath
+	// for artifacts on a file share, which can't be downloaded.
+	Type        string            `json:"type"`
+	Data        string            `json:"data"`
+	Properties  map[string]string `json:"properties,omitempty"`
+	URL         string            `json:"url"`
+	DownloadURL string            `json:"downloadUrl"`
+}
+
+type ListBuildArtifactsResponse struct {
+	Count int             `json:"count"`
+	Value []BuildArtifact `json:"value"`
+}
+
+type EnsureSubscriptionInput struct {
+	Org       string
+	EventType AzureDevOpsEvent
+	// URL is the URL events are delivered to.
+	URL string
+	// PublisherInputs filter the events, e.g. projectId and repository.
+	PublisherInputs map[string]string
+	// ConsumerInputs are additional inputs of the webhook, e.g. httpHeaders.
+	// The url input is always set to URL.
+	ConsumerInputs map[string]string
+}
+
+// Subscription is a service hook subscription.
+type Subscription struct {
+	ID               string            `json:"id,omitempty"`
+	PublisherID      string            `json:"publisherId"`
+	EventType        string            `json:"eventType"`
+	ResourceVersion  string            `json:"resourceVersion,omitempty"`
+	ConsumerID       string            `json:"consumerId"`
+	ConsumerActionID string            `json:"consumerActionId"`
+	PublisherInputs  map[string]string `json:"publisherInputs,omitempty"`
+	// ConsumerInputs are returned with secrets like basicAuthPassword masked.
+	ConsumerInputs map[string]string `json:"consumerInputs,omitempty"`
+	Status         string            `json:"status,omitempty"`
+	URL            string            `json:"url,omitempty"`
+}
+
+type ListSubscriptionsResponse struct {
+	Count int            `json:"count"`
+	Value []Subscription `json:"value"`
+}
+
 type HTTPError struct {
+	// Method is the HTTP method of the request, which tells e.g. whether it is
+	// safe to retry.
+	Method     string
 	StatusCode int
 	URL        *url.URL
 	Body       []byte
+	// E2EID is the activity ID Azure DevOps assigned to the request, see
+	// e2eIDHeader. Azure support asks for it when investigating server errors.
+	E2EID string
 }
 
 // Error returns a minimal string of the HTTP error with the status code and the URL.
@@ -296,5 +1962,161 @@ type HTTPError struct {
 // In the worst case, we should reproduce the error by manually sending a curl request that
 // causes an error and inspecting the HTML output.
 func (e *HTTPError) Error() string {
-	return fmt.Sprintf("Azure DevOps API HTTP error: code=%d url=%q", e.StatusCode, e.URL)
+	var b strings.Builder
+	b.WriteString("Azure DevOps API HTTP error: ")
+	if e.Method != "" {
+		fmt.Fprintf(&b, "method=%s ", e.Method)
+	}
+	fmt.Fprintf(&b, "code=%d url=%q", e.StatusCode, e.URL)
+	if e.E2EID != "" {
+		fmt.Fprintf(&b, " e2eid=%s", e.E2EID)
+	}
+	return b.String()
+}
+
+// Status returns the HTTP status code of the response.
+func (e *HTTPError) Status() int {
+	return e.StatusCode
+}
+
+// Code returns the type of the error as reported by Azure DevOps in the
+// typeKey of the JSON body, e.g. "GitRepositoryNotFoundException", or an empty
+// string if the body isn't such an error.
+func (e *HTTPError) Code() string {
+	var body struct {
+		TypeKey string `json:"typeKey"`
+	}
+	if err := json.Unmarshal(e.Body, &body); err != nil {
+		return ""
+	}
+	return body.TypeKey
+}
+
+// NotFoundError is returned for 404 responses when the client probed the
+// enclosing scopes (see Client.SetProbeNotFound). Scope is the most specific
+// scope that could not be found: "organization", "project", "repository" or
+// "resource".
+type NotFoundError struct {
+	Scope string
+	// Deleted is true if Scope is "repository" and the repository is in the
+	// recycle bin of its project.
+	Deleted bool
+	Err     error
+}
+
+func (e *NotFoundError) Error() string {
+	if e.Scope == "project" {
+		return fmt.Sprintf("Azure DevOps project not found or not visible to the authenticated user: %v", e.Err)
+	}
+	if e.Deleted {
+		return fmt.Sprintf("Azure DevOps %s was deleted: %v", e.Scope, e.Err)
+	}
+	return fmt.Sprintf("Azure DevOps %s not found: %v", e.Scope, e.Err)
+}
+
+func (e *NotFoundError) Unwrap() error {
+	return e.Err
+}
+
+func (e *NotFoundError) NotFound() bool {
+	return true
+}
+
+// ForbiddenError is returned for 404 responses when the client probed the
+// enclosing scopes (see Client.SetProbeNotFound) and found that the
+// authenticated user cannot access Scope, which is "organization", "project"
+// or "repository".
+type ForbiddenError struct {
+	Scope string
+	Err   error
+}
+
+func (e *ForbiddenError) Error() string {
+	return fmt.Sprintf("authenticated user cannot access Azure DevOps %s: %v", e.Scope, e.Err)
+}
+
+func (e *ForbiddenError) Unwrap() error {
+	return e.Err
+}
+
+func (e *ForbiddenError) Forbidden() bool {
+	return true
+}
+
+// NotFound returns false even though the wrapped error is a 404, as the
+// resource may well exist.
+func (e *ForbiddenError) NotFound() bool {
+	return false
+}
+
+// MissingScopeError is returned for 403 responses to requests that need a
+// scope the token may lack. Azure DevOps also responds with 403 if the user
+// lacks a permission, so Scope is only the likely cause.
+type MissingScopeError struct {
+	Scope string
+	Err   error
+}
+
+func (e *MissingScopeError) Error() string {
+	return fmt.Sprintf("this operation requires the '%s' scope, check that the token has it: %v", e.Scope, e.Err)
+}
+
+func (e *MissingScopeError) Unwrap() error {
+	return e.Err
+}
+
+func (e *MissingScopeError) Forbidden() bool {
+	return true
+}
+
+// ProxyInterferenceError is returned for successful responses that are likely
+// not from Azure DevOps but from a proxy between the client and it: those with
+// status 203 Non-Authoritative Information, and those that can't be decoded
+// and aren't JSON. Err is the decoding error, if any.
+type ProxyInterferenceError struct {
+	URL         *url.URL
+	StatusCode  int
+	ContentType string
+	Err         error
+}
+
+func (e *ProxyInterferenceError) Error() string {
+	msg := fmt.Sprintf("unexpected response to %q with code=%d and content type %q, it was likely modified by a proxy between Sourcegraph and Azure DevOps", e.URL, e.StatusCode, e.ContentType)
+	if e.Err != nil {
+		return msg + ": " + e.Err.Error()
+	}
+	return msg
+}
+
+func (e *ProxyInterferenceError) Unwrap() error {
+	return e.Err
+}
+
+// PreconditionFailedError is returned for 412 responses, i.e. when an update
+// was rejected because the ETag passed with WithIfMatch no longer matches the
+// resource. Callers should refetch the resource and retry.
+type PreconditionFailedError struct {
+	Err error
+}
+
+func (e *PreconditionFailedError) Error() string {
+	return fmt.Sprintf("resource was modified concurrently: %v", e.Err)
+}
+
+func (e *PreconditionFailedError) Unwrap() error {
+	return e.Err
+}
+
+// AlreadyExistsError is returned when a resource cannot be created or renamed
+// because another resource with the same name exists.
+type AlreadyExistsError struct {
+	Err error
+}
+
+func (e *AlreadyExistsError) Error() string {
+	return fmt.Sprintf("resource already exists: %v", e.Err)
+}
+
+func (e *AlreadyExistsError) Unwrap() error {
+	return e.Err
 }
@@ -0,0 +1,439 @@
+package azuredevops
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinks(t *testing.T) {
+	var repo Repository
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"webUrl": "https://dev.azure.com/org/project/_git/repo",
+		"_links": {"web": {"href": "https://dev.azure.com/org/project/_git/repo-from-links"}}
+	}`), &repo))
+	assert.Equal(t, "https://dev.azure.com/org/project/_git/repo-from-links", repo.WebLink())
+	assert.Equal(t, "", repo.Links.Href("missing"))
+
+	repo.Links = nil
+	assert.Equal(t, "https://dev.azure.com/org/project/_git/repo", repo.WebLink())
+
+	pr := PullRequest{ID: 42, Repository: repo}
+	assert.Equal(t, "https://dev.azure.com/org/project/_git/repo/pullrequest/42", pr.WebLink())
+}
+
+func TestRepository_FileWebURL(t *testing.T) {
+	repo := Repository{WebURL: "https://dev.azure.com/org/project/_git/repo"}
+
+	for name, tc := range map[string]struct {
+		repo    Repository
+		path    string
+		version *GitVersionDescriptor
+		line    int
+		want    string
+	}{
+		"default branch": {
+			repo: repo,
+			path: "README.md",
+			want: "https://dev.azure.com/org/project/_git/repo?path=%2FREADME.md",
+		},
+		"branch and line": {
+			repo:    repo,
+			path:    "/cmd/my app/main.go",
+			version: &GitVersionDescriptor{Version: "feature/x", VersionType: GitVersionTypeBranch},
+			line:    10,
+			want:    "https://dev.azure.com/org/project/_git/repo?_a=contents&line=10&lineEnd=11&lineEndColumn=1&lineStartColumn=1&lineStyle=plain&path=%2Fcmd%2Fmy+app%2Fmain.go&version=GBfeature%2Fx",
+		},
+		"commit": {
+			repo:    repo,
+			path:    "/main.go",
+			version: &GitVersionDescriptor{Version: "abc", VersionType: GitVersionTypeCommit},
+			want:    "https://dev.azure.com/org/project/_git/repo?path=%2Fmain.go&version=GCabc",
+		},
+		"tag": {
+			repo:    repo,
+			path:    "/main.go",
+			version: &GitVersionDescriptor{Version: "v1.0", VersionType: GitVersionTypeTag},
+			want:    "https://dev.azure.com/org/project/_git/repo?path=%2Fmain.go&version=GTv1.0",
+		},
+		"web URL with query": {
+			repo: Repository{WebURL: "https://ado.example.com/tfs/_git/repo?a=b"},
+			path: "/main.go",
+			want: "https://ado.example.com/tfs/_git/repo?a=b&path=%2Fmain.go",
+		},
+		"no web URL": {
+			path: "/main.go",
+			want: "",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.repo.FileWebURL(tc.path, tc.version, tc.line))
+		})
+	}
+}
+
+func TestAzureTime(t *testing.T) {
+	for name, tc := range map[string]struct {
+		json    string
+		want    time.Time
+		wantErr bool
+	}{
+		"seconds": {
+			json: `"2023-03-01T10:11:12Z"`,
+			want: time.Date(2023, 3, 1, 10, 11, 12, 0, time.UTC),
+		},
+		"fractional seconds": {
+			json: `"2023-03-01T10:11:12.1234567Z"`,
+			want: time.Date(2023, 3, 1, 10, 11, 12, 123456700, time.UTC),
+		},
+		"offset": {
+			json: `"2023-03-01T12:11:12.5+02:00"`,
+			want: time.Date(2023, 3, 1, 10, 11, 12, 500000000, time.UTC),
+		},
+		"no time zone": {
+			json: `"2023-03-01T10:11:12.123"`,
+			want: time.Date(2023, 3, 1, 10, 11, 12, 123000000, time.UTC),
+		},
+		"unset": {
+			json: `"0001-01-01T00:00:00"`,
+		},
+		"null": {
+			json: `null`,
+		},
+		"empty": {
+			json: `""`,
+		},
+		"invalid": {
+			json:    `"yesterday"`,
+			wantErr: true,
+		},
+		"not a string": {
+			json:    `42`,
+			wantErr: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var got azureTime
+			err := json.Unmarshal([]byte(tc.json), &got)
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tc.want.Equal(got.Time), "got %s, want %s", got.Time, tc.want)
+			assert.Equal(t, tc.want.IsZero(), got.IsZero())
+		})
+	}
+}
+
+func TestPullRequest_UnmarshalJSON(t *testing.T) {
+	var pr PullRequest
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"pullRequestId": 42,
+		"creationDate": "2023-03-01T10:11:12.1234567Z",
+		"closedDate": "2023-03-02T10:11:12",
+		"title": "title"
+	}`), &pr))
+	assert.Equal(t, 42, pr.ID)
+	assert.Equal(t, "title", pr.Title)
+	assert.True(t, time.Date(2023, 3, 1, 10, 11, 12, 123456700, time.UTC).Equal(pr.CreationDate))
+	require.NotNil(t, pr.ClosedDate)
+	assert.True(t, time.Date(2023, 3, 2, 10, 11, 12, 0, time.UTC).Equal(*pr.ClosedDate))
+
+	// Round trips through our own encoding, e.g. of changeset metadata.
+	data, err := json.Marshal(pr)
+	require.NoError(t, err)
+	var decoded PullRequest
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, pr, decoded)
+
+	var active PullRequest
+	require.NoError(t, json.Unmarshal([]byte(`{"closedDate": "0001-01-01T00:00:00"}`), &active))
+	assert.Nil(t, active.ClosedDate)
+}
+
+func TestPullRequestMergeStatus(t *testing.T) {
+	for _, tc := range []struct {
+		json         string
+		want         PullRequestMergeStatus
+		canMerge     bool
+		hasConflicts bool
+	}{
+		{json: `"notSet"`, want: PullRequestMergeStatusNotSet},
+		{json: `"queued"`, want: PullRequestMergeStatusQueued},
+		{json: `"conflicts"`, want: PullRequestMergeStatusConflicts, hasConflicts: true},
+		{json: `"succeeded"`, want: PullRequestMergeStatusSucceeded, canMerge: true},
+		{json: `"rejectedByPolicy"`, want: PullRequestMergeStatusRejectedByPolicy},
+		{json: `"failure"`, want: PullRequestMergeStatusFailure},
+		{json: `"somethingNew"`, want: PullRequestMergeStatusUnknown},
+	} {
+		t.Run(tc.json, func(t *testing.T) {
+			var pr PullRequest
+			require.NoError(t, json.Unmarshal([]byte(`{"mergeStatus": `+tc.json+`}`), &pr))
+			assert.Equal(t, tc.want, pr.MergeStatus)
+			assert.Equal(t, tc.canMerge, pr.MergeStatus.CanMerge())
+			assert.Equal(t, tc.hasConflicts, pr.MergeStatus.HasConflicts())
+		})
+	}
+
+	var status PullRequestMergeStatus
+	assert.Error(t, json.Unmarshal([]byte(`1`), &status))
+}
+
+func TestPullRequestMergeStrategy_MarshalJSON(t *testing.T) {
+	for strategy, want := range map[PullRequestMergeStrategy]string{
+		PullRequestMergeStrategySquash:        `"squash"`,
+		PullRequestMergeStrategyRebase:        `"rebase"`,
+		PullRequestMergeStrategyRebaseMerge:   `"rebaseMerge"`,
+		PullRequestMergeStrategyNoFastForward: `"noFastForward"`,
+	} {
+		bs, err := json.Marshal(PullRequestCompletionOptions{MergeStrategy: strategy})
+		require.NoError(t, err)
+		assert.Contains(t, string(bs), `"mergeStrategy":`+want)
+	}
+}
+
+func TestJSONPatchOperation_MarshalJSON(t *testing.T) {
+	for _, tc := range []struct {
+		op   JSONPatchOperation
+		want string
+	}{
+		{JSONPatchOperation{Op: "replace", Path: "/x", Value: false}, `{"op":"replace","path":"/x","value":false}`},
+		{JSONPatchOperation{Op: "add", Path: "/x", Value: 0}, `{"op":"add","path":"/x","value":0}`},
+		{JSONPatchOperation{Op: "add", Path: "/x", Value: ""}, `{"op":"add","path":"/x","value":""}`},
+		{RemovePropertyOperation("x"), `{"op":"remove","path":"/x"}`},
+	} {
+		bs, err := json.Marshal(tc.op)
+		require.NoError(t, err)
+		assert.JSONEq(t, tc.want, string(bs))
+	}
+}
+
+func TestCommit_UnmarshalJSON(t *testing.T) {
+	var commit Commit
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"commitId": "abc",
+		"author": {"name": "a", "email": "a@example.com", "date": "2023-03-01T10:11:12.5Z"},
+		"committer": {"name": "c", "date": "2023-03-01T10:11:13"}
+	}`), &commit))
+	assert.Equal(t, GitUserDate{Name: "a", Email: "a@example.com", Date: time.Date(2023, 3, 1, 10, 11, 12, 500000000, time.UTC)}, commit.Author)
+	assert.Equal(t, GitUserDate{Name: "c", Date: time.Date(2023, 3, 1, 10, 11, 13, 0, time.UTC)}, commit.Committer)
+}
+
+// TestUnmarshalJSON_Timestamps checks that all models decode the timestamp
+// formats of Azure DevOps Server, which omits the time zone of some fields.
+func TestUnmarshalJSON_Timestamps(t *testing.T) {
+	want := time.Date(2023, 3, 1, 10, 11, 12, 0, time.UTC)
+	ts := `"2023-03-01T10:11:12"`
+
+	for name, tc := range map[string]struct {
+		json  string
+		v     any
+		times func(v any) []*time.Time
+	}{
+		"AuditLogEntry": {
+			json: `{"id": "1", "timestamp": ` + ts + `}`,
+			v:    &AuditLogEntry{},
+			times: func(v any) []*time.Time {
+				return []*time.Time{&v.(*AuditLogEntry).Timestamp}
+			},
+		},
+		"Push": {
+			json: `{"pushId": 1, "date": ` + ts + `}`,
+			v:    &Push{},
+			times: func(v any) []*time.Time {
+				return []*time.Time{&v.(*Push).Date}
+			},
+		},
+		"Attachment": {
+			json: `{"id": 1, "createdDate": ` + ts + `}`,
+			v:    &Attachment{},
+			times: func(v any) []*time.Time {
+				return []*time.Time{&v.(*Attachment).CreatedDate}
+			},
+		},
+		"PullRequestCommentResponse": {
+			json: `{"id": 1, "publishedDate": ` + ts + `, "lastUpdatedDate": ` + ts + `}`,
+			v:    &PullRequestCommentResponse{},
+			times: func(v any) []*time.Time {
+				thread := v.(*PullRequestCommentResponse)
+				return []*time.Time{&thread.PublishedDate, &thread.LastUpdatedOn}
+			},
+		},
+		"PullRequestCommentForResponse": {
+			json: `{"id": 1, "publishedDate": ` + ts + `, "lastUpdatedDate": ` + ts + `}`,
+			v:    &PullRequestCommentForResponse{},
+			times: func(v any) []*time.Time {
+				comment := v.(*PullRequestCommentForResponse)
+				return []*time.Time{&comment.PublishedDate, &comment.LastUpdatedOn}
+			},
+		},
+		"PullRequestBuildStatus": {
+			json: `{"id": 1, "creationDate": ` + ts + `, "updatedDate": ` + ts + `}`,
+			v:    &PullRequestBuildStatus{},
+			times: func(v any) []*time.Time {
+				status := v.(*PullRequestBuildStatus)
+				return []*time.Time{&status.CreationDate, &status.UpdateDate}
+			},
+		},
+		"PolicyEvaluation": {
+			json: `{"evaluationId": "1", "startedDate": ` + ts + `, "completedDate": ` + ts + `}`,
+			v:    &PolicyEvaluation{},
+			times: func(v any) []*time.Time {
+				e := v.(*PolicyEvaluation)
+				return []*time.Time{e.StartedDate, e.CompletedDate}
+			},
+		},
+		"Profile": {
+			json: `{"id": "1", "timestamp": ` + ts + `}`,
+			v:    &Profile{},
+			times: func(v any) []*time.Time {
+				return []*time.Time{&v.(*Profile).LastChanged}
+			},
+		},
+		"PipelineRun": {
+			json: `{"id": 1, "createdDate": ` + ts + `, "finishedDate": ` + ts + `}`,
+			v:    &PipelineRun{},
+			times: func(v any) []*time.Time {
+				run := v.(*PipelineRun)
+				return []*time.Time{&run.CreatedDate, run.FinishedDate}
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, json.Unmarshal([]byte(tc.json), tc.v))
+			for _, got := range tc.times(tc.v) {
+				require.NotNil(t, got)
+				assert.True(t, want.Equal(*got), "got %s, want %s", *got, want)
+			}
+		})
+	}
+
+	// Unset optional timestamps decode to nil.
+	var run PipelineRun
+	require.NoError(t, json.Unmarshal([]byte(`{"id": 1, "finishedDate": "0001-01-01T00:00:00"}`), &run))
+	assert.Nil(t, run.FinishedDate)
+}
+
+func TestHTTPError(t *testing.T) {
+	u, err := url.Parse("https://dev.azure.com/org/_apis/projects")
+	require.NoError(t, err)
+
+	e := &HTTPError{
+		Method:     "POST",
+		StatusCode: 409,
+		URL:        u,
+		Body:       []byte(`{"message": "conflict", "typeKey": "GitRefUpdateRejectedException"}`),
+		E2EID:      "e2e",
+	}
+	assert.Equal(t, `Azure DevOps API HTTP error: method=POST code=409 url="https://dev.azure.com/org/_apis/projects" e2eid=e2e`, e.Error())
+	assert.Equal(t, 409, e.Status())
+	assert.Equal(t, "GitRefUpdateRejectedException", e.Code())
+
+	e = &HTTPError{StatusCode: 502, URL: u, Body: []byte("<html>Bad Gateway</html>")}
+	assert.Equal(t, `Azure DevOps API HTTP error: code=502 url="https://dev.azure.com/org/_apis/projects"`, e.Error())
+	assert.Equal(t, "", e.Code())
+}
+
+func TestPullRequest_Completing(t *testing.T) {
+	var pr PullRequest
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"status": "active",
+		"mergeStatus": "succeeded",
+		"completionQueueTime": "2023-02-21T22:00:06.8118586Z"
+	}`), &pr))
+	require.NotNil(t, pr.CompletionQueueTime)
+	assert.True(t, time.Date(2023, 2, 21, 22, 0, 6, 811858600, time.UTC).Equal(*pr.CompletionQueueTime))
+	assert.True(t, pr.Completing())
+
+	for name, pr := range map[string]PullRequest{
+		"auto-complete": {Status: PullRequestStatusActive, AutoCompleteSetBy: &CreatorInfo{ID: "id"}},
+	} {
+		assert.True(t, pr.Completing(), name)
+	}
+	for name, pr := range map[string]PullRequest{
+		"active":               {Status: PullRequestStatusActive, MergeStatus: PullRequestMergeStatusSucceeded},
+		"queued merge preview": {Status: PullRequestStatusActive, MergeStatus: PullRequestMergeStatusQueued},
+		"completed":            {Status: PullRequestStatusCompleted, CompletionQueueTime: pr.CompletionQueueTime},
+	} {
+		assert.False(t, pr.Completing(), name)
+	}
+}
+
+func TestPullRequest_MergeFailureReason(t *testing.T) {
+	var pr PullRequest
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"mergeStatus": "failure",
+		"mergeFailureType": "somethingNew",
+		"mergeFailureMessage": "TF401027: something went wrong."
+	}`), &pr))
+	assert.Equal(t, PullRequestMergeFailureTypeUnknown, pr.MergeFailureType)
+	assert.Equal(t, "the merge failed: TF401027: something went wrong.", pr.MergeFailureReason())
+
+	for name, tc := range map[string]struct {
+		pr   PullRequest
+		want string
+	}{
+		"succeeded":        {PullRequest{MergeStatus: PullRequestMergeStatusSucceeded, MergeFailureType: PullRequestMergeFailureTypeNone}, ""},
+		"conflicts":        {PullRequest{MergeStatus: PullRequestMergeStatusConflicts}, "the source branch has conflicts with the target branch"},
+		"rejectedByPolicy": {PullRequest{MergeStatus: PullRequestMergeStatusRejectedByPolicy}, "the merge was rejected by a branch policy"},
+		"caseSensitive": {
+			PullRequest{MergeStatus: PullRequestMergeStatusFailure, MergeFailureType: PullRequestMergeFailureTypeCaseSensitive, MergeFailureMessage: "a/B.txt and a/b.txt"},
+			"the merge would create paths that only differ in case: a/B.txt and a/b.txt",
+		},
+		"objectTooLarge": {
+			PullRequest{MergeStatus: PullRequestMergeStatusFailure, MergeFailureType: PullRequestMergeFailureTypeObjectTooLarge},
+			"the merge contains an object that exceeds the size limit",
+		},
+		"failure": {PullRequest{MergeStatus: PullRequestMergeStatusFailure}, "the merge failed"},
+	} {
+		assert.Equal(t, tc.want, tc.pr.MergeFailureReason(), name)
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	httpErr := func(code int) error {
+		return &HTTPError{StatusCode: code, URL: &url.URL{Path: "/org/_apis/projects"}}
+	}
+
+	for name, tc := range map[string]struct {
+		err  error
+		want bool
+	}{
+		"nil":                   {nil, false},
+		"429":                   {httpErr(http.StatusTooManyRequests), true},
+		"502":                   {httpErr(http.StatusBadGateway), true},
+		"503":                   {httpErr(http.StatusServiceUnavailable), true},
+		"504":                   {httpErr(http.StatusGatewayTimeout), true},
+		"500":                   {httpErr(http.StatusInternalServerError), false},
+		"404":                   {httpErr(http.StatusNotFound), false},
+		"wrapped 503":           {errors.Wrap(httpErr(http.StatusServiceUnavailable), "getting project"), true},
+		"missing scope":         {&MissingScopeError{Scope: "Code (Read)", Err: httpErr(http.StatusForbidden)}, false},
+		"rate limited":          {ErrRateLimited, true},
+		"timeout":               {&net.OpError{Op: "dial", Err: timeoutError{}}, true},
+		"refused":               {&net.OpError{Op: "dial", Err: errors.New("connection refused")}, false},
+		"canceled":              {context.Canceled, false},
+		"deadline exceeded":     {context.DeadlineExceeded, false},
+		"retry aborted":         {&RetryAbortedError{Err: context.Canceled, Last: httpErr(http.StatusTooManyRequests).(*HTTPError)}, false},
+		"invalid refresh token": {&InvalidRefreshTokenError{Err: errors.New("invalid_grant")}, false},
+		"other":                 {errors.New("boom"), false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.want, IsRetryable(tc.err))
+		})
+	}
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
@@ -71,6 +71,19 @@ func (c *client) ListAuthorizedUserOrganizations(ctx context.Context, profile Pr
 	return response.Value, nil
 }
 
+// ListAccessibleOrgs returns the organizations the authenticated user is a
+// member of, by resolving the member ID of the user from their profile first.
+// Like ListAuthorizedUserOrganizations it can only be used with Azure DevOps
+// Services.
+func (c *client) ListAccessibleOrgs(ctx context.Context) ([]Org, error) {
+	profile, err := c.GetAuthorizedProfile(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting profile")
+	}
+
+	return c.ListAuthorizedUserOrganizations(ctx, profile)
+}
+
 // SetExternalAccountData sets the user and token into the external account data blob.
 func SetExternalAccountData(data *extsvc.AccountData, user *Profile, token *oauth2.Token) error {
 	serializedUser, err := json.Marshal(user)
@@ -2,9 +2,15 @@ package azuredevops
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/sourcegraph/sourcegraph/internal/extsvc/auth"
 	"github.com/sourcegraph/sourcegraph/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestClient_AzureServicesProfile(t *testing.T) {
@@ -44,3 +50,34 @@ func TestClient_ListAuthorizedUserOrganizations(t *testing.T) {
 
 	testutil.AssertGolden(t, "testdata/golden/ListAuthorizedUserOrganizations.json", *update, orgs)
 }
+
+func TestClient_ListAccessibleOrgs(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/_apis/profile/profiles/me":
+			json.NewEncoder(w).Encode(Profile{ID: "id", PublicAlias: "alias"})
+		case "/_apis/accounts":
+			assert.Equal(t, "alias", r.URL.Query().Get("memberId"))
+			json.NewEncoder(w).Encode(ListAuthorizedUserOrgsResponse{
+				Count: 1,
+				Value: []Org{{ID: "org-id", Name: "org"}},
+			})
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	MockVisualStudioAppURL = srv.URL
+	t.Cleanup(func() {
+		MockVisualStudioAppURL = ""
+	})
+
+	cli, err := NewClient("test", srv.URL, &auth.BasicAuth{Username: "test", Password: "test"}, nil)
+	require.NoError(t, err)
+
+	orgs, err := cli.ListAccessibleOrgs(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []Org{{ID: "org-id", Name: "org"}}, orgs)
+}
@@ -0,0 +1,78 @@
+package azuredevops
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/sourcegraph/log"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+)
+
+// ConnectionConfigError is returned by ValidateConnection for each invalid
+// field of an Azure DevOps connection config.
+type ConnectionConfigError struct {
+	// Field is the JSON name of the offending field, e.g. "url".
+	Field   string
+	Message string
+}
+
+func (e *ConnectionConfigError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Field, e.Message)
+}
+
+// ValidateConnection checks config for common misconfigurations before it is
+// used to create a Client with NewClient. The returned error contains one
+// *ConnectionConfigError per invalid field.
+//
+// Plain HTTP URLs are accepted for Azure DevOps Server instances, but logged
+// as a warning since the token would be sent unencrypted.
+func ValidateConnection(config *schema.AzureDevOpsConnection) error {
+	var errs error
+	invalid := func(field, format string, args ...any) {
+		errs = errors.Append(errs, &ConnectionConfigError{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if config.Url == "" {
+		invalid("url", "must be set")
+	} else if u, err := url.Parse(config.Url); err != nil {
+		invalid("url", "cannot be parsed: %s", err)
+	} else if !u.IsAbs() || u.Host == "" {
+		invalid("url", "must be an absolute URL, e.g. %s", AzureDevOpsAPIURL)
+	} else {
+		switch u.Scheme {
+		case "https":
+		case "http":
+			if strings.EqualFold(u.Hostname(), "dev.azure.com") {
+				invalid("url", "Azure DevOps Services must use https")
+			} else {
+				log.Scoped("azuredevops.ValidateConnection", "").Warn("Azure DevOps connection uses plain http", log.String("url", u.Redacted()))
+			}
+		default:
+			invalid("url", "scheme must be https or http, not %q", u.Scheme)
+		}
+		if strings.Contains(u.Path, "/_apis") {
+			invalid("url", "must not contain the API path (/_apis)")
+		}
+	}
+
+	if config.Username == "" {
+		invalid("username", "must be set")
+	}
+	if config.Token == "" {
+		invalid("token", "must be set")
+	}
+
+	if len(config.Orgs) == 0 && len(config.Projects) == 0 {
+		invalid("orgs", "at least one of orgs or projects must be set")
+	}
+	for _, p := range config.Projects {
+		if org, project, ok := strings.Cut(p, "/"); !ok || org == "" || project == "" || strings.Contains(project, "/") {
+			invalid("projects", "%q must be of the form org/project", p)
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,87 @@
+package azuredevops
+
+import (
+	"testing"
+
+	"github.com/sourcegraph/sourcegraph/lib/errors"
+	"github.com/sourcegraph/sourcegraph/schema"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateConnection(t *testing.T) {
+	valid := func() *schema.AzureDevOpsConnection {
+		return &schema.AzureDevOpsConnection{
+			Url:      "https://dev.azure.com",
+			Username: "admin",
+			Token:    "secret",
+			Orgs:     []string{"org"},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		modify     func(*schema.AzureDevOpsConnection)
+		wantFields []string
+	}{
+		"valid": {
+			modify: func(*schema.AzureDevOpsConnection) {},
+		},
+		"on-prem http": {
+			modify: func(c *schema.AzureDevOpsConnection) { c.Url = "http://ado.example.com/tfs" },
+		},
+		"projects only": {
+			modify: func(c *schema.AzureDevOpsConnection) {
+				c.Orgs = nil
+				c.Projects = []string{"org/project"}
+			},
+		},
+		"services over http": {
+			modify:     func(c *schema.AzureDevOpsConnection) { c.Url = "http://dev.azure.com" },
+			wantFields: []string{"url"},
+		},
+		"relative url": {
+			modify:     func(c *schema.AzureDevOpsConnection) { c.Url = "dev.azure.com/org" },
+			wantFields: []string{"url"},
+		},
+		"wrong scheme": {
+			modify:     func(c *schema.AzureDevOpsConnection) { c.Url = "ftp://dev.azure.com" },
+			wantFields: []string{"url"},
+		},
+		"api path": {
+			modify:     func(c *schema.AzureDevOpsConnection) { c.Url = "https://dev.azure.com/org/_apis" },
+			wantFields: []string{"url"},
+		},
+		"everything missing": {
+			modify: func(c *schema.AzureDevOpsConnection) {
+				*c = schema.AzureDevOpsConnection{}
+			},
+			wantFields: []string{"url", "username", "token", "orgs"},
+		},
+		"malformed project": {
+			modify:     func(c *schema.AzureDevOpsConnection) { c.Projects = []string{"project", "org/project/repo"} },
+			wantFields: []string{"projects", "projects"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			config := valid()
+			tc.modify(config)
+
+			err := ValidateConnection(config)
+			if len(tc.wantFields) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			var fields []string
+			var multi errors.MultiError
+			if errors.As(err, &multi) {
+				for _, err := range multi.Errors() {
+					var cfgErr *ConnectionConfigError
+					if errors.As(err, &cfgErr) {
+						fields = append(fields, cfgErr.Field)
+					}
+				}
+			}
+			assert.Equal(t, tc.wantFields, fields)
+		})
+	}
+}
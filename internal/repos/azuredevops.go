@@ -101,7 +101,7 @@ func (s *AzureDevOpsSource) ListRepos(ctx context.Context, results chan SourceRe
 }
 
 func (s *AzureDevOpsSource) processReposFromProjectOrOrg(ctx context.Context, name string, results chan SourceResult) {
-	repos, err := s.cli.ListRepositoriesByProjectOrOrg(ctx, azuredevops.ListRepositoriesByProjectOrOrgArgs{
+	page, err := s.cli.ListRepositoriesPage(ctx, azuredevops.ListRepositoriesByProjectOrOrgArgs{
 		ProjectOrOrgName: name,
 	})
 	if err != nil {
@@ -109,7 +109,7 @@ func (s *AzureDevOpsSource) processReposFromProjectOrOrg(ctx context.Context, na
 		return
 	}
 
-	for _, repo := range repos {
+	for _, repo := range page.Repositories {
 		org, err := repo.GetOrganization()
 		if err != nil {
 			results <- SourceResult{Source: s, Err: err}